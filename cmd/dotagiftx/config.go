@@ -2,9 +2,15 @@ package main
 
 import (
 	"github.com/kudarap/dotagiftx/gokit/log"
+	"github.com/kudarap/dotagiftx/grpc"
+	"github.com/kudarap/dotagiftx/http"
+	"github.com/kudarap/dotagiftx/notify"
 	"github.com/kudarap/dotagiftx/paypal"
+	"github.com/kudarap/dotagiftx/postgres"
 	"github.com/kudarap/dotagiftx/redis"
 	"github.com/kudarap/dotagiftx/rethink"
+	"github.com/kudarap/dotagiftx/search"
+	"github.com/kudarap/dotagiftx/service"
 	"github.com/kudarap/dotagiftx/steam"
 )
 
@@ -20,10 +26,31 @@ type (
 			Size  int
 			Types []string
 		}
-		Rethink rethink.Config
-		Redis   redis.Config
-		Steam   steam.Config
-		Paypal  paypal.Config
-		Log     log.Config
+		DB struct {
+			Driver      string
+			AutoMigrate bool
+		}
+		Events struct {
+			Driver string
+			Stream string
+			Group  string
+		}
+		Matching struct {
+			Enabled bool
+		}
+		CatalogIndex service.CatalogIndexConfig
+		Currency     struct {
+			RatesFile string
+		}
+		Rethink   rethink.Config
+		Postgres  postgres.Config
+		Redis     redis.Config
+		Search    search.Config
+		GRPC      grpc.Config
+		RateLimit http.RateLimitConfig
+		Steam     steam.Config
+		SMTP      notify.SMTPConfig
+		Paypal    paypal.Config
+		Log       log.Config
 	}
 )
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/kudarap/dotagiftx/events"
+	"github.com/kudarap/dotagiftx/redis"
+)
+
+// Supported event bus drivers.
+const (
+	eventsDriverLocal = "local"
+	eventsDriverRedis = "redis"
+)
+
+// setupEvents returns event bus of the selected driver and its listener
+// that consumes published events, listener is nil on in-process bus.
+func (app *application) setupEvents(rc *redis.Client) (events.Bus, func(context.Context) error, error) {
+	cfg := app.config.Events
+	switch cfg.Driver {
+	case eventsDriverLocal, "":
+		return events.NewLocal(app.contextLog("events")), nil, nil
+	case eventsDriverRedis:
+		consumer, err := os.Hostname()
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not get event consumer name: %s", err)
+		}
+
+		s := redis.NewEventStream(rc, cfg.Stream, cfg.Group, consumer, app.contextLog("events"))
+		return s, s.Listen, nil
+	}
+
+	return nil, nil, fmt.Errorf("events driver %q not supported", cfg.Driver)
+}
+
+// broadcastEvents returns subscriber which handlers runs on every instance,
+// event stream consumer group only delivers each event to one of them.
+func broadcastEvents(bus events.Bus) events.Subscriber {
+	if s, ok := bus.(*redis.EventStream); ok {
+		return s.Broadcast()
+	}
+
+	return bus
+}
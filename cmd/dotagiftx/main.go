@@ -1,18 +1,25 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"time"
 
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/fixes"
 	"github.com/kudarap/dotagiftx/gokit/envconf"
 	"github.com/kudarap/dotagiftx/gokit/file"
 	"github.com/kudarap/dotagiftx/gokit/log"
 	"github.com/kudarap/dotagiftx/gokit/version"
+	"github.com/kudarap/dotagiftx/grpc"
 	"github.com/kudarap/dotagiftx/http"
 	"github.com/kudarap/dotagiftx/jobs"
+	"github.com/kudarap/dotagiftx/migration"
+	"github.com/kudarap/dotagiftx/notify"
 	"github.com/kudarap/dotagiftx/paypal"
 	"github.com/kudarap/dotagiftx/redis"
-	"github.com/kudarap/dotagiftx/rethink"
+	"github.com/kudarap/dotagiftx/search"
 	"github.com/kudarap/dotagiftx/service"
 	"github.com/kudarap/dotagiftx/steam"
 	"github.com/kudarap/dotagiftx/worker"
@@ -21,6 +28,18 @@ import (
 
 const configPrefix = "DG"
 
+// catalogIndexQueueKey redis key of catalog index queue shared by the api
+// server and worker.
+const catalogIndexQueueKey = "dotagiftx:catalog_index"
+
+// searchIndexChannel redis pub/sub channel of search index changes applied by
+// every api server instance to its local search index.
+const searchIndexChannel = "dotagiftx:search_index"
+
+// rateLimitKeyPrefix redis key prefix of rate limit counters shared by api
+// server instances.
+const rateLimitKeyPrefix = "dotagiftx:ratelimit"
+
 var logger = log.Default()
 
 func main() {
@@ -41,6 +60,14 @@ func main() {
 		logger.Fatalln("could not setup:", err)
 	}
 
+	// Runs migration command instead of serving the app.
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := app.migrate(os.Args[2:]); err != nil {
+			logger.Fatalln("could not migrate:", err)
+		}
+		return
+	}
+
 	logger.Println("running app...")
 	if err := app.run(); err != nil {
 		logger.Fatalln("could not run:", err)
@@ -51,8 +78,22 @@ func main() {
 type application struct {
 	config Config
 	server *http.Server
-	worker *worker.Worker
-	logger *logrus.Logger
+	// grpcServer is only set when gRPC address is configured.
+	grpcServer *grpc.Server
+	worker     *worker.Worker
+	logger     *logrus.Logger
+
+	migrator *migration.Migrator
+	fixer    *migration.Migrator
+
+	// listenEvents consumes published events when bus is not in-process.
+	listenEvents func(context.Context) error
+	// listenSearch applies search index changes published by any instance.
+	listenSearch func(context.Context) error
+	// loadRates saves exchange rates from configured rates file.
+	loadRates func() error
+	// buildSearch adds existing records to a newly created search index.
+	buildSearch func() error
 
 	closerFn func()
 }
@@ -81,7 +122,7 @@ func (app *application) setup() error {
 	if err != nil {
 		return err
 	}
-	rethinkClient, err := setupRethink(app.config.Rethink)
+	stg, err := app.setupStorages()
 	if err != nil {
 		return err
 	}
@@ -103,31 +144,70 @@ func (app *application) setup() error {
 	// NOTE! this is shade I don't like this one bit
 	dispatcher := new(jobs.Dispatcher)
 
+	// Domain events setup.
+	logSvc.Println("setting up event bus...")
+	eventBus, listenEvents, err := app.setupEvents(redisClient)
+	if err != nil {
+		return err
+	}
+	app.listenEvents = listenEvents
+
+	// Search index setup.
+	logSvc.Println("setting up search index...")
+	searchIdx, err := search.New(app.config.Search)
+	if err != nil {
+		return err
+	}
+	searchSync := redis.NewSearchIndexBroadcast(redisClient, searchIndexChannel, app.contextLog("search_index"))
+	app.listenSearch = func(ctx context.Context) error {
+		return searchSync.Listen(ctx, searchIdx)
+	}
+
 	// Storage inits.
 	logSvc.Println("setting up data stores...")
-	userStg := rethink.NewUser(rethinkClient)
-	authStg := rethink.NewAuth(rethinkClient)
-	catalogStg := rethink.NewCatalog(rethinkClient, app.contextLog("storage_catalog"))
-	itemStg := rethink.NewItem(rethinkClient)
-	marketStg := rethink.NewMarket(rethinkClient)
-	trackStg := rethink.NewTrack(rethinkClient)
-
-	statsStg := rethink.NewStats(rethinkClient)
-	reportStg := rethink.NewReport(rethinkClient)
-	deliveryStg := rethink.NewDelivery(rethinkClient)
-	inventoryStg := rethink.NewInventory(rethinkClient)
+	userStg := stg.user
+	authStg := stg.auth
+	tokenStg := stg.token
+	roleAuditStg := stg.roleAudit
+	synonymSvc := service.NewSynonym(stg.synonym, userStg)
+	catalogStg := service.NewCatalogIndexPublisher(
+		service.NewCatalogSearch(stg.catalog, searchIdx, synonymSvc),
+		eventBus,
+		app.contextLog("storage_catalog"),
+	)
+	itemStg := stg.item
+	marketStg := service.NewMarketSearch(service.NewMarketIndexSync(stg.market, searchSync), searchIdx, synonymSvc)
+	historyStg := stg.history
+	matchStg := stg.match
+	offerStg := stg.offer
+	priceStg := stg.price
+	rateStg := stg.rate
+	webhookStg := stg.webhook
+	whDeliverStg := stg.whDeliver
+	notifyStg := stg.notify
+	watchlistStg := stg.watchlist
+	wlAlertStg := stg.wlAlert
+	trackStg := stg.track
+
+	statsStg := stg.stats
+	reportStg := stg.report
+	deliveryStg := stg.delivery
+	inventoryStg := stg.inventory
 
 	// Service inits.
 	logSvc.Println("setting up services...")
 	fileMgr := setupFileManager(app.config)
 	userSvc := service.NewUser(userStg, fileMgr, paypalClient)
 	authSvc := service.NewAuth(steamClient, authStg, userSvc)
+	tokenSvc := service.NewAccessToken(tokenStg)
 	imageSvc := service.NewImage(fileMgr)
-	itemSvc := service.NewItem(itemStg, fileMgr)
-	deliverySvc := service.NewDelivery(deliveryStg, marketStg)
-	inventorySvc := service.NewInventory(inventoryStg, marketStg, catalogStg)
+	itemSvc := service.NewItem(itemStg, userStg, fileMgr)
+	deliverySvc := service.NewDelivery(deliveryStg, marketStg, eventBus, app.contextLog("service_delivery"))
+	inventorySvc := service.NewInventory(inventoryStg, marketStg, eventBus, app.contextLog("service_inventory"))
+	currencySvc := service.NewCurrency(rateStg, userStg)
 	marketSvc := service.NewMarket(
 		marketStg,
+		historyStg,
 		userStg,
 		itemStg,
 		trackStg,
@@ -136,13 +216,88 @@ func (app *application) setup() error {
 		deliverySvc,
 		inventorySvc,
 		steamClient,
-		dispatcher,
+		currencySvc,
+		eventBus,
 		app.contextLog("service_market"),
 	)
 	trackSvc := service.NewTrack(trackStg, itemStg)
 	reportSvc := service.NewReport(reportStg)
 	statsSvc := service.NewStats(statsStg, trackStg)
-	hammerSvc := service.NewHammerService(userStg, marketStg)
+	hammerSvc := service.NewHammerService(userStg, marketStg, historyStg, eventBus)
+	roleSvc := service.NewRole(userStg, roleAuditStg)
+	webhookSvc := service.NewWebhook(webhookStg, whDeliverStg)
+	indexSvc := service.NewCatalogIndexer(
+		app.config.CatalogIndex,
+		redis.NewCatalogIndexQueue(redisClient, catalogIndexQueueKey),
+		catalogStg,
+		userStg,
+		app.contextLog("service_catalog_index"),
+	)
+	notifySvc := service.NewNotification(
+		notifyStg,
+		setupNotificationChannels(app.config.SMTP),
+		app.contextLog("service_notification"),
+	)
+	priceSvc := service.NewPriceHistory(
+		priceStg,
+		marketStg,
+		catalogStg,
+		itemStg,
+		app.contextLog("service_price_history"),
+	)
+	watchlistSvc := service.NewWatchlist(
+		watchlistStg,
+		wlAlertStg,
+		itemStg,
+		catalogStg,
+		notifySvc,
+		app.contextLog("service_watchlist"),
+	)
+	matchSvc := service.NewMarketMatch(
+		matchStg,
+		marketStg,
+		historyStg,
+		catalogStg,
+		userStg,
+		notifySvc,
+		eventBus,
+		app.contextLog("service_market_match"),
+	)
+	offerSvc := service.NewOffer(
+		offerStg,
+		marketStg,
+		historyStg,
+		catalogStg,
+		userStg,
+		notifySvc,
+		eventBus,
+		app.contextLog("service_offer"),
+	)
+
+	// Register side effects on domain events.
+	subscriber := service.NewSubscriber(
+		marketSvc,
+		marketStg,
+		indexSvc,
+		webhookSvc,
+		notifySvc,
+		watchlistSvc,
+		matchSvc,
+		priceSvc,
+		searchSync,
+		dispatcher,
+		app.contextLog("subscriber"),
+	)
+	subscriber.SubscribeMarket(eventBus)
+	subscriber.SubscribeVerification(eventBus)
+	subscriber.SubscribeWebhook(eventBus)
+	subscriber.SubscribeNotification(eventBus)
+	subscriber.SubscribeWatchlist(eventBus)
+	subscriber.SubscribePriceHistory(eventBus)
+	subscriber.SubscribeSearch(eventBus)
+	if app.config.Matching.Enabled {
+		subscriber.SubscribeMatching(eventBus)
+	}
 
 	// Register job on the worker.
 	*dispatcher = *jobs.NewDispatcher(
@@ -151,18 +306,27 @@ func (app *application) setup() error {
 		inventorySvc,
 		deliveryStg,
 		marketStg,
-		catalogStg,
+		historyStg,
+		indexSvc,
+		webhookStg,
+		whDeliverStg,
 		redisClient,
+		eventBus,
+		notifySvc,
 		logger,
 	)
 	dispatcher.RegisterJobs()
 
+	// Schema migrations and data fixes are tracked on the same store but
+	// only schema migrations and deploy fixes are applied on start.
+	app.migrator = migration.New(stg.migration, app.contextLog("migration"))
+	app.migrator.Register(stg.schema...)
+	app.migrator.Register(fixes.DeployMigrations(userStg, roleAuditStg)...)
+	app.fixer = migration.New(stg.migration, app.contextLog("migration_fixes"))
+	app.fixer.Register(fixes.Migrations(itemStg, catalogStg, marketStg, stg.synonym, userSvc, marketSvc, steamClient, priceSvc)...)
+
 	// NOTE! this is for run-once scripts
 	//fixes.GenerateFakeMarket(itemStg, userStg, marketSvc)
-	//fixes.ReIndexAll(itemStg, catalogStg)
-	//fixes.ResolveCompletedBidSteamID(marketStg, steamClient)
-	//fixes.MarketIndexRebuild(marketStg)
-	//fixes.MarketSetRankingScores(userSvc, marketSvc)
 	//redisClient.BulkDel("")
 
 	// Server setup.
@@ -171,6 +335,7 @@ func (app *application) setup() error {
 		app.config.SigKey,
 		userSvc,
 		authSvc,
+		tokenSvc,
 		imageSvc,
 		itemSvc,
 		marketSvc,
@@ -178,24 +343,70 @@ func (app *application) setup() error {
 		statsSvc,
 		reportSvc,
 		hammerSvc,
+		roleSvc,
+		webhookSvc,
+		notifySvc,
+		watchlistSvc,
+		matchSvc,
+		offerSvc,
+		currencySvc,
+		priceSvc,
+		indexSvc,
+		synonymSvc,
+		deliverySvc,
+		inventorySvc,
 		steamClient,
 		redisClient,
+		redis.NewRateLimiter(redisClient, rateLimitKeyPrefix),
 		initVer(app.config),
 		logSvc,
 	)
 	srv.Addr = app.config.Addr
+	srv.RateLimit = app.config.RateLimit
 	app.server = srv
 
+	if addr := app.config.GRPC.Addr; addr != "" {
+		logSvc.Println("setting up grpc server...")
+		gs := grpc.NewServer(
+			app.config.SigKey,
+			userSvc,
+			itemSvc,
+			marketSvc,
+			statsSvc,
+			app.contextLog("grpc"),
+		)
+		gs.Addr = addr
+		gs.SubscribeMarket(broadcastEvents(eventBus))
+		app.grpcServer = gs
+	}
+
+	// Exchange rates file is loaded after migrations on start.
+	if f := app.config.Currency.RatesFile; f != "" {
+		app.loadRates = func() error {
+			return loadExchangeRates(currencySvc, f)
+		}
+	}
+
+	// New search index is built from existing records after migrations on start.
+	if searchIdx.IsNew() {
+		app.buildSearch = func() error {
+			return fixes.SearchIndexRebuild(searchIdx, stg.catalog, stg.market)
+		}
+	}
+
 	app.closerFn = func() {
 		logSvc.Println("closing and stopping app...")
 		if err = app.worker.Stop(); err != nil {
 			logSvc.Fatal("could not stop worker", err)
 		}
+		if err = searchIdx.Close(); err != nil {
+			logSvc.Fatal("could not close search index", err)
+		}
 		if err = redisClient.Close(); err != nil {
 			logSvc.Fatal("could not close redis client", err)
 		}
-		if err = rethinkClient.Close(); err != nil {
-			logSvc.Fatal("could not close rethink client", err)
+		if err = stg.db.Close(); err != nil {
+			logSvc.Fatal("could not close database client", err)
 		}
 	}
 
@@ -205,8 +416,55 @@ func (app *application) setup() error {
 func (app *application) run() error {
 	defer app.closerFn()
 
+	if app.config.DB.AutoMigrate {
+		if _, err := app.migrator.Up(); err != nil {
+			return fmt.Errorf("could not apply migrations: %s", err)
+		}
+	}
+
+	if app.loadRates != nil {
+		if err := app.loadRates(); err != nil {
+			return fmt.Errorf("could not load exchange rates: %s", err)
+		}
+	}
+
+	if app.buildSearch != nil {
+		go func() {
+			if err := app.buildSearch(); err != nil {
+				app.logger.Errorf("could not build search index: %s", err)
+			}
+		}()
+	}
+
 	go app.worker.Start()
 
+	if app.listenEvents != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			if err := app.listenEvents(ctx); err != nil {
+				app.logger.Errorf("could not listen events: %s", err)
+			}
+		}()
+	}
+
+	searchCtx, cancelSearch := context.WithCancel(context.Background())
+	defer cancelSearch()
+	go func() {
+		if err := app.listenSearch(searchCtx); err != nil {
+			app.logger.Errorf("could not listen search index changes: %s", err)
+		}
+	}()
+
+	if app.grpcServer != nil {
+		go func() {
+			if err := app.grpcServer.Run(); err != nil {
+				app.logger.Errorf("could not run grpc server: %s", err)
+			}
+		}()
+		defer app.grpcServer.Stop()
+	}
+
 	return app.server.Run()
 }
 
@@ -243,19 +501,23 @@ func setupFileManager(cfg Config) *file.Local {
 	return file.New(c.Path, c.Size, c.Types)
 }
 
-func setupRethink(cfg rethink.Config) (c *rethink.Client, err error) {
-	c = &rethink.Client{}
-	fn := func() error {
-		c, err = rethink.New(cfg)
-		if err != nil {
-			return fmt.Errorf("could not setup rethink client: %s", err)
-		}
+func setupNotificationChannels(cfg notify.SMTPConfig) []core.NotificationChannel {
+	cc := []core.NotificationChannel{notify.NewWebhook()}
+	if cfg.Addr != "" {
+		cc = append(cc, notify.NewSMTP(cfg))
+	}
 
-		return nil
+	return cc
+}
+
+func loadExchangeRates(svc core.CurrencyService, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
 	}
+	defer f.Close()
 
-	err = connRetry("rethink", fn)
-	return
+	return svc.LoadExchangeRates(f)
 }
 
 func setupRedis(cfg redis.Config) (c *redis.Client, err error) {
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/kudarap/dotagiftx/migration"
+)
+
+const migrateUsage = `usage: dotagiftx migrate [-dry-run] [-steps n] up|down|fixes|list
+
+  up     applies all pending schema migrations
+  down   reverts last applied schema migrations by number of steps
+  fixes  applies all pending data fixes, they rewrites existing records and
+         are never applied on start
+  list   shows schema migrations and data fixes and when they were applied`
+
+// migrate runs migration subcommand.
+func (app *application) migrate(args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	fs.Usage = func() { fmt.Println(migrateUsage) }
+	dryRun := fs.Bool("dry-run", false, "lists pending changes without executing them")
+	steps := fs.Int("steps", 1, "number of migrations to revert")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("migrate command required")
+	}
+
+	app.migrator.SetDryRun(*dryRun)
+	app.fixer.SetDryRun(*dryRun)
+	switch cmd := fs.Arg(0); cmd {
+	case "up":
+		names, err := app.migrator.Up()
+		printMigrations("applied", names, *dryRun)
+		return err
+	case "down":
+		names, err := app.migrator.Down(*steps)
+		printMigrations("reverted", names, *dryRun)
+		return err
+	case "fixes":
+		names, err := app.fixer.Up()
+		printMigrations("applied", names, *dryRun)
+		return err
+	case "list":
+		for _, m := range []*migration.Migrator{app.migrator, app.fixer} {
+			ss, err := m.List()
+			if err != nil {
+				return err
+			}
+
+			for _, s := range ss {
+				applied := "pending"
+				if s.Applied() {
+					applied = s.AppliedAt.Format(time.RFC3339)
+				}
+				fmt.Printf("%-45s %s\n", s.Name, applied)
+			}
+		}
+		return nil
+	default:
+		fs.Usage()
+		return fmt.Errorf("migrate command %q not supported", cmd)
+	}
+}
+
+func printMigrations(action string, names []string, dryRun bool) {
+	if dryRun {
+		action += " (dry-run)"
+	}
+	if len(names) == 0 {
+		fmt.Println("no migrations", action)
+		return
+	}
+
+	for _, n := range names {
+		fmt.Println(action, n)
+	}
+}
@@ -2,19 +2,36 @@ package main
 
 import (
 	"github.com/kudarap/dotagiftx/gokit/log"
+	"github.com/kudarap/dotagiftx/notify"
+	"github.com/kudarap/dotagiftx/postgres"
 	"github.com/kudarap/dotagiftx/redis"
 	"github.com/kudarap/dotagiftx/rethink"
+	"github.com/kudarap/dotagiftx/service"
 	"github.com/kudarap/dotagiftx/steam"
 )
 
 type (
 	Config struct {
-		SigKey  string
-		Prod    bool
-		Addr    string
-		Rethink rethink.Config
-		Redis   redis.Config
-		Steam   steam.Config
-		Log     log.Config
+		SigKey string
+		Prod   bool
+		Addr   string
+		DB     struct {
+			Driver string
+		}
+		Events struct {
+			Driver string
+			Stream string
+			Group  string
+		}
+		Matching struct {
+			Enabled bool
+		}
+		CatalogIndex service.CatalogIndexConfig
+		Rethink      rethink.Config
+		Postgres     postgres.Config
+		Redis        redis.Config
+		Steam        steam.Config
+		SMTP         notify.SMTPConfig
+		Log          log.Config
 	}
 )
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kudarap/dotagiftx/events"
+	"github.com/kudarap/dotagiftx/redis"
+)
+
+// Supported event bus drivers.
+const (
+	eventsDriverLocal = "local"
+	eventsDriverRedis = "redis"
+)
+
+// setupEvents returns event bus of the selected driver. Events published
+// on redis driver are consumed by the api server.
+func (app *application) setupEvents(rc *redis.Client) (events.Bus, error) {
+	cfg := app.config.Events
+	switch cfg.Driver {
+	case eventsDriverLocal, "":
+		return events.NewLocal(app.contextLog("events")), nil
+	case eventsDriverRedis:
+		consumer, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("could not get event consumer name: %s", err)
+		}
+
+		return redis.NewEventStream(rc, cfg.Stream, cfg.Group, consumer, app.contextLog("events")), nil
+	}
+
+	return nil, fmt.Errorf("events driver %q not supported", cfg.Driver)
+}
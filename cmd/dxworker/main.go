@@ -4,12 +4,13 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/kudarap/dotagiftx/core"
 	"github.com/kudarap/dotagiftx/gokit/envconf"
 	"github.com/kudarap/dotagiftx/gokit/log"
 	"github.com/kudarap/dotagiftx/gokit/version"
 	"github.com/kudarap/dotagiftx/jobs"
+	"github.com/kudarap/dotagiftx/notify"
 	"github.com/kudarap/dotagiftx/redis"
-	"github.com/kudarap/dotagiftx/rethink"
 	"github.com/kudarap/dotagiftx/service"
 	"github.com/kudarap/dotagiftx/steam"
 	"github.com/kudarap/dotagiftx/worker"
@@ -18,6 +19,14 @@ import (
 
 const configPrefix = "DG"
 
+// catalogIndexQueueKey redis key of catalog index queue shared by the api
+// server and worker.
+const catalogIndexQueueKey = "dotagiftx:catalog_index"
+
+// searchIndexChannel redis pub/sub channel of search index changes applied by
+// every api server instance to its local search index.
+const searchIndexChannel = "dotagiftx:search_index"
+
 var logger = log.Default()
 
 func main() {
@@ -78,7 +87,7 @@ func (app *application) setup() error {
 	if err != nil {
 		return err
 	}
-	rethinkClient, err := setupRethink(app.config.Rethink)
+	stg, err := app.setupStorages()
 	if err != nil {
 		return err
 	}
@@ -96,18 +105,33 @@ func (app *application) setup() error {
 	// NOTE! this is shade I don't like this one bit
 	dispatcher := new(jobs.Dispatcher)
 
+	// Domain events setup.
+	logSvc.Println("setting up event bus...")
+	eventBus, err := app.setupEvents(redisClient)
+	if err != nil {
+		return err
+	}
+
+	// Search index changes made by the worker are applied by api servers.
+	searchSync := redis.NewSearchIndexBroadcast(redisClient, searchIndexChannel, app.contextLog("search_index"))
+
 	// Storage inits.
 	logSvc.Println("setting up data stores...")
-	//userStg := rethink.NewUser(rethinkClient)
-	//authStg := rethink.NewAuth(rethinkClient)
-	catalogStg := rethink.NewCatalog(rethinkClient, app.contextLog("storage_catalog"))
-	//itemStg := rethink.NewItem(rethinkClient)
-	marketStg := rethink.NewMarket(rethinkClient)
-	//trackStg := rethink.NewTrack(rethinkClient)
-	//statsStg := rethink.NewStats(rethinkClient)
-	//reportStg := rethink.NewReport(rethinkClient)
-	deliveryStg := rethink.NewDelivery(rethinkClient)
-	inventoryStg := rethink.NewInventory(rethinkClient)
+	catalogStg := service.NewCatalogIndexPublisher(stg.catalog, eventBus, app.contextLog("storage_catalog"))
+	itemStg := stg.item
+	marketStg := service.NewMarketIndexSync(stg.market, searchSync)
+	historyStg := stg.history
+	matchStg := stg.match
+	offerStg := stg.offer
+	priceStg := stg.price
+	userStg := stg.user
+	webhookStg := stg.webhook
+	whDeliverStg := stg.whDeliver
+	notifyStg := stg.notify
+	watchlistStg := stg.watchlist
+	wlAlertStg := stg.wlAlert
+	deliveryStg := stg.delivery
+	inventoryStg := stg.inventory
 
 	// Service inits.
 	logSvc.Println("setting up services...")
@@ -115,9 +139,57 @@ func (app *application) setup() error {
 	//userSvc := service.NewUser(userStg, fileMgr)
 	//authSvc := service.NewAuth(steamClient, authStg, userSvc)
 	//imageSvc := service.NewImage(fileMgr)
-	//itemSvc := service.NewItem(itemStg, fileMgr)
-	deliverySvc := service.NewDelivery(deliveryStg, marketStg)
-	inventorySvc := service.NewInventory(inventoryStg, marketStg, catalogStg)
+	//itemSvc := service.NewItem(itemStg, userStg, fileMgr)
+	deliverySvc := service.NewDelivery(deliveryStg, marketStg, eventBus, app.contextLog("service_delivery"))
+	inventorySvc := service.NewInventory(inventoryStg, marketStg, eventBus, app.contextLog("service_inventory"))
+	webhookSvc := service.NewWebhook(webhookStg, whDeliverStg)
+	indexSvc := service.NewCatalogIndexer(
+		app.config.CatalogIndex,
+		redis.NewCatalogIndexQueue(redisClient, catalogIndexQueueKey),
+		catalogStg,
+		userStg,
+		app.contextLog("service_catalog_index"),
+	)
+	notifySvc := service.NewNotification(
+		notifyStg,
+		setupNotificationChannels(app.config.SMTP),
+		app.contextLog("service_notification"),
+	)
+	priceSvc := service.NewPriceHistory(
+		priceStg,
+		marketStg,
+		catalogStg,
+		itemStg,
+		app.contextLog("service_price_history"),
+	)
+	watchlistSvc := service.NewWatchlist(
+		watchlistStg,
+		wlAlertStg,
+		itemStg,
+		catalogStg,
+		notifySvc,
+		app.contextLog("service_watchlist"),
+	)
+	matchSvc := service.NewMarketMatch(
+		matchStg,
+		marketStg,
+		historyStg,
+		catalogStg,
+		userStg,
+		notifySvc,
+		eventBus,
+		app.contextLog("service_market_match"),
+	)
+	offerSvc := service.NewOffer(
+		offerStg,
+		marketStg,
+		historyStg,
+		catalogStg,
+		userStg,
+		notifySvc,
+		eventBus,
+		app.contextLog("service_offer"),
+	)
 	//marketSvc := service.NewMarket(
 	//	marketStg,
 	//	userStg,
@@ -127,7 +199,7 @@ func (app *application) setup() error {
 	//	deliverySvc,
 	//	inventorySvc,
 	//	steamClient,
-	//	dispatcher,
+	//	eventBus,
 	//	app.contextLog("service_market"),
 	//)
 	//trackSvc := service.NewTrack(trackStg, itemStg)
@@ -141,9 +213,46 @@ func (app *application) setup() error {
 		inventorySvc,
 		deliveryStg,
 		marketStg,
+		historyStg,
+		indexSvc,
+		webhookStg,
+		whDeliverStg,
+		redisClient,
+		eventBus,
+		notifySvc,
 		logger,
 	)
 	dispatcher.RegisterJobs()
+	dispatcher.RegisterWebhookJobs()
+	if app.config.Matching.Enabled {
+		dispatcher.RegisterMatchJobs(matchSvc)
+	}
+	dispatcher.RegisterOfferJobs(offerSvc)
+
+	// Verification, webhook, watchlist, price history, search and matching events are handled by the api server on redis driver.
+	if app.config.Events.Driver != eventsDriverRedis {
+		subscriber := service.NewSubscriber(
+			nil,
+			marketStg,
+			indexSvc,
+			webhookSvc,
+			notifySvc,
+			watchlistSvc,
+			matchSvc,
+			priceSvc,
+			searchSync,
+			dispatcher,
+			app.contextLog("subscriber"),
+		)
+		subscriber.SubscribeVerification(eventBus)
+		subscriber.SubscribeWebhook(eventBus)
+		subscriber.SubscribeWatchlist(eventBus)
+		subscriber.SubscribePriceHistory(eventBus)
+		subscriber.SubscribeSearch(eventBus)
+		if app.config.Matching.Enabled {
+			subscriber.SubscribeMatching(eventBus)
+		}
+	}
 
 	// NOTE! this is for run-once scripts
 	//fixes.GenerateFakeMarket(itemStg, userStg, marketSvc)
@@ -160,8 +269,8 @@ func (app *application) setup() error {
 		if err = redisClient.Close(); err != nil {
 			logSvc.Fatal("could not close redis client", err)
 		}
-		if err = rethinkClient.Close(); err != nil {
-			logSvc.Fatal("could not close rethink client", err)
+		if err = stg.db.Close(); err != nil {
+			logSvc.Fatal("could not close database client", err)
 		}
 	}
 
@@ -194,19 +303,13 @@ func setupSteam(cfg steam.Config, rc *redis.Client) (*steam.Client, error) {
 	return c, nil
 }
 
-func setupRethink(cfg rethink.Config) (c *rethink.Client, err error) {
-	c = &rethink.Client{}
-	fn := func() error {
-		c, err = rethink.New(cfg)
-		if err != nil {
-			return fmt.Errorf("could not setup rethink client: %s", err)
-		}
-
-		return nil
+func setupNotificationChannels(cfg notify.SMTPConfig) []core.NotificationChannel {
+	cc := []core.NotificationChannel{notify.NewWebhook()}
+	if cfg.Addr != "" {
+		cc = append(cc, notify.NewSMTP(cfg))
 	}
 
-	err = connRetry("rethink", fn)
-	return
+	return cc
 }
 
 func setupRedis(cfg redis.Config) (c *redis.Client, err error) {
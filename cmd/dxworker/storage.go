@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/postgres"
+	"github.com/kudarap/dotagiftx/rethink"
+)
+
+// Supported database drivers.
+const (
+	dbDriverRethink  = "rethink"
+	dbDriverPostgres = "postgres"
+)
+
+// storages represents data stores of the selected database driver.
+type storages struct {
+	user      core.UserStorage
+	catalog   core.CatalogStorage
+	item      core.ItemStorage
+	market    core.MarketStorage
+	history   core.MarketHistoryStorage
+	match     core.MarketMatchStorage
+	offer     core.OfferStorage
+	price     core.PriceHistoryStorage
+	webhook   core.WebhookStorage
+	whDeliver core.WebhookDeliveryStorage
+	notify    core.NotificationStorage
+	watchlist core.WatchlistStorage
+	wlAlert   core.WatchlistAlertStorage
+	delivery  core.DeliveryStorage
+	inventory core.InventoryStorage
+
+	db io.Closer
+}
+
+func (app *application) setupStorages() (*storages, error) {
+	switch app.config.DB.Driver {
+	case dbDriverRethink, "":
+		c, err := setupRethink(app.config.Rethink)
+		if err != nil {
+			return nil, err
+		}
+
+		return &storages{
+			user:      rethink.NewUser(c),
+			catalog:   rethink.NewCatalog(c, app.contextLog("storage_catalog")),
+			item:      rethink.NewItem(c),
+			market:    rethink.NewMarket(c),
+			history:   rethink.NewMarketHistory(c),
+			match:     rethink.NewMarketMatch(c),
+			offer:     rethink.NewOffer(c),
+			price:     rethink.NewPriceHistory(c),
+			webhook:   rethink.NewWebhook(c),
+			whDeliver: rethink.NewWebhookDelivery(c),
+			notify:    rethink.NewNotification(c),
+			watchlist: rethink.NewWatchlist(c),
+			wlAlert:   rethink.NewWatchlistAlert(c),
+			delivery:  rethink.NewDelivery(c),
+			inventory: rethink.NewInventory(c),
+			db:        c,
+		}, nil
+	case dbDriverPostgres:
+		c, err := setupPostgres(app.config.Postgres)
+		if err != nil {
+			return nil, err
+		}
+
+		return &storages{
+			user:      postgres.NewUser(c),
+			catalog:   postgres.NewCatalog(c, app.contextLog("storage_catalog")),
+			item:      postgres.NewItem(c),
+			market:    postgres.NewMarket(c),
+			history:   postgres.NewMarketHistory(c),
+			match:     postgres.NewMarketMatch(c),
+			offer:     postgres.NewOffer(c),
+			price:     postgres.NewPriceHistory(c),
+			webhook:   postgres.NewWebhook(c),
+			whDeliver: postgres.NewWebhookDelivery(c),
+			notify:    postgres.NewNotification(c),
+			watchlist: postgres.NewWatchlist(c),
+			wlAlert:   postgres.NewWatchlistAlert(c),
+			delivery:  postgres.NewDelivery(c),
+			inventory: postgres.NewInventory(c),
+			db:        c,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("database driver %q not supported", app.config.DB.Driver)
+}
+
+func setupRethink(cfg rethink.Config) (c *rethink.Client, err error) {
+	c = &rethink.Client{}
+	fn := func() error {
+		c, err = rethink.New(cfg)
+		if err != nil {
+			return fmt.Errorf("could not setup rethink client: %s", err)
+		}
+
+		return nil
+	}
+
+	err = connRetry("rethink", fn)
+	return
+}
+
+func setupPostgres(cfg postgres.Config) (c *postgres.Client, err error) {
+	c = &postgres.Client{}
+	fn := func() error {
+		c, err = postgres.New(cfg)
+		if err != nil {
+			return fmt.Errorf("could not setup postgres client: %s", err)
+		}
+
+		return nil
+	}
+
+	err = connRetry("postgres", fn)
+	return
+}
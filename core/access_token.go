@@ -0,0 +1,214 @@
+package core
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// Access token error types.
+const (
+	AccessTokenErrNotFound Errors = iota + 1200
+	AccessTokenErrRequiredID
+	AccessTokenErrRequiredFields
+	AccessTokenErrInvalidScope
+	AccessTokenErrLimitReached
+	AccessTokenErrRevoked
+	AccessTokenErrExpired
+)
+
+// sets error text definition.
+func init() {
+	appErrorText[AccessTokenErrNotFound] = "access token not found"
+	appErrorText[AccessTokenErrRequiredID] = "access token id is required"
+	appErrorText[AccessTokenErrRequiredFields] = "access token fields are required"
+	appErrorText[AccessTokenErrInvalidScope] = "access token scope not supported"
+	appErrorText[AccessTokenErrLimitReached] = "access token limit per user reached"
+	appErrorText[AccessTokenErrRevoked] = "access token is revoked"
+	appErrorText[AccessTokenErrExpired] = "access token is expired"
+}
+
+// Access token scopes granted to bot and API clients.
+const (
+	AccessTokenScopeProfileRead  = "profile:read"
+	AccessTokenScopeMarketsRead  = "markets:read"
+	AccessTokenScopeMarketsWrite = "markets:write"
+	AccessTokenScopeOffersRead   = "offers:read"
+	AccessTokenScopeOffersWrite  = "offers:write"
+)
+
+// AccessTokenScopes lists supported access token scopes.
+var AccessTokenScopes = []string{
+	AccessTokenScopeProfileRead,
+	AccessTokenScopeMarketsRead,
+	AccessTokenScopeMarketsWrite,
+	AccessTokenScopeOffersRead,
+	AccessTokenScopeOffersWrite,
+}
+
+const (
+	// AccessTokenPrefix identifies personal access tokens from JWT on
+	// authorization header.
+	AccessTokenPrefix = "dgx_"
+
+	// MaxAccessTokensPerUser limits active access tokens of a user.
+	MaxAccessTokensPerUser = 10
+
+	// accessTokenHintLen number of token characters kept to identify it.
+	accessTokenHintLen = 8
+)
+
+type (
+	// AccessToken represents long-lived personal access token of bot and
+	// API clients, only its hash is stored and plain token is only returned
+	// on create.
+	AccessToken struct {
+		ID         string     `json:"id"              db:"id,omitempty"`
+		UserID     string     `json:"user_id"         db:"user_id,omitempty,indexed"`
+		Name       string     `json:"name"            db:"name,omitempty"         valid:"required"`
+		Scopes     []string   `json:"scopes"          db:"scopes,omitempty"       valid:"required,min=1"`
+		Hash       string     `json:"-"               db:"hash,omitempty,indexed"`
+		Hint       string     `json:"hint"            db:"hint,omitempty"`
+		Token      string     `json:"token,omitempty" db:"-"`
+		ExpiresAt  *time.Time `json:"expires_at"      db:"expires_at,omitempty"`
+		LastUsedAt *time.Time `json:"last_used_at"    db:"last_used_at,omitempty"`
+		RevokedAt  *time.Time `json:"revoked_at"      db:"revoked_at,omitempty"`
+		CreatedAt  *time.Time `json:"created_at"      db:"created_at,omitempty"`
+		UpdatedAt  *time.Time `json:"updated_at"      db:"updated_at,omitempty"`
+	}
+
+	// AccessTokenService provides access to personal access token service.
+	AccessTokenService interface {
+		// AccessTokens returns a list of access tokens of the authenticated
+		// user including revoked ones.
+		AccessTokens(context.Context) ([]AccessToken, error)
+
+		// Create generates new access token, plain token is only set on
+		// create and cannot be retrieved later.
+		Create(context.Context, *AccessToken) error
+
+		// Revoke disables access token by id.
+		Revoke(ctx context.Context, id string) (*AccessToken, error)
+
+		// Authenticate returns active access token of plain token.
+		Authenticate(token string) (*AccessToken, error)
+	}
+
+	// AccessTokenStorage defines operation for access token records.
+	AccessTokenStorage interface {
+		// Find returns a list of access tokens from data store.
+		Find(FindOpts) ([]AccessToken, error)
+
+		// Get returns access token details by id from data store.
+		Get(id string) (*AccessToken, error)
+
+		// GetByHash returns access token details by its hash from data store.
+		GetByHash(hash string) (*AccessToken, error)
+
+		// Create persists a new access token to data store.
+		Create(*AccessToken) error
+
+		// Update persists access token changes to data store.
+		Update(*AccessToken) error
+	}
+)
+
+// CheckCreate validates field on creating new access token.
+func (t AccessToken) CheckCreate() error {
+	// Check required fields.
+	if err := validator.Struct(t); err != nil {
+		return err
+	}
+
+	for _, s := range t.Scopes {
+		if !isAccessTokenScope(s) {
+			return AccessTokenErrInvalidScope
+		}
+	}
+
+	return nil
+}
+
+// SetToken sets plain token and its hash and hint to be stored.
+func (t *AccessToken) SetToken(token string) {
+	t.Token = token
+	t.Hash = HashAccessToken(token)
+	t.Hint = token
+	if n := len(AccessTokenPrefix) + accessTokenHintLen; len(token) > n {
+		t.Hint = token[:n]
+	}
+}
+
+// IsRevoked returns true when access token was revoked.
+func (t AccessToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// IsExpired returns true when access token has expiration and past it.
+func (t AccessToken) IsExpired(at time.Time) bool {
+	return t.ExpiresAt != nil && !at.Before(*t.ExpiresAt)
+}
+
+// CheckActive returns error when access token cannot be used.
+func (t AccessToken) CheckActive(at time.Time) error {
+	if t.IsRevoked() {
+		return AccessTokenErrRevoked
+	}
+	if t.IsExpired(at) {
+		return AccessTokenErrExpired
+	}
+
+	return nil
+}
+
+// HasScope returns true when access token was granted the scope.
+func (t AccessToken) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HashAccessToken returns hex encoded SHA-256 of plain token, tokens are
+// random and long enough so it does not need a slow hash.
+func HashAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsAccessToken checks bearer token is a personal access token.
+func IsAccessToken(token string) bool {
+	return strings.HasPrefix(token, AccessTokenPrefix)
+}
+
+func isAccessTokenScope(scope string) bool {
+	for _, s := range AccessTokenScopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AccessTokenToContext sets access token that authenticated the request to
+// context.
+func AccessTokenToContext(parent context.Context, t *AccessToken) context.Context {
+	return context.WithValue(parent, accessTokenKey, t)
+}
+
+// AccessTokenFromContext returns access token that authenticated the request
+// and nil when request was authenticated by login.
+func AccessTokenFromContext(ctx context.Context) *AccessToken {
+	if ctx == nil {
+		return nil
+	}
+
+	t, _ := ctx.Value(accessTokenKey).(*AccessToken)
+	return t
+}
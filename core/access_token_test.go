@@ -0,0 +1,60 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAccessToken_CheckActive(t *testing.T) {
+	now := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	tests := []struct {
+		name  string
+		token AccessToken
+		want  error
+	}{
+		{"no expiration", AccessToken{}, nil},
+		{"not expired", AccessToken{ExpiresAt: &future}, nil},
+		{"expired", AccessToken{ExpiresAt: &past}, AccessTokenErrExpired},
+		{"expires now", AccessToken{ExpiresAt: &now}, AccessTokenErrExpired},
+		{"revoked", AccessToken{RevokedAt: &past, ExpiresAt: &future}, AccessTokenErrRevoked},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.token.CheckActive(now); got != tc.want {
+				t.Errorf("CheckActive() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAccessToken_CheckCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   AccessToken
+		wantErr bool
+	}{
+		{"valid", AccessToken{Name: "bot", Scopes: []string{AccessTokenScopeMarketsRead}}, false},
+		{"no name", AccessToken{Scopes: []string{AccessTokenScopeMarketsRead}}, true},
+		{"no scopes", AccessToken{Name: "bot"}, true},
+		{"unknown scope", AccessToken{Name: "bot", Scopes: []string{"markets:delete"}}, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.token.CheckCreate(); (err != nil) != tc.wantErr {
+				t.Errorf("CheckCreate() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccessToken_SetToken(t *testing.T) {
+	var at AccessToken
+	at.SetToken("dgx_0123456789abcdef")
+	if at.Hint != "dgx_01234567" {
+		t.Errorf("Hint = %s, want dgx_01234567", at.Hint)
+	}
+	if at.Hash != HashAccessToken("dgx_0123456789abcdef") || at.Hash == at.Token {
+		t.Errorf("Hash = %s, want SHA-256 of token", at.Hash)
+	}
+}
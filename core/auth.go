@@ -40,6 +40,9 @@ type (
 		RefreshToken string     `json:"refresh_token" db:"refresh_token,omitempty"`
 		CreatedAt    *time.Time `json:"created_at"    db:"created_at,omitempty"`
 		UpdatedAt    *time.Time `json:"updated_at"    db:"updated_at,omitempty"`
+
+		// Roles of auth user carried on access token claims level.
+		Roles []string `json:"-" db:"-"`
 	}
 
 	// AuthService provides access to service.
@@ -98,7 +101,10 @@ func (Auth) ComposePassword(steamID, userID string) string {
 
 type ctxKey int
 
-const authKey ctxKey = iota
+const (
+	authKey ctxKey = iota
+	accessTokenKey
+)
 
 // AuthToContext sets auth details to context.
 func AuthToContext(parent context.Context, au *Auth) context.Context {
@@ -46,6 +46,8 @@ type (
 		RecentSale *time.Time `json:"recent_sale" db:"recent_sale,omitempty"`
 		CreatedAt  *time.Time `json:"created_at"  db:"created_at,omitempty,indexed"`
 		UpdatedAt  *time.Time `json:"updated_at"  db:"updated_at,omitempty,indexed"`
+		// Currency of market summary prices when converted from base currency.
+		Currency string `json:"currency,omitempty" db:"-"`
 		// Include related fields.
 		Asks []Market `json:"asks" db:"-"`
 		Bids []Market `json:"bids" db:"-"`
@@ -59,6 +61,9 @@ type (
 		// Count returns number of catalog from data store.
 		Count(FindOpts) (int, error)
 
+		// Facets returns aggregated catalog counts of find options from data store.
+		Facets(FindOpts) (*CatalogFacets, error)
+
 		// Get returns catalog details by id from data store.
 		Get(id string) (*Catalog, error)
 
@@ -69,3 +74,23 @@ type (
 		Trending() ([]Catalog, error)
 	}
 )
+
+// FilterExprFields returns catalog fields allowed on filter expressions.
+func (Catalog) FilterExprFields() FilterExprFields {
+	return FilterExprFields{
+		"hero":        FilterTypeString,
+		"origin":      FilterTypeString,
+		"rarity":      FilterTypeString,
+		"quantity":    FilterTypeNumber,
+		"lowest_ask":  FilterTypeNumber,
+		"median_ask":  FilterTypeNumber,
+		"highest_bid": FilterTypeNumber,
+		"bid_count":   FilterTypeNumber,
+		"sale_count":  FilterTypeNumber,
+		"view_count":  FilterTypeNumber,
+		"recent_ask":  FilterTypeTime,
+		"recent_bid":  FilterTypeTime,
+		"created_at":  FilterTypeTime,
+		"updated_at":  FilterTypeTime,
+	}
+}
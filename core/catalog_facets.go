@@ -0,0 +1,110 @@
+package core
+
+import "sort"
+
+// CatalogFacetPriceRanges lower bounds of catalog lowest ask price range
+// buckets in base currency, the last bucket has no upper bound.
+var CatalogFacetPriceRanges = []float64{0, 1, 5, 10, 25, 50, 100}
+
+// catalogFacetFields catalog fields needed to aggregate facets.
+var catalogFacetFields = []string{"hero", "rarity", "origin", "lowest_ask"}
+
+type (
+	// CatalogFacets represents aggregated catalog counts of a search query.
+	CatalogFacets struct {
+		Hero   []FacetCount      `json:"hero"`
+		Rarity []FacetCount      `json:"rarity"`
+		Origin []FacetCount      `json:"origin"`
+		Price  []PriceRangeCount `json:"price"`
+		// Currency of price range bounds when converted from base currency.
+		Currency string `json:"currency,omitempty"`
+	}
+
+	// FacetCount represents number of results having the field value.
+	FacetCount struct {
+		Value string `json:"value"`
+		Count int    `json:"count"`
+	}
+
+	// PriceRangeCount represents number of results with the lowest ask
+	// within min(inclusive) and max(exclusive), zero max has no upper bound.
+	PriceRangeCount struct {
+		Min   float64 `json:"min"`
+		Max   float64 `json:"max"`
+		Count int     `json:"count"`
+	}
+)
+
+// CatalogFacetFindOpts returns find options for fetching fields used on
+// facets of the same query without sorting and pagination.
+func CatalogFacetFindOpts(o FindOpts) FindOpts {
+	return FindOpts{
+		Keyword:       o.Keyword,
+		KeywordFields: o.KeywordFields,
+		IDs:           o.IDs,
+		Filter:        o.Filter,
+		FilterExprs:   o.FilterExprs,
+		UserID:        o.UserID,
+		Fields:        catalogFacetFields,
+	}
+}
+
+// NewCatalogFacets returns facets counts of catalogs by hero, rarity, origin
+// and lowest ask price range. Catalogs without asks are not counted on
+// price ranges.
+func NewCatalogFacets(cc []Catalog) *CatalogFacets {
+	heroes := map[string]int{}
+	rarities := map[string]int{}
+	origins := map[string]int{}
+	prices := make([]PriceRangeCount, len(CatalogFacetPriceRanges))
+	for i, min := range CatalogFacetPriceRanges {
+		prices[i].Min = min
+		if i+1 < len(CatalogFacetPriceRanges) {
+			prices[i].Max = CatalogFacetPriceRanges[i+1]
+		}
+	}
+
+	for _, c := range cc {
+		if c.Hero != "" {
+			heroes[c.Hero]++
+		}
+		if c.Rarity != "" {
+			rarities[c.Rarity]++
+		}
+		if c.Origin != "" {
+			origins[c.Origin]++
+		}
+		if c.LowestAsk <= 0 {
+			continue
+		}
+		for i := len(prices) - 1; i >= 0; i-- {
+			if c.LowestAsk >= prices[i].Min {
+				prices[i].Count++
+				break
+			}
+		}
+	}
+
+	return &CatalogFacets{
+		Hero:   facetCounts(heroes),
+		Rarity: facetCounts(rarities),
+		Origin: facetCounts(origins),
+		Price:  prices,
+	}
+}
+
+// facetCounts returns value counts sorted by most results.
+func facetCounts(m map[string]int) []FacetCount {
+	fc := make([]FacetCount, 0, len(m))
+	for v, n := range m {
+		fc = append(fc, FacetCount{v, n})
+	}
+	sort.Slice(fc, func(i, j int) bool {
+		if fc[i].Count != fc[j].Count {
+			return fc[i].Count > fc[j].Count
+		}
+		return fc[i].Value < fc[j].Value
+	})
+
+	return fc
+}
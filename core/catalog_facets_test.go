@@ -0,0 +1,33 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewCatalogFacets(t *testing.T) {
+	got := NewCatalogFacets([]Catalog{
+		{Hero: "Pudge", Rarity: "immortal", Origin: "The International 10", LowestAsk: 3},
+		{Hero: "Pudge", Rarity: "mythical", Origin: "The International 10", LowestAsk: 1},
+		{Hero: "Axe", Rarity: "immortal", Origin: "Collector's Cache", LowestAsk: 120},
+		{Hero: "Mirana", Rarity: "immortal"},
+	})
+
+	want := &CatalogFacets{
+		Hero:   []FacetCount{{"Pudge", 2}, {"Axe", 1}, {"Mirana", 1}},
+		Rarity: []FacetCount{{"immortal", 3}, {"mythical", 1}},
+		Origin: []FacetCount{{"The International 10", 2}, {"Collector's Cache", 1}},
+		Price: []PriceRangeCount{
+			{0, 1, 0},
+			{1, 5, 2},
+			{5, 10, 0},
+			{10, 25, 0},
+			{25, 50, 0},
+			{50, 100, 0},
+			{100, 0, 1},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewCatalogFacets() = %+v, want %+v", got, want)
+	}
+}
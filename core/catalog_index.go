@@ -0,0 +1,96 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// CatalogIndexRequest represents a pending catalog re-index of an item.
+	CatalogIndexRequest struct {
+		ItemID   string
+		QueuedAt time.Time
+		// Attempts number of failed index attempts of the request.
+		Attempts int
+	}
+
+	// CatalogIndexStats represents catalog index queue depth and counters
+	// since the queue was created.
+	CatalogIndexStats struct {
+		Pending        int        `json:"pending"`
+		OldestQueuedAt *time.Time `json:"oldest_queued_at"`
+		Queued         int        `json:"queued"`
+		Deduplicated   int        `json:"deduplicated"`
+		Indexed        int        `json:"indexed"`
+		Failed         int        `json:"failed"`
+		SLAMissed      int        `json:"sla_missed"`
+		AvgLatency     float64    `json:"avg_latency_seconds"`
+	}
+
+	// CatalogIndexResult represents outcome of a drained batch.
+	CatalogIndexResult struct {
+		Indexed   int
+		Failed    int
+		SLAMissed int
+		Latency   time.Duration
+	}
+
+	// CatalogIndexService provides access to queued catalog re-indexing.
+	CatalogIndexService interface {
+		// Queue adds items for re-indexing, items that are already queued
+		// are only indexed once.
+		Queue(itemIDs ...string) error
+
+		// Drain re-indexes queued items in batches that are past the
+		// debounce window.
+		Drain(context.Context) (*CatalogIndexResult, error)
+
+		// Stats returns catalog index queue metrics and only accessible
+		// to admin and support users.
+		Stats(context.Context) (*CatalogIndexStats, error)
+	}
+
+	// CatalogIndexQueue defines operation for deduplicating catalog index queue.
+	CatalogIndexQueue interface {
+		// Push adds items to the queue and returns number of newly queued
+		// items, already queued items keeps its queued time.
+		Push(itemIDs ...string) (int, error)
+
+		// Pop claims and removes up to n requests queued before time t.
+		Pop(t time.Time, n int) ([]CatalogIndexRequest, error)
+
+		// Retry puts back failed request to be claimed once time t is past
+		// the debounce window, keeping its first queued time and attempts.
+		Retry(r CatalogIndexRequest, t time.Time) error
+
+		// Record adds drained batch result to queue counters.
+		Record(CatalogIndexResult) error
+
+		// Stats returns queue depth and counters.
+		Stats() (*CatalogIndexStats, error)
+	}
+)
+
+// Latency returns elapsed time of the request since it was first queued.
+func (r CatalogIndexRequest) Latency(t time.Time) time.Duration {
+	return t.Sub(r.QueuedAt)
+}
+
+// RetryAt returns time of the next index attempt of failed request that
+// doubles the base delay on every attempt up to max.
+func (r CatalogIndexRequest) RetryAt(t time.Time, base, max time.Duration) time.Time {
+	d := base
+	for i := 1; i < r.Attempts && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+
+	return t.Add(d)
+}
+
+// IsLate returns true when request was not indexed within the sla.
+func (r CatalogIndexRequest) IsLate(t time.Time, sla time.Duration) bool {
+	return sla > 0 && r.Latency(t) > sla
+}
@@ -0,0 +1,48 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCatalogIndexRequest_IsLate(t *testing.T) {
+	queued := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+	r := CatalogIndexRequest{ItemID: "item", QueuedAt: queued}
+	tests := []struct {
+		name string
+		at   time.Time
+		sla  time.Duration
+		want bool
+	}{
+		{"within sla", queued.Add(time.Second * 30), time.Minute, false},
+		{"on sla", queued.Add(time.Minute), time.Minute, false},
+		{"past sla", queued.Add(time.Minute + time.Second), time.Minute, true},
+		{"no sla", queued.Add(time.Hour), 0, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := r.IsLate(tc.at, tc.sla); got != tc.want {
+				t.Errorf("IsLate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCatalogIndexRequest_RetryAt(t *testing.T) {
+	now := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Second * 10},
+		{2, time.Second * 20},
+		{3, time.Second * 40},
+		{10, time.Minute},
+	}
+	for _, tc := range tests {
+		r := CatalogIndexRequest{ItemID: "item", Attempts: tc.attempts}
+		if got := r.RetryAt(now, time.Second*10, time.Minute); !got.Equal(now.Add(tc.want)) {
+			t.Errorf("RetryAt() attempts %d = %s, want %s", tc.attempts, got.Sub(now), tc.want)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+)
+
+// Currency error types.
+const (
+	CurrencyErrNotFound Errors = iota + 2500
+	CurrencyErrNotSupported
+	CurrencyErrRequiredFields
+	CurrencyErrInvalidRate
+	CurrencyErrRatesFile
+)
+
+// sets error text definition.
+func init() {
+	appErrorText[CurrencyErrNotFound] = "exchange rate not found"
+	appErrorText[CurrencyErrNotSupported] = "currency is not supported"
+	appErrorText[CurrencyErrRequiredFields] = "exchange rate fields are required"
+	appErrorText[CurrencyErrInvalidRate] = "exchange rate should be above zero"
+	appErrorText[CurrencyErrRatesFile] = "could not parse exchange rates file"
+}
+
+// BaseCurrency currency where market prices are normalized for catalog market
+// summary, price sorting and matching.
+const BaseCurrency = "USD"
+
+type (
+	// ExchangeRate represents amount of currency equivalent to one base currency.
+	ExchangeRate struct {
+		ID        string     `json:"id"         db:"id,omitempty"`
+		Currency  string     `json:"currency"   db:"currency,omitempty,indexed" valid:"required"`
+		Rate      float64    `json:"rate"       db:"rate,omitempty"             valid:"required"`
+		CreatedAt *time.Time `json:"created_at" db:"created_at,omitempty"`
+		UpdatedAt *time.Time `json:"updated_at" db:"updated_at,omitempty"`
+	}
+
+	// ExchangeRates represents exchange rates table by currency code.
+	ExchangeRates map[string]float64
+
+	// CurrencyService provides access to exchange rates and price conversion.
+	CurrencyService interface {
+		// ExchangeRates returns a list of exchange rates against base currency.
+		ExchangeRates(context.Context) ([]ExchangeRate, error)
+
+		// UpdateExchangeRates saves exchange rates changes and only
+		// accessible to admin users.
+		UpdateExchangeRates(context.Context, []ExchangeRate) error
+
+		// LoadExchangeRates saves exchange rates from a rates file
+		// formatted as JSON object of currency code and its rate.
+		LoadExchangeRates(io.Reader) error
+
+		// Rates returns exchange rates table for converting prices.
+		Rates() (ExchangeRates, error)
+	}
+
+	// ExchangeRateStorage defines operation for exchange rate records.
+	ExchangeRateStorage interface {
+		// Find returns a list of exchange rates from data store.
+		Find(FindOpts) ([]ExchangeRate, error)
+
+		// Get returns exchange rate details by id from data store.
+		Get(id string) (*ExchangeRate, error)
+
+		// Create persists a new exchange rate to data store.
+		Create(*ExchangeRate) error
+
+		// Update persists exchange rate changes to data store.
+		Update(*ExchangeRate) error
+	}
+)
+
+// CheckUpdate validates field on updating exchange rate.
+func (r ExchangeRate) CheckUpdate() error {
+	if err := validator.Struct(r); err != nil {
+		return err
+	}
+	if r.Rate <= 0 {
+		return CurrencyErrInvalidRate
+	}
+	if normalizeCurrency(r.Currency) == BaseCurrency {
+		return CurrencyErrNotSupported
+	}
+
+	return nil
+}
+
+// ParseExchangeRates returns exchange rates from JSON object of currency code
+// and its rate, e.g. {"EUR": 0.92, "PHP": 56.3}.
+func ParseExchangeRates(r io.Reader) ([]ExchangeRate, error) {
+	var m map[string]float64
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return nil, CurrencyErrRatesFile
+	}
+
+	var res []ExchangeRate
+	for c, rate := range m {
+		res = append(res, ExchangeRate{Currency: normalizeCurrency(c), Rate: rate})
+	}
+
+	return res, nil
+}
+
+// NewExchangeRates returns exchange rates table that includes the base currency.
+func NewExchangeRates(rates []ExchangeRate) ExchangeRates {
+	t := ExchangeRates{BaseCurrency: 1}
+	for _, r := range rates {
+		t[normalizeCurrency(r.Currency)] = r.Rate
+	}
+
+	return t
+}
+
+// Convert returns amount converted between currencies through the base currency.
+func (t ExchangeRates) Convert(amount float64, from, to string) (float64, error) {
+	fr, ok := t[normalizeCurrency(from)]
+	if !ok || fr <= 0 {
+		return 0, CurrencyErrNotSupported
+	}
+	tr, ok := t[normalizeCurrency(to)]
+	if !ok || tr <= 0 {
+		return 0, CurrencyErrNotSupported
+	}
+
+	return priceToTenths(amount / fr * tr), nil
+}
+
+// IsSupported returns true when currency exists on the rates table.
+func (t ExchangeRates) IsSupported(currency string) bool {
+	_, ok := t[normalizeCurrency(currency)]
+	return ok
+}
+
+// NormalizePrice keeps the user's listed price and currency and converts
+// market price to base currency.
+func (m *Market) NormalizePrice(t ExchangeRates) error {
+	if m.Currency == "" {
+		m.Currency = BaseCurrency
+	}
+	m.Currency = normalizeCurrency(m.Currency)
+
+	price, err := t.Convert(m.Price, m.Currency, BaseCurrency)
+	if err != nil {
+		return err
+	}
+	// Listed price could be too low after rounding the converted price.
+	if price <= 0 {
+		return MarketErrInvalidPrice
+	}
+
+	m.ListPrice = m.Price
+	m.ListCurrency = m.Currency
+	m.Price = price
+	m.Currency = BaseCurrency
+	return nil
+}
+
+// ConvertPrice converts market price to currency.
+func (m *Market) ConvertPrice(t ExchangeRates, currency string) error {
+	from := m.Currency
+	if from == "" {
+		from = BaseCurrency
+	}
+
+	price, err := t.Convert(m.Price, from, currency)
+	if err != nil {
+		return err
+	}
+
+	m.Price = price
+	m.Currency = normalizeCurrency(currency)
+	return nil
+}
+
+// ConvertPrice converts catalog market summary prices and its entries to currency.
+func (c *Catalog) ConvertPrice(t ExchangeRates, currency string) error {
+	from := c.Currency
+	if from == "" {
+		from = BaseCurrency
+	}
+
+	for _, p := range []*float64{&c.LowestAsk, &c.MedianAsk, &c.HighestBid, &c.AvgSale} {
+		v, err := t.Convert(*p, from, currency)
+		if err != nil {
+			return err
+		}
+		*p = v
+	}
+	for _, mm := range [][]Market{c.Asks, c.Bids} {
+		for i := range mm {
+			if err := mm[i].ConvertPrice(t, currency); err != nil {
+				return err
+			}
+		}
+	}
+
+	c.Currency = normalizeCurrency(currency)
+	return nil
+}
+
+// ConvertPrice converts price range bounds to target currency.
+func (f *CatalogFacets) ConvertPrice(t ExchangeRates, currency string) error {
+	from := f.Currency
+	if from == "" {
+		from = BaseCurrency
+	}
+
+	for i := range f.Price {
+		for _, p := range []*float64{&f.Price[i].Min, &f.Price[i].Max} {
+			v, err := t.Convert(*p, from, currency)
+			if err != nil {
+				return err
+			}
+			*p = v
+		}
+	}
+
+	f.Currency = normalizeCurrency(currency)
+	return nil
+}
+
+func normalizeCurrency(c string) string {
+	return strings.ToUpper(strings.TrimSpace(c))
+}
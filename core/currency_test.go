@@ -0,0 +1,65 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExchangeRates_Convert(t *testing.T) {
+	rates := NewExchangeRates([]ExchangeRate{{Currency: "eur", Rate: 0.5}, {Currency: "PHP", Rate: 50}})
+	tests := []struct {
+		name     string
+		amount   float64
+		from, to string
+		want     float64
+		wantErr  error
+	}{
+		{"base to base", 10, "USD", "USD", 10, nil},
+		{"base to currency", 10, "USD", "EUR", 5, nil},
+		{"currency to base", 500, "php", "USD", 10, nil},
+		{"cross currency", 5, "EUR", "PHP", 500, nil},
+		{"rounds to cents", 1, "PHP", "USD", 0.02, nil},
+		{"unsupported source", 1, "BRL", "USD", 0, CurrencyErrNotSupported},
+		{"unsupported target", 1, "USD", "BRL", 0, CurrencyErrNotSupported},
+	}
+	for _, tc := range tests {
+		got, err := rates.Convert(tc.amount, tc.from, tc.to)
+		if err != tc.wantErr || got != tc.want {
+			t.Errorf("%s: Convert() = %v, %v, want %v, %v", tc.name, got, err, tc.want, tc.wantErr)
+		}
+	}
+}
+
+func TestMarket_NormalizePrice(t *testing.T) {
+	rates := NewExchangeRates([]ExchangeRate{{Currency: "PHP", Rate: 50}})
+
+	m := Market{Price: 250, Currency: "php"}
+	if err := m.NormalizePrice(rates); err != nil {
+		t.Fatalf("NormalizePrice() error = %v", err)
+	}
+	if m.Price != 5 || m.Currency != BaseCurrency || m.ListPrice != 250 || m.ListCurrency != "PHP" {
+		t.Errorf("unexpected normalized market %+v", m)
+	}
+
+	if err := m.ConvertPrice(rates, "PHP"); err != nil || m.Price != 250 || m.Currency != "PHP" {
+		t.Errorf("ConvertPrice() = %+v, %v", m, err)
+	}
+
+	tiny := Market{Price: 0.1, Currency: "PHP"}
+	if err := tiny.NormalizePrice(rates); err != MarketErrInvalidPrice {
+		t.Errorf("too low converted price should be invalid, got %v", err)
+	}
+	if err := (&Market{Price: 1, Currency: "XYZ"}).NormalizePrice(rates); err != CurrencyErrNotSupported {
+		t.Errorf("unknown currency should not be supported, got %v", err)
+	}
+}
+
+func TestParseExchangeRates(t *testing.T) {
+	res, err := ParseExchangeRates(strings.NewReader(`{"eur": 0.92}`))
+	if err != nil || len(res) != 1 || res[0].Currency != "EUR" || res[0].Rate != 0.92 {
+		t.Errorf("ParseExchangeRates() = %+v, %v", res, err)
+	}
+	if _, err = ParseExchangeRates(strings.NewReader(`[]`)); err != CurrencyErrRatesFile {
+		t.Errorf("invalid rates file should fail, got %v", err)
+	}
+}
@@ -0,0 +1,114 @@
+package core
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// cursorFieldTag struct tag used to look up cursor sort field values.
+const cursorFieldTag = "db"
+
+// Cursor represents position of the last record of a page by its sort field
+// value and id as tie-breaker, records that follows it belongs to the next page.
+type Cursor struct {
+	Sort  string      `json:"s"`
+	Desc  bool        `json:"d,omitempty"`
+	Value interface{} `json:"v,omitempty"`
+	Time  *time.Time  `json:"t,omitempty"`
+	ID    string      `json:"i"`
+}
+
+// NewCursor returns cursor of a record sorted by field. Records without
+// id or sort field value cannot be used as cursor and returns nil.
+func NewCursor(record interface{}, sort string, desc bool) *Cursor {
+	v := reflect.Indirect(reflect.ValueOf(record))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	c := &Cursor{Sort: sort, Desc: desc}
+	c.ID, _ = cursorField(v, "id").(string)
+	switch sv := cursorField(v, sort).(type) {
+	case time.Time:
+		c.Time = &sv
+	case *time.Time:
+		c.Time = sv
+	default:
+		c.Value = sv
+	}
+	if c.ID == "" || (c.Value == nil && c.Time == nil) {
+		return nil
+	}
+
+	return c
+}
+
+// DecodeCursor returns cursor from its encoded token.
+func DecodeCursor(token string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, StorageInvalidCursorErr
+	}
+	c := &Cursor{}
+	if err = json.Unmarshal(b, c); err != nil || c.Sort == "" || c.ID == "" {
+		return nil, StorageInvalidCursorErr
+	}
+	if c.Value == nil && c.Time == nil {
+		return nil, StorageInvalidCursorErr
+	}
+
+	return c, nil
+}
+
+// Encode returns opaque cursor token.
+func (c Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// SortValue returns sort field value of the cursor record.
+func (c Cursor) SortValue() interface{} {
+	if c.Time != nil {
+		return *c.Time
+	}
+
+	return c.Value
+}
+
+// NextCursor returns encoded cursor of the last record when the results
+// fills up the page limit, unsorted results have no stable order and has
+// no next cursor.
+func (o FindOpts) NextCursor(n int, last interface{}) string {
+	if o.Sort == "" || o.Limit == 0 || n < o.Limit {
+		return ""
+	}
+
+	c := NewCursor(last, o.Sort, o.Desc)
+	if c == nil {
+		return ""
+	}
+
+	return c.Encode()
+}
+
+// cursorField returns value of the struct field by its tag name.
+func cursorField(v reflect.Value, name string) interface{} {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		tag := strings.Split(t.Field(i).Tag.Get(cursorFieldTag), ",")[0]
+		if tag != name {
+			continue
+		}
+
+		f := v.Field(i)
+		if f.Kind() == reflect.Ptr && f.IsNil() {
+			return nil
+		}
+		return f.Interface()
+	}
+
+	return nil
+}
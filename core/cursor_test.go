@@ -0,0 +1,49 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCursor_Encode(t *testing.T) {
+	now := time.Date(2023, 5, 1, 10, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		record interface{}
+		sort   string
+		want   interface{}
+	}{
+		{"number", Market{ID: "m1", Price: 2.5}, "price", 2.5},
+		{"time", &Market{ID: "m1", CreatedAt: &now}, "created_at", now},
+		{"string", Catalog{ID: "c1", Name: "Gothic Whisper"}, "name", "Gothic Whisper"},
+	}
+	for _, tc := range tests {
+		c := NewCursor(tc.record, tc.sort, true)
+		if c == nil {
+			t.Fatalf("%s: NewCursor() = nil", tc.name)
+		}
+		got, err := DecodeCursor(c.Encode())
+		if err != nil {
+			t.Fatalf("%s: DecodeCursor() error = %v", tc.name, err)
+		}
+		v := got.SortValue()
+		if tm, ok := v.(time.Time); ok {
+			v = tm.UTC()
+		}
+		if got.Sort != tc.sort || !got.Desc || got.ID == "" || v != tc.want {
+			t.Errorf("%s: DecodeCursor() = %+v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestNewCursor_empty(t *testing.T) {
+	if c := NewCursor(Market{ID: "m1"}, "created_at", false); c != nil {
+		t.Errorf("NewCursor() without sort value = %+v, want nil", c)
+	}
+	if c := NewCursor(Market{Price: 1}, "price", false); c != nil {
+		t.Errorf("NewCursor() without id = %+v, want nil", c)
+	}
+	if _, err := DecodeCursor("not-a-cursor"); err != StorageInvalidCursorErr {
+		t.Errorf("DecodeCursor() error = %v, want %v", err, StorageInvalidCursorErr)
+	}
+}
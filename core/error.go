@@ -2,6 +2,8 @@ package core
 
 //go:generate stringer -type=Errors -output=errors_string.go
 
+import "sort"
+
 var appErrorText = map[Errors]string{}
 
 // Errors represents app's error.
@@ -16,3 +18,16 @@ func (i Errors) Error() string {
 func (i Errors) Code() string {
 	return i.String()
 }
+
+// ErrorTypes returns registered error types ordered by code.
+func ErrorTypes() []Errors {
+	tt := make([]Errors, 0, len(appErrorText))
+	for t := range appErrorText {
+		tt = append(tt, t)
+	}
+	sort.Slice(tt, func(i, j int) bool {
+		return tt[i] < tt[j]
+	})
+
+	return tt
+}
@@ -8,6 +8,13 @@ func _() {
 	// An "invalid array index" compiler error signifies that the constant values have changed.
 	// Re-run the stringer command to generate them again.
 	var x [1]struct{}
+	_ = x[AccessTokenErrNotFound-1200]
+	_ = x[AccessTokenErrRequiredID-1201]
+	_ = x[AccessTokenErrRequiredFields-1202]
+	_ = x[AccessTokenErrInvalidScope-1203]
+	_ = x[AccessTokenErrLimitReached-1204]
+	_ = x[AccessTokenErrRevoked-1205]
+	_ = x[AccessTokenErrExpired-1206]
 	_ = x[AuthErrNotFound-1000]
 	_ = x[AuthErrRequiredID-1001]
 	_ = x[AuthErrRequiredFields-1002]
@@ -18,6 +25,11 @@ func _() {
 	_ = x[CatalogErrNotFound-2200]
 	_ = x[CatalogErrRequiredID-2201]
 	_ = x[CatalogErrIndexing-2202]
+	_ = x[CurrencyErrNotFound-2500]
+	_ = x[CurrencyErrNotSupported-2501]
+	_ = x[CurrencyErrRequiredFields-2502]
+	_ = x[CurrencyErrInvalidRate-2503]
+	_ = x[CurrencyErrRatesFile-2504]
 	_ = x[ImageErrNotFound-3000]
 	_ = x[ImageErrUpload-3001]
 	_ = x[ImageErrThumbnail-3002]
@@ -36,11 +48,46 @@ func _() {
 	_ = x[MarketErrRequiredPartnerURL-2107]
 	_ = x[MarketErrInvalidBidPrice-2108]
 	_ = x[MarketErrInvalidAskPrice-2109]
+	_ = x[MarketErrInvalidStatusTransition-2110]
+	_ = x[MarketErrStatusChanged-2111]
+	_ = x[MarketMatchErrNotFound-2300]
+	_ = x[MarketMatchErrRequiredID-2301]
+	_ = x[MarketMatchErrNotPending-2302]
+	_ = x[MarketMatchErrMarketChanged-2303]
+	_ = x[NotificationErrNotFound-7100]
+	_ = x[NotificationErrRequiredFields-7101]
+	_ = x[NotificationErrInvalidEvent-7102]
+	_ = x[NotificationErrInvalidWebhookURL-7103]
+	_ = x[NotificationErrInvalidEmailCode-7104]
+	_ = x[OfferErrNotFound-2400]
+	_ = x[OfferErrRequiredID-2401]
+	_ = x[OfferErrRequiredFields-2402]
+	_ = x[OfferErrInvalidPrice-2403]
+	_ = x[OfferErrNotPending-2404]
+	_ = x[OfferErrNotAllowed-2405]
+	_ = x[OfferErrMarketNotAvailable-2406]
+	_ = x[OfferErrDuplicate-2407]
+	_ = x[OfferErrExpired-2408]
+	_ = x[PriceHistoryErrNotFound-2600]
+	_ = x[PriceHistoryErrInvalidInterval-2601]
+	_ = x[RateLimitErrExceeded-8000]
+	_ = x[RateLimitErrInvalid-8001]
 	_ = x[ReportErrNotFound-5000]
 	_ = x[ReportErrRequiredID-5001]
 	_ = x[ReportErrRequiredFields-5002]
+	_ = x[RoleErrNotFound-1300]
+	_ = x[RoleErrRequiredFields-1301]
+	_ = x[RoleErrInvalid-1302]
+	_ = x[RoleErrSelfRevoke-1303]
 	_ = x[StorageUncaughtErr-100]
 	_ = x[StorageMergeErr-101]
+	_ = x[StorageInvalidCursorErr-102]
+	_ = x[StorageInvalidFilterErr-103]
+	_ = x[SynonymErrNotFound-2700]
+	_ = x[SynonymErrRequiredID-2701]
+	_ = x[SynonymErrRequiredFields-2702]
+	_ = x[SynonymErrInvalidTerm-2703]
+	_ = x[SynonymErrDuplicate-2704]
 	_ = x[TrackErrNotFound-4000]
 	_ = x[UserErrNotFound-1100]
 	_ = x[UserErrRequiredID-1101]
@@ -55,58 +102,129 @@ func _() {
 	_ = x[InventoryErrNotFound-6100]
 	_ = x[InventoryErrRequiredID-6101]
 	_ = x[InventoryErrRequiredFields-6102]
+	_ = x[WatchlistErrNotFound-7200]
+	_ = x[WatchlistErrRequiredID-7201]
+	_ = x[WatchlistErrRequiredFields-7202]
+	_ = x[WatchlistErrRequiredThreshold-7203]
+	_ = x[WatchlistErrDuplicateItem-7204]
+	_ = x[WatchlistErrLimitReached-7205]
+	_ = x[WebhookErrNotFound-7000]
+	_ = x[WebhookErrRequiredID-7001]
+	_ = x[WebhookErrRequiredFields-7002]
+	_ = x[WebhookErrInvalidEvent-7003]
+	_ = x[WebhookErrLimitReached-7004]
+	_ = x[WebhookErrInvalidURL-7005]
 }
 
-const _Errors_name = "StorageUncaughtErrStorageMergeErrAuthErrNotFoundAuthErrRequiredIDAuthErrRequiredFieldsAuthErrNoAccessAuthErrForbiddenAuthErrLoginAuthErrRefreshTokenUserErrNotFoundUserErrRequiredIDUserErrRequiredFieldsUserErrProfileImageDLUserErrSteamSyncUserErrSuspendedUserErrBannedItemErrNotFoundItemErrRequiredIDItemErrRequiredFieldsItemErrCreateItemExistsItemErrImportMarketErrNotFoundMarketErrRequiredIDMarketErrRequiredFieldsMarketErrInvalidStatusMarketErrNotesLimitMarketErrInvalidPriceMarketErrQtyLimitPerUserMarketErrRequiredPartnerURLMarketErrInvalidBidPriceMarketErrInvalidAskPriceCatalogErrNotFoundCatalogErrRequiredIDCatalogErrIndexingImageErrNotFoundImageErrUploadImageErrThumbnailTrackErrNotFoundReportErrNotFoundReportErrRequiredIDReportErrRequiredFieldsDeliveryErrNotFoundDeliveryErrRequiredIDDeliveryErrRequiredFieldsInventoryErrNotFoundInventoryErrRequiredIDInventoryErrRequiredFields"
+const _Errors_name = "StorageUncaughtErrStorageMergeErrStorageInvalidCursorErrStorageInvalidFilterErrAuthErrNotFoundAuthErrRequiredIDAuthErrRequiredFieldsAuthErrNoAccessAuthErrForbiddenAuthErrLoginAuthErrRefreshTokenUserErrNotFoundUserErrRequiredIDUserErrRequiredFieldsUserErrProfileImageDLUserErrSteamSyncUserErrSuspendedUserErrBannedAccessTokenErrNotFoundAccessTokenErrRequiredIDAccessTokenErrRequiredFieldsAccessTokenErrInvalidScopeAccessTokenErrLimitReachedAccessTokenErrRevokedAccessTokenErrExpiredRoleErrNotFoundRoleErrRequiredFieldsRoleErrInvalidRoleErrSelfRevokeItemErrNotFoundItemErrRequiredIDItemErrRequiredFieldsItemErrCreateItemExistsItemErrImportMarketErrNotFoundMarketErrRequiredIDMarketErrRequiredFieldsMarketErrInvalidStatusMarketErrNotesLimitMarketErrInvalidPriceMarketErrQtyLimitPerUserMarketErrRequiredPartnerURLMarketErrInvalidBidPriceMarketErrInvalidAskPriceMarketErrInvalidStatusTransitionMarketErrStatusChangedCatalogErrNotFoundCatalogErrRequiredIDCatalogErrIndexingMarketMatchErrNotFoundMarketMatchErrRequiredIDMarketMatchErrNotPendingMarketMatchErrMarketChangedOfferErrNotFoundOfferErrRequiredIDOfferErrRequiredFieldsOfferErrInvalidPriceOfferErrNotPendingOfferErrNotAllowedOfferErrMarketNotAvailableOfferErrDuplicateOfferErrExpiredCurrencyErrNotFoundCurrencyErrNotSupportedCurrencyErrRequiredFieldsCurrencyErrInvalidRateCurrencyErrRatesFilePriceHistoryErrNotFoundPriceHistoryErrInvalidIntervalSynonymErrNotFoundSynonymErrRequiredIDSynonymErrRequiredFieldsSynonymErrInvalidTermSynonymErrDuplicateImageErrNotFoundImageErrUploadImageErrThumbnailTrackErrNotFoundReportErrNotFoundReportErrRequiredIDReportErrRequiredFieldsDeliveryErrNotFoundDeliveryErrRequiredIDDeliveryErrRequiredFieldsInventoryErrNotFoundInventoryErrRequiredIDInventoryErrRequiredFieldsWebhookErrNotFoundWebhookErrRequiredIDWebhookErrRequiredFieldsWebhookErrInvalidEventWebhookErrLimitReachedWebhookErrInvalidURLNotificationErrNotFoundNotificationErrRequiredFieldsNotificationErrInvalidEventNotificationErrInvalidWebhookURLNotificationErrInvalidEmailCodeWatchlistErrNotFoundWatchlistErrRequiredIDWatchlistErrRequiredFieldsWatchlistErrRequiredThresholdWatchlistErrDuplicateItemWatchlistErrLimitReachedRateLimitErrExceededRateLimitErrInvalid"
 
 var _Errors_map = map[Errors]string{
 	100:  _Errors_name[0:18],
 	101:  _Errors_name[18:33],
-	1000: _Errors_name[33:48],
-	1001: _Errors_name[48:65],
-	1002: _Errors_name[65:86],
-	1003: _Errors_name[86:101],
-	1004: _Errors_name[101:117],
-	1005: _Errors_name[117:129],
-	1006: _Errors_name[129:148],
-	1100: _Errors_name[148:163],
-	1101: _Errors_name[163:180],
-	1102: _Errors_name[180:201],
-	1103: _Errors_name[201:222],
-	1104: _Errors_name[222:238],
-	1105: _Errors_name[238:254],
-	1106: _Errors_name[254:267],
-	2000: _Errors_name[267:282],
-	2001: _Errors_name[282:299],
-	2002: _Errors_name[299:320],
-	2003: _Errors_name[320:343],
-	2004: _Errors_name[343:356],
-	2100: _Errors_name[356:373],
-	2101: _Errors_name[373:392],
-	2102: _Errors_name[392:415],
-	2103: _Errors_name[415:437],
-	2104: _Errors_name[437:456],
-	2105: _Errors_name[456:477],
-	2106: _Errors_name[477:501],
-	2107: _Errors_name[501:528],
-	2108: _Errors_name[528:552],
-	2109: _Errors_name[552:576],
-	2200: _Errors_name[576:594],
-	2201: _Errors_name[594:614],
-	2202: _Errors_name[614:632],
-	3000: _Errors_name[632:648],
-	3001: _Errors_name[648:662],
-	3002: _Errors_name[662:679],
-	4000: _Errors_name[679:695],
-	5000: _Errors_name[695:712],
-	5001: _Errors_name[712:731],
-	5002: _Errors_name[731:754],
-	6000: _Errors_name[754:773],
-	6001: _Errors_name[773:794],
-	6002: _Errors_name[794:819],
-	6100: _Errors_name[819:839],
-	6101: _Errors_name[839:861],
-	6102: _Errors_name[861:887],
+	102:  _Errors_name[33:56],
+	103:  _Errors_name[56:79],
+	1000: _Errors_name[79:94],
+	1001: _Errors_name[94:111],
+	1002: _Errors_name[111:132],
+	1003: _Errors_name[132:147],
+	1004: _Errors_name[147:163],
+	1005: _Errors_name[163:175],
+	1006: _Errors_name[175:194],
+	1100: _Errors_name[194:209],
+	1101: _Errors_name[209:226],
+	1102: _Errors_name[226:247],
+	1103: _Errors_name[247:268],
+	1104: _Errors_name[268:284],
+	1105: _Errors_name[284:300],
+	1106: _Errors_name[300:313],
+	1200: _Errors_name[313:335],
+	1201: _Errors_name[335:359],
+	1202: _Errors_name[359:387],
+	1203: _Errors_name[387:413],
+	1204: _Errors_name[413:439],
+	1205: _Errors_name[439:460],
+	1206: _Errors_name[460:481],
+	1300: _Errors_name[481:496],
+	1301: _Errors_name[496:517],
+	1302: _Errors_name[517:531],
+	1303: _Errors_name[531:548],
+	2000: _Errors_name[548:563],
+	2001: _Errors_name[563:580],
+	2002: _Errors_name[580:601],
+	2003: _Errors_name[601:624],
+	2004: _Errors_name[624:637],
+	2100: _Errors_name[637:654],
+	2101: _Errors_name[654:673],
+	2102: _Errors_name[673:696],
+	2103: _Errors_name[696:718],
+	2104: _Errors_name[718:737],
+	2105: _Errors_name[737:758],
+	2106: _Errors_name[758:782],
+	2107: _Errors_name[782:809],
+	2108: _Errors_name[809:833],
+	2109: _Errors_name[833:857],
+	2110: _Errors_name[857:889],
+	2111: _Errors_name[889:911],
+	2200: _Errors_name[911:929],
+	2201: _Errors_name[929:949],
+	2202: _Errors_name[949:967],
+	2300: _Errors_name[967:989],
+	2301: _Errors_name[989:1013],
+	2302: _Errors_name[1013:1037],
+	2303: _Errors_name[1037:1064],
+	2400: _Errors_name[1064:1080],
+	2401: _Errors_name[1080:1098],
+	2402: _Errors_name[1098:1120],
+	2403: _Errors_name[1120:1140],
+	2404: _Errors_name[1140:1158],
+	2405: _Errors_name[1158:1176],
+	2406: _Errors_name[1176:1202],
+	2407: _Errors_name[1202:1219],
+	2408: _Errors_name[1219:1234],
+	2500: _Errors_name[1234:1253],
+	2501: _Errors_name[1253:1276],
+	2502: _Errors_name[1276:1301],
+	2503: _Errors_name[1301:1323],
+	2504: _Errors_name[1323:1343],
+	2600: _Errors_name[1343:1366],
+	2601: _Errors_name[1366:1396],
+	2700: _Errors_name[1396:1414],
+	2701: _Errors_name[1414:1434],
+	2702: _Errors_name[1434:1458],
+	2703: _Errors_name[1458:1479],
+	2704: _Errors_name[1479:1498],
+	3000: _Errors_name[1498:1514],
+	3001: _Errors_name[1514:1528],
+	3002: _Errors_name[1528:1545],
+	4000: _Errors_name[1545:1561],
+	5000: _Errors_name[1561:1578],
+	5001: _Errors_name[1578:1597],
+	5002: _Errors_name[1597:1620],
+	6000: _Errors_name[1620:1639],
+	6001: _Errors_name[1639:1660],
+	6002: _Errors_name[1660:1685],
+	6100: _Errors_name[1685:1705],
+	6101: _Errors_name[1705:1727],
+	6102: _Errors_name[1727:1753],
+	7000: _Errors_name[1753:1771],
+	7001: _Errors_name[1771:1791],
+	7002: _Errors_name[1791:1815],
+	7003: _Errors_name[1815:1837],
+	7004: _Errors_name[1837:1859],
+	7005: _Errors_name[1859:1879],
+	7100: _Errors_name[1879:1902],
+	7101: _Errors_name[1902:1931],
+	7102: _Errors_name[1931:1958],
+	7103: _Errors_name[1958:1990],
+	7104: _Errors_name[1990:2021],
+	7200: _Errors_name[2021:2041],
+	7201: _Errors_name[2041:2063],
+	7202: _Errors_name[2063:2089],
+	7203: _Errors_name[2089:2118],
+	7204: _Errors_name[2118:2143],
+	7205: _Errors_name[2143:2167],
+	8000: _Errors_name[2167:2187],
+	8001: _Errors_name[2187:2206],
 }
 
 func (i Errors) String() string {
@@ -0,0 +1,130 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Filter expression operators.
+const (
+	FilterOpGt  FilterOp = "gt"
+	FilterOpGte FilterOp = "gte"
+	FilterOpLt  FilterOp = "lt"
+	FilterOpLte FilterOp = "lte"
+	FilterOpIn  FilterOp = "in"
+)
+
+// Filter expression value types.
+const (
+	FilterTypeNumber FilterType = iota + 1
+	FilterTypeTime
+	FilterTypeString
+)
+
+// filterExprKeyRe matches filter expression query key, e.g. price[gte].
+var filterExprKeyRe = regexp.MustCompile(`^([a-z0-9_]+)\[([a-z]+)\]$`)
+
+type (
+	// FilterOp represents filter expression comparison operator.
+	FilterOp string
+
+	// FilterType represents value type of filter expression field.
+	FilterType uint
+
+	// FilterExpr represents field comparison filter, e.g. price[gte]=5 or
+	// status[in]=200,300. Number values are float64 and time values are
+	// time.Time.
+	FilterExpr struct {
+		Field  string
+		Op     FilterOp
+		Type   FilterType
+		Values []interface{}
+	}
+
+	// FilterExprFields represents resource fields allowed on filter
+	// expressions and its value type.
+	FilterExprFields map[string]FilterType
+
+	// ExprFilterer represents resource that supports filter expressions.
+	ExprFilterer interface {
+		FilterExprFields() FilterExprFields
+	}
+)
+
+// Value returns the first value of the expression used by comparison operators.
+func (e FilterExpr) Value() interface{} {
+	if len(e.Values) == 0 {
+		return nil
+	}
+
+	return e.Values[0]
+}
+
+// IsFilterExprKey checks query key uses filter expression syntax.
+func IsFilterExprKey(key string) bool {
+	return filterExprKeyRe.MatchString(key)
+}
+
+// ParseFilterExpr returns filter expression from query key and value
+// validated against resource fields, e.g. key price[gte] and value 5.
+func ParseFilterExpr(fields FilterExprFields, key, value string) (*FilterExpr, error) {
+	m := filterExprKeyRe.FindStringSubmatch(key)
+	if m == nil {
+		return nil, fmt.Errorf("%s is not a filter expression", key)
+	}
+	e := &FilterExpr{Field: m[1], Op: FilterOp(m[2])}
+
+	t, ok := fields[e.Field]
+	if !ok {
+		return nil, fmt.Errorf("field %s does not support filter expression", e.Field)
+	}
+	e.Type = t
+
+	raw := []string{value}
+	switch e.Op {
+	case FilterOpGt, FilterOpGte, FilterOpLt, FilterOpLte:
+		if t == FilterTypeString {
+			return nil, fmt.Errorf("field %s does not support %s operator", e.Field, e.Op)
+		}
+	case FilterOpIn:
+		raw = strings.Split(value, ",")
+	default:
+		return nil, fmt.Errorf("unknown filter operator %s", e.Op)
+	}
+
+	for _, s := range raw {
+		v, err := parseFilterValue(t, strings.TrimSpace(s))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value: %s", key, err)
+		}
+		e.Values = append(e.Values, v)
+	}
+
+	return e, nil
+}
+
+// filterTimeLayouts accepted time value formats.
+var filterTimeLayouts = []string{time.RFC3339Nano, "2006-01-02"}
+
+func parseFilterValue(t FilterType, s string) (interface{}, error) {
+	if s == "" {
+		return nil, fmt.Errorf("value is required")
+	}
+
+	switch t {
+	case FilterTypeNumber:
+		return strconv.ParseFloat(s, 64)
+	case FilterTypeTime:
+		for _, l := range filterTimeLayouts {
+			if v, err := time.Parse(l, s); err == nil {
+				return v, nil
+			}
+		}
+		return nil, fmt.Errorf("%s is not RFC3339 or YYYY-MM-DD time", s)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,39 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseFilterExpr(t *testing.T) {
+	fields := Market{}.FilterExprFields()
+	tests := []struct {
+		name       string
+		key, value string
+		want       *FilterExpr
+		wantErr    bool
+	}{
+		{"number range", "price[gte]", "5", &FilterExpr{"price", FilterOpGte, FilterTypeNumber, []interface{}{5.0}}, false},
+		{"number list", "status[in]", "200, 300", &FilterExpr{"status", FilterOpIn, FilterTypeNumber, []interface{}{200.0, 300.0}}, false},
+		{"date", "created_at[gt]", "2023-05-01", &FilterExpr{"created_at", FilterOpGt, FilterTypeTime, []interface{}{time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)}}, false},
+		{"string list", "item_id[in]", "i1,i2", &FilterExpr{"item_id", FilterOpIn, FilterTypeString, []interface{}{"i1", "i2"}}, false},
+		{"string range", "item_id[gt]", "i1", nil, true},
+		{"unknown field", "notes[in]", "a", nil, true},
+		{"unknown operator", "price[between]", "1,2", nil, true},
+		{"invalid number", "price[lte]", "cheap", nil, true},
+		{"invalid time", "created_at[gt]", "last week", nil, true},
+		{"empty list value", "status[in]", "200,", nil, true},
+		{"not an expression", "price", "5", nil, true},
+	}
+	for _, tc := range tests {
+		got, err := ParseFilterExpr(fields, tc.key, tc.value)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%s: ParseFilterExpr() error = %v, wantErr %v", tc.name, err, tc.wantErr)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: ParseFilterExpr() = %+v, want %+v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -5,12 +5,15 @@ type (
 	FindOpts struct {
 		Keyword       string
 		KeywordFields []string
+		IDs           []string // Limits results to records by id, e.g. search index hits.
 		Filter        interface{}
+		FilterExprs   []FilterExpr // Field comparisons on top of exact match filter, e.g. price range.
 		UserID        string
 		Sort          string
 		Desc          bool
 		Page          int
 		Limit         int
+		Cursor        *Cursor // Continues after the cursor record instead of page offset, uses the same sort.
 		Fields        []string
 		WithMeta      bool
 		// Advance options
@@ -21,5 +24,9 @@ type (
 	FindMetadata struct {
 		ResultCount int
 		TotalCount  int
+		// Facets aggregated counts of catalog results.
+		Facets *CatalogFacets
+		// NextCursor encoded cursor of the next page.
+		NextCursor string
 	}
 )
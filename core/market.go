@@ -19,6 +19,8 @@ const (
 	MarketErrRequiredPartnerURL
 	MarketErrInvalidBidPrice
 	MarketErrInvalidAskPrice
+	MarketErrInvalidStatusTransition
+	MarketErrStatusChanged
 )
 
 // sets error text definition.
@@ -33,6 +35,8 @@ func init() {
 	appErrorText[MarketErrRequiredPartnerURL] = "market partner steam url is required"
 	appErrorText[MarketErrInvalidBidPrice] = "market bid should be lower than lowest ask price"
 	appErrorText[MarketErrInvalidAskPrice] = "market ask should be higher than highest bid price"
+	appErrorText[MarketErrInvalidStatusTransition] = "market status transition not allowed"
+	appErrorText[MarketErrStatusChanged] = "market status was changed by another request"
 }
 
 const (
@@ -55,14 +59,15 @@ const (
 
 // Market statuses.
 const (
-	MarketStatusPending      MarketStatus = 100
-	MarketStatusLive         MarketStatus = 200
-	MarketStatusReserved     MarketStatus = 300
-	MarketStatusSold         MarketStatus = 400
-	MarketStatusBidCompleted MarketStatus = 410
-	MarketStatusRemoved      MarketStatus = 500
-	MarketStatusCancelled    MarketStatus = 600
-	MarketStatusExpired      MarketStatus = 700
+	MarketStatusPending        MarketStatus = 100
+	MarketStatusLive           MarketStatus = 200
+	MarketStatusReservePending MarketStatus = 250 // matched entry waiting for both users to confirm
+	MarketStatusReserved       MarketStatus = 300
+	MarketStatusSold           MarketStatus = 400
+	MarketStatusBidCompleted   MarketStatus = 410
+	MarketStatusRemoved        MarketStatus = 500
+	MarketStatusCancelled      MarketStatus = 600
+	MarketStatusExpired        MarketStatus = 700
 )
 
 // Market trending score rates.
@@ -82,6 +87,9 @@ type (
 	MarketStatus uint
 
 	// Market represents market information.
+	//
+	// Price is normalized to base currency while list price and currency
+	// keeps the price that the user listed.
 	Market struct {
 		ID             string       `json:"id"               db:"id,omitempty"`
 		UserID         string       `json:"user_id"          db:"user_id,omitempty,indexed"   valid:"required"`
@@ -90,6 +98,8 @@ type (
 		Status         MarketStatus `json:"status"           db:"status,omitempty,indexed"    valid:"required"`
 		Price          float64      `json:"price"            db:"price,omitempty,indexed"     valid:"required"`
 		Currency       string       `json:"currency"         db:"currency,omitempty"`
+		ListPrice      float64      `json:"list_price"       db:"list_price,omitempty"`
+		ListCurrency   string       `json:"list_currency"    db:"list_currency,omitempty"`
 		PartnerSteamID string       `json:"partner_steam_id" db:"partner_steam_id,omitempty"`
 		Notes          string       `json:"notes"            db:"notes,omitempty"`
 		CreatedAt      *time.Time   `json:"created_at"       db:"created_at,omitempty,indexed"`
@@ -137,6 +147,10 @@ type (
 		// resolve it by setting complete-bid status.
 		AutoCompleteBid(ctx context.Context, ask Market, partnerSteamID string) error
 
+		// History returns status transitions of a market entry
+		// that is only accessible to its owner and staff users.
+		History(ctx context.Context, id string) ([]MarketHistory, error)
+
 		// Catalog returns a list of catalogs.
 		Catalog(opts FindOpts) ([]Catalog, *FindMetadata, error)
 
@@ -168,6 +182,11 @@ type (
 		// will not update updated_at field.
 		BaseUpdate(*Market) error
 
+		// UpdateIfStatus persists market changes to data store only when
+		// its stored status is still the expected status, returns
+		// MarketErrStatusChanged otherwise.
+		UpdateIfStatus(in *Market, status MarketStatus) error
+
 		// PendingInventoryStatus returns market entries that is pending for checking
 		// inventory status or needs re-processing of re-process error status.
 		PendingInventoryStatus(o FindOpts) ([]Market, error)
@@ -184,22 +203,26 @@ type (
 		// UpdateUserScore sets new rank score value of all live market by user ID.
 		UpdateUserScore(userID string, rankScore int) error
 
-		// UpdateExpiring sets live items to expired status by expiration time.
-		UpdateExpiring(t MarketType, b UserBoon, expiration time.Time) (itemIDs []string, err error)
+		// UpdateExpiring sets live items to expired status by expiration time
+		// and returns the affected markets.
+		UpdateExpiring(t MarketType, b UserBoon, expiration time.Time) ([]Market, error)
 
-		BulkDeleteByStatus(ms MarketStatus, cutOff time.Time, limit int) error
+		// BulkDeleteByStatus deletes markets by status older than cutOff time
+		// and returns the deleted markets.
+		BulkDeleteByStatus(ms MarketStatus, cutOff time.Time, limit int) ([]Market, error)
 	}
 )
 
 var MarketStatusTexts = map[MarketStatus]string{
-	MarketStatusPending:      "pending",
-	MarketStatusLive:         "live",
-	MarketStatusReserved:     "reserved",
-	MarketStatusSold:         "sold",
-	MarketStatusBidCompleted: "completed",
-	MarketStatusRemoved:      "removed",
-	MarketStatusCancelled:    "cancelled",
-	MarketStatusExpired:      "expired",
+	MarketStatusPending:        "pending",
+	MarketStatusLive:           "live",
+	MarketStatusReservePending: "reserve_pending",
+	MarketStatusReserved:       "reserved",
+	MarketStatusSold:           "sold",
+	MarketStatusBidCompleted:   "completed",
+	MarketStatusRemoved:        "removed",
+	MarketStatusCancelled:      "cancelled",
+	MarketStatusExpired:        "expired",
 }
 
 // CheckCreate validates field on creating new market.
@@ -240,12 +263,13 @@ func (m Market) CheckUpdate() error {
 	return nil
 }
 
-const defaultCurrency = "USD"
-
 // SetDefaults sets default values for a new market.
 func (m *Market) SetDefaults() {
 	m.Status = MarketStatusLive
-	m.Currency = defaultCurrency
+	m.Currency = normalizeCurrency(m.Currency)
+	if m.Currency == "" {
+		m.Currency = BaseCurrency
+	}
 	m.Price = priceToTenths(m.Price)
 	if m.Type == 0 {
 		m.Type = MarketTypeAsk
@@ -266,3 +290,18 @@ func priceToTenths(n float64) float64 {
 	const dec = 100
 	return math.Round(n*dec) / dec
 }
+
+// FilterExprFields returns market fields allowed on filter expressions.
+func (Market) FilterExprFields() FilterExprFields {
+	return FilterExprFields{
+		"item_id":          FilterTypeString,
+		"type":             FilterTypeNumber,
+		"status":           FilterTypeNumber,
+		"price":            FilterTypeNumber,
+		"inventory_status": FilterTypeNumber,
+		"delivery_status":  FilterTypeNumber,
+		"user_rank_score":  FilterTypeNumber,
+		"created_at":       FilterTypeTime,
+		"updated_at":       FilterTypeTime,
+	}
+}
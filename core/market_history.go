@@ -0,0 +1,57 @@
+package core
+
+import "time"
+
+// Market history sources where status changes came from.
+const (
+	MarketHistorySourceUpdate      = "market_update"
+	MarketHistorySourceAutoBid     = "auto_complete_bid"
+	MarketHistorySourceHammer      = "hammer"
+	MarketHistorySourceExpiring    = "expiring_market"
+	MarketHistorySourceSweepMarket = "sweep_market"
+	MarketHistorySourceMatch       = "market_match"
+	MarketHistorySourceOffer       = "offer"
+)
+
+type (
+	// MarketHistory represents an immutable market status transition entry.
+	//
+	// Status with zero value means the market entry was deleted.
+	MarketHistory struct {
+		ID         string       `json:"id"          db:"id,omitempty"`
+		MarketID   string       `json:"market_id"   db:"market_id,omitempty,indexed"`
+		UserID     string       `json:"user_id"     db:"user_id,omitempty"` // market owner kept after deletion
+		ActorID    string       `json:"actor_id"    db:"actor_id,omitempty"`
+		Source     string       `json:"source"      db:"source,omitempty"`
+		PrevStatus MarketStatus `json:"prev_status" db:"prev_status,omitempty"`
+		Status     MarketStatus `json:"status"      db:"status,omitempty"`
+		CreatedAt  *time.Time   `json:"created_at"  db:"created_at,omitempty,indexed"`
+	}
+
+	// MarketHistoryStorage defines operation for market history records.
+	MarketHistoryStorage interface {
+		// Find returns status transitions of a market from data store
+		// ordered by oldest entry first.
+		Find(marketID string) ([]MarketHistory, error)
+
+		// Create persists new market history entries to data store.
+		Create(...MarketHistory) error
+	}
+)
+
+// NewMarketHistory returns a status transition entry of a market.
+func NewMarketHistory(m Market, prev MarketStatus, actorID, source string) MarketHistory {
+	return MarketHistory{
+		MarketID:   m.ID,
+		UserID:     m.UserID,
+		ActorID:    actorID,
+		Source:     source,
+		PrevStatus: prev,
+		Status:     m.Status,
+	}
+}
+
+// IsDeleted returns true when history entry marks market deletion.
+func (h MarketHistory) IsDeleted() bool {
+	return h.Status == 0
+}
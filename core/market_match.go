@@ -0,0 +1,194 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+// Market match error types.
+const (
+	MarketMatchErrNotFound Errors = iota + 2300
+	MarketMatchErrRequiredID
+	MarketMatchErrNotPending
+	MarketMatchErrMarketChanged
+)
+
+// sets error text definition.
+func init() {
+	appErrorText[MarketMatchErrNotFound] = "market match not found"
+	appErrorText[MarketMatchErrRequiredID] = "market match id is required"
+	appErrorText[MarketMatchErrNotPending] = "market match is already resolved"
+	appErrorText[MarketMatchErrMarketChanged] = "market match entries are no longer available"
+}
+
+// Market match statuses.
+const (
+	MarketMatchStatusPending   MarketMatchStatus = 100
+	MarketMatchStatusConfirmed MarketMatchStatus = 200
+	MarketMatchStatusDeclined  MarketMatchStatus = 300
+	MarketMatchStatusExpired   MarketMatchStatus = 400
+)
+
+// MarketMatchExpiration duration of pending match before its entries are
+// released back to live.
+const MarketMatchExpiration = time.Hour * 48
+
+var marketMatchStatusTexts = map[MarketMatchStatus]string{
+	MarketMatchStatusPending:   "pending",
+	MarketMatchStatusConfirmed: "confirmed",
+	MarketMatchStatusDeclined:  "declined",
+	MarketMatchStatusExpired:   "expired",
+}
+
+type (
+	// MarketMatchStatus represents market match status.
+	MarketMatchStatus uint
+
+	// MarketMatch represents paired ask and bid entries with crossing
+	// prices that waits for seller and buyer confirmation.
+	MarketMatch struct {
+		ID              string            `json:"id"               db:"id,omitempty"`
+		ItemID          string            `json:"item_id"          db:"item_id,omitempty"`
+		AskID           string            `json:"ask_id"           db:"ask_id,omitempty,indexed"`
+		BidID           string            `json:"bid_id"           db:"bid_id,omitempty,indexed"`
+		SellerID        string            `json:"seller_id"        db:"seller_id,omitempty,indexed"`
+		BuyerID         string            `json:"buyer_id"         db:"buyer_id,omitempty,indexed"`
+		Price           float64           `json:"price"            db:"price,omitempty"`
+		Status          MarketMatchStatus `json:"status"           db:"status,omitempty,indexed"`
+		SellerConfirmed *bool             `json:"seller_confirmed" db:"seller_confirmed,omitempty"`
+		BuyerConfirmed  *bool             `json:"buyer_confirmed"  db:"buyer_confirmed,omitempty"`
+		ExpiresAt       *time.Time        `json:"expires_at"       db:"expires_at,omitempty"`
+		CreatedAt       *time.Time        `json:"created_at"       db:"created_at,omitempty"`
+		UpdatedAt       *time.Time        `json:"updated_at"       db:"updated_at,omitempty"`
+	}
+
+	// MarketMatchService provides access to market matching engine.
+	MarketMatchService interface {
+		// Matches returns matches of the authenticated user as seller or buyer.
+		Matches(context.Context) ([]MarketMatch, error)
+
+		// Confirm accepts the match on behalf of the authenticated user and
+		// reserves the entries once both users confirmed.
+		Confirm(ctx context.Context, id string) (*MarketMatch, error)
+
+		// Decline rejects the match and puts its entries back to live.
+		Decline(ctx context.Context, id string) (*MarketMatch, error)
+
+		// Match pairs a live market with the best crossing counter-part entry
+		// and notifies both users to confirm.
+		Match(ctx context.Context, m Market) (*MarketMatch, error)
+
+		// Expire releases entries of pending matches that were not confirmed in time.
+		Expire(context.Context) error
+	}
+
+	// MarketMatchStorage defines operation for market match records.
+	MarketMatchStorage interface {
+		// Find returns a list of market matches from data store.
+		Find(FindOpts) ([]MarketMatch, error)
+
+		// Get returns market match details by id from data store.
+		Get(id string) (*MarketMatch, error)
+
+		// Create persists a new market match to data store.
+		Create(*MarketMatch) error
+
+		// Update persists market match changes to data store.
+		Update(*MarketMatch) error
+	}
+)
+
+// NewMarketMatch returns pending match of crossing ask and bid entries.
+// Match price follows the resting entry which is the older one.
+func NewMarketMatch(ask, bid Market, t time.Time) *MarketMatch {
+	price := ask.Price
+	if bid.CreatedAt != nil && ask.CreatedAt != nil && bid.CreatedAt.Before(*ask.CreatedAt) {
+		price = bid.Price
+	}
+	exp := t.Add(MarketMatchExpiration)
+	return &MarketMatch{
+		ItemID:    ask.ItemID,
+		AskID:     ask.ID,
+		BidID:     bid.ID,
+		SellerID:  ask.UserID,
+		BuyerID:   bid.UserID,
+		Price:     price,
+		Status:    MarketMatchStatusPending,
+		ExpiresAt: &exp,
+	}
+}
+
+// Confirm sets confirmation of a match party and returns false when user
+// is not part of the match.
+func (m *MarketMatch) Confirm(userID string) bool {
+	t := true
+	switch userID {
+	case m.SellerID:
+		m.SellerConfirmed = &t
+	case m.BuyerID:
+		m.BuyerConfirmed = &t
+	default:
+		return false
+	}
+
+	return true
+}
+
+// IsParty returns true when user is the seller or buyer of the match.
+func (m MarketMatch) IsParty(userID string) bool {
+	return userID == m.SellerID || userID == m.BuyerID
+}
+
+// CounterParty returns the other party of the user on the match.
+func (m MarketMatch) CounterParty(userID string) string {
+	if userID == m.SellerID {
+		return m.BuyerID
+	}
+
+	return m.SellerID
+}
+
+// IsConfirmed returns true when both seller and buyer confirmed the match.
+func (m MarketMatch) IsConfirmed() bool {
+	return m.SellerConfirmed != nil && *m.SellerConfirmed &&
+		m.BuyerConfirmed != nil && *m.BuyerConfirmed
+}
+
+// IsExpired returns true when pending match passed its expiration time.
+func (m MarketMatch) IsExpired(t time.Time) bool {
+	return m.Status == MarketMatchStatusPending && m.ExpiresAt != nil && !t.Before(*m.ExpiresAt)
+}
+
+// String returns text value of a market match status.
+func (s MarketMatchStatus) String() string {
+	return marketMatchStatusTexts[s]
+}
+
+// CounterPartType returns market type of the opposite side.
+func (m Market) CounterPartType() MarketType {
+	if m.Type == MarketTypeBid {
+		return MarketTypeAsk
+	}
+
+	return MarketTypeBid
+}
+
+// BestCounterPartPrice returns best opposite side price from catalog market
+// summary, highest bid for asks and lowest ask for bids.
+func (m Market) BestCounterPartPrice(c Catalog) (price float64, ok bool) {
+	if m.Type == MarketTypeBid {
+		return c.LowestAsk, c.Quantity != 0
+	}
+
+	return c.HighestBid, c.BidCount != 0
+}
+
+// Crosses returns true when market price meets the counter-part price,
+// ask at or below the bid and bid at or above the ask.
+func (m Market) Crosses(price float64) bool {
+	if m.Type == MarketTypeBid {
+		return m.Price >= price
+	}
+
+	return m.Price <= price
+}
@@ -0,0 +1,65 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMarket_Crosses(t *testing.T) {
+	c := Catalog{Quantity: 1, LowestAsk: 10, BidCount: 1, HighestBid: 8}
+	tests := []struct {
+		name   string
+		market Market
+		want   bool
+	}{
+		{"ask above highest bid", Market{Type: MarketTypeAsk, Price: 9}, false},
+		{"ask at highest bid", Market{Type: MarketTypeAsk, Price: 8}, true},
+		{"ask below highest bid", Market{Type: MarketTypeAsk, Price: 7}, true},
+		{"bid below lowest ask", Market{Type: MarketTypeBid, Price: 9}, false},
+		{"bid at lowest ask", Market{Type: MarketTypeBid, Price: 10}, true},
+		{"bid above lowest ask", Market{Type: MarketTypeBid, Price: 11}, true},
+	}
+	for _, tc := range tests {
+		price, ok := tc.market.BestCounterPartPrice(c)
+		if !ok {
+			t.Fatalf("%s: counter-part price should exist", tc.name)
+		}
+		if got := tc.market.Crosses(price); got != tc.want {
+			t.Errorf("%s: Crosses(%v) = %v, want %v", tc.name, price, got, tc.want)
+		}
+	}
+
+	if _, ok := (Market{Type: MarketTypeAsk}).BestCounterPartPrice(Catalog{Quantity: 1}); ok {
+		t.Error("ask should have no counter-part price without bids")
+	}
+}
+
+func TestNewMarketMatch(t *testing.T) {
+	now := time.Now()
+	older := now.Add(-time.Hour)
+	ask := Market{ID: "a", UserID: "seller", ItemID: "item", Type: MarketTypeAsk, Price: 5, CreatedAt: &now}
+	bid := Market{ID: "b", UserID: "buyer", ItemID: "item", Type: MarketTypeBid, Price: 6, CreatedAt: &older}
+
+	mm := NewMarketMatch(ask, bid, now)
+	if mm.Price != bid.Price {
+		t.Errorf("price should follow resting bid, got %v", mm.Price)
+	}
+	if mm.Status != MarketMatchStatusPending || mm.IsExpired(now) || !mm.IsExpired(now.Add(MarketMatchExpiration)) {
+		t.Errorf("unexpected pending match state %+v", mm)
+	}
+
+	if mm.Confirm("other") {
+		t.Error("non-party should not confirm")
+	}
+	mm.Confirm("seller")
+	if mm.IsConfirmed() {
+		t.Error("match should wait for buyer confirmation")
+	}
+	mm.Confirm("buyer")
+	if !mm.IsConfirmed() {
+		t.Error("match should be confirmed by both sides")
+	}
+	if mm.CounterParty("seller") != "buyer" || mm.CounterParty("buyer") != "seller" {
+		t.Error("unexpected counter-party")
+	}
+}
@@ -0,0 +1,109 @@
+package core
+
+// MarketStatusTransitions defines allowed market status changes per market
+// type. Statuses that are not listed as source are final and cannot be changed.
+var MarketStatusTransitions = map[MarketType]map[MarketStatus][]MarketStatus{
+	MarketTypeAsk: {
+		MarketStatusPending: {
+			MarketStatusLive,
+			MarketStatusRemoved,
+			MarketStatusCancelled,
+		},
+		MarketStatusLive: {
+			MarketStatusReservePending,
+			MarketStatusReserved,
+			MarketStatusRemoved,
+			MarketStatusCancelled,
+			MarketStatusExpired,
+		},
+		// Declined or expired matches puts the entry back to live.
+		MarketStatusReservePending: {
+			MarketStatusReserved,
+			MarketStatusLive,
+			MarketStatusCancelled,
+		},
+		MarketStatusReserved: {
+			MarketStatusSold,
+			MarketStatusCancelled,
+		},
+	},
+	MarketTypeBid: {
+		MarketStatusLive: {
+			MarketStatusReservePending,
+			MarketStatusBidCompleted,
+			MarketStatusRemoved,
+			MarketStatusCancelled,
+			MarketStatusExpired,
+		},
+		MarketStatusReservePending: {
+			MarketStatusBidCompleted,
+			MarketStatusLive,
+			MarketStatusCancelled,
+		},
+	},
+}
+
+// MarketStatusLiftTransitions defines status changes on any market type that
+// are only allowed when lifting user's ban or suspension restores its listings.
+var MarketStatusLiftTransitions = map[MarketStatus][]MarketStatus{
+	MarketStatusCancelled: {
+		MarketStatusLive,
+	},
+}
+
+// CanTransitionTo returns true when market status is allowed to change
+// to target status. Unchanged status is always allowed.
+func (m Market) CanTransitionTo(to MarketStatus) bool {
+	if m.Status == to {
+		return true
+	}
+
+	// Entries without type are treated as ask type same as SetDefaults.
+	t := m.Type
+	if t == 0 {
+		t = MarketTypeAsk
+	}
+	for _, s := range MarketStatusTransitions[t][m.Status] {
+		if s == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckStatusTransition validates market status change to target status.
+func (m Market) CheckStatusTransition(to MarketStatus) error {
+	if !m.CanTransitionTo(to) {
+		return MarketErrInvalidStatusTransition
+	}
+
+	return nil
+}
+
+// CheckLiftTransition validates market status change to target status when
+// restoring listings on hammer lift.
+func (m Market) CheckLiftTransition(to MarketStatus) error {
+	for _, s := range MarketStatusLiftTransitions[m.Status] {
+		if s == to {
+			return nil
+		}
+	}
+
+	return m.CheckStatusTransition(to)
+}
+
+// IsMarketStatusFinal returns true when status cannot be changed on any
+// market type, used for detecting entries that are safe to clean up.
+func IsMarketStatusFinal(s MarketStatus) bool {
+	if len(MarketStatusLiftTransitions[s]) != 0 {
+		return false
+	}
+	for _, tt := range MarketStatusTransitions {
+		if len(tt[s]) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
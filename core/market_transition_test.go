@@ -0,0 +1,76 @@
+package core
+
+import "testing"
+
+func TestMarket_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		name   string
+		market Market
+		to     MarketStatus
+		want   bool
+	}{
+		{"ask live to reserved", Market{Type: MarketTypeAsk, Status: MarketStatusLive}, MarketStatusReserved, true},
+		{"ask reserved to sold", Market{Type: MarketTypeAsk, Status: MarketStatusReserved}, MarketStatusSold, true},
+		{"ask live to sold", Market{Type: MarketTypeAsk, Status: MarketStatusLive}, MarketStatusSold, false},
+		{"ask sold to live", Market{Type: MarketTypeAsk, Status: MarketStatusSold}, MarketStatusLive, false},
+		{"ask live to bid completed", Market{Type: MarketTypeAsk, Status: MarketStatusLive}, MarketStatusBidCompleted, false},
+		{"ask cancelled to live", Market{Type: MarketTypeAsk, Status: MarketStatusCancelled}, MarketStatusLive, false},
+		{"ask reserved to cancelled", Market{Type: MarketTypeAsk, Status: MarketStatusReserved}, MarketStatusCancelled, true},
+		{"ask unchanged", Market{Type: MarketTypeAsk, Status: MarketStatusSold}, MarketStatusSold, true},
+		{"no type as ask", Market{Status: MarketStatusLive}, MarketStatusReserved, true},
+		{"bid live to completed", Market{Type: MarketTypeBid, Status: MarketStatusLive}, MarketStatusBidCompleted, true},
+		{"bid live to reserved", Market{Type: MarketTypeBid, Status: MarketStatusLive}, MarketStatusReserved, false},
+		{"bid completed to live", Market{Type: MarketTypeBid, Status: MarketStatusBidCompleted}, MarketStatusLive, false},
+		{"ask live to reserve pending", Market{Type: MarketTypeAsk, Status: MarketStatusLive}, MarketStatusReservePending, true},
+		{"ask reserve pending to reserved", Market{Type: MarketTypeAsk, Status: MarketStatusReservePending}, MarketStatusReserved, true},
+		{"ask reserve pending to sold", Market{Type: MarketTypeAsk, Status: MarketStatusReservePending}, MarketStatusSold, false},
+		{"bid reserve pending to completed", Market{Type: MarketTypeBid, Status: MarketStatusReservePending}, MarketStatusBidCompleted, true},
+		{"bid reserve pending to live", Market{Type: MarketTypeBid, Status: MarketStatusReservePending}, MarketStatusLive, true},
+		{"bid expired to live", Market{Type: MarketTypeBid, Status: MarketStatusExpired}, MarketStatusLive, false},
+	}
+	for _, tc := range tests {
+		if got := tc.market.CanTransitionTo(tc.to); got != tc.want {
+			t.Errorf("%s: CanTransitionTo(%s) = %v, want %v", tc.name, tc.to, got, tc.want)
+		}
+	}
+}
+
+func TestMarket_CheckLiftTransition(t *testing.T) {
+	tests := []struct {
+		name   string
+		market Market
+		to     MarketStatus
+		want   error
+	}{
+		{"ask cancelled to live", Market{Type: MarketTypeAsk, Status: MarketStatusCancelled}, MarketStatusLive, nil},
+		{"bid cancelled to live", Market{Type: MarketTypeBid, Status: MarketStatusCancelled}, MarketStatusLive, nil},
+		{"ask live to cancelled", Market{Type: MarketTypeAsk, Status: MarketStatusLive}, MarketStatusCancelled, nil},
+		{"ask sold to live", Market{Type: MarketTypeAsk, Status: MarketStatusSold}, MarketStatusLive, MarketErrInvalidStatusTransition},
+	}
+	for _, tc := range tests {
+		if got := tc.market.CheckLiftTransition(tc.to); got != tc.want {
+			t.Errorf("%s: CheckLiftTransition(%s) = %v, want %v", tc.name, tc.to, got, tc.want)
+		}
+	}
+}
+
+func TestIsMarketStatusFinal(t *testing.T) {
+	tests := []struct {
+		status MarketStatus
+		want   bool
+	}{
+		{MarketStatusLive, false},
+		{MarketStatusReserved, false},
+		{MarketStatusCancelled, false},
+		{MarketStatusReservePending, false},
+		{MarketStatusSold, true},
+		{MarketStatusBidCompleted, true},
+		{MarketStatusRemoved, true},
+		{MarketStatusExpired, true},
+	}
+	for _, tc := range tests {
+		if got := IsMarketStatusFinal(tc.status); got != tc.want {
+			t.Errorf("IsMarketStatusFinal(%s) = %v, want %v", tc.status, got, tc.want)
+		}
+	}
+}
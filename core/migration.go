@@ -0,0 +1,24 @@
+package core
+
+import "time"
+
+type (
+	// Migration represents an applied schema or data migration record.
+	Migration struct {
+		ID        string     `json:"id"         db:"id,omitempty"`
+		Name      string     `json:"name"       db:"name,omitempty,indexed"`
+		AppliedAt *time.Time `json:"applied_at" db:"applied_at,omitempty"`
+	}
+
+	// MigrationStorage defines operation for migration records.
+	MigrationStorage interface {
+		// Find returns a list of applied migrations from data store.
+		Find() ([]Migration, error)
+
+		// Create persists a new applied migration to data store.
+		Create(*Migration) error
+
+		// Delete removes applied migration by name from data store.
+		Delete(name string) error
+	}
+)
@@ -0,0 +1,168 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/kudarap/dotagiftx/gokit/http/safeclient"
+)
+
+// Notification error types.
+const (
+	NotificationErrNotFound Errors = iota + 7100
+	NotificationErrRequiredFields
+	NotificationErrInvalidEvent
+	NotificationErrInvalidWebhookURL
+	NotificationErrInvalidEmailCode
+)
+
+// sets error text definition.
+func init() {
+	appErrorText[NotificationErrNotFound] = "notification setting not found"
+	appErrorText[NotificationErrRequiredFields] = "notification setting fields are required"
+	appErrorText[NotificationErrInvalidEvent] = "notification event not supported"
+	appErrorText[NotificationErrInvalidWebhookURL] = "notification webhook url must be a valid https url"
+	appErrorText[NotificationErrInvalidEmailCode] = "email confirmation code is invalid"
+}
+
+// Notification events that users can subscribe to.
+const (
+	// NotificationEventMarketExpired listings that were expired by the system.
+	NotificationEventMarketExpired = "market_expired"
+	// NotificationEventBidAboveAsk new buy order with price higher or equal to user's listing.
+	NotificationEventBidAboveAsk = "bid_above_ask"
+	// NotificationEventDeliveryStatus delivery verification status changes of sold listings.
+	NotificationEventDeliveryStatus = "delivery_status"
+	// NotificationEventPriceAlert watched item price crossed user's watchlist threshold.
+	NotificationEventPriceAlert = "price_alert"
+	// NotificationEventMarketMatch listings or buy orders paired by matching engine.
+	NotificationEventMarketMatch = "market_match"
+	// NotificationEventOffer price offers on listings and their responses.
+	NotificationEventOffer = "offer"
+	// NotificationEventEmailConfirm confirmation code sent to a new email address,
+	// always sent and not subscribable.
+	NotificationEventEmailConfirm = "email_confirm"
+)
+
+// NotificationEvents lists supported notification events.
+var NotificationEvents = []string{
+	NotificationEventMarketExpired,
+	NotificationEventBidAboveAsk,
+	NotificationEventDeliveryStatus,
+	NotificationEventPriceAlert,
+	NotificationEventMarketMatch,
+	NotificationEventOffer,
+}
+
+type (
+	// NotificationSetting represents user notification preferences and
+	// channel destinations.
+	NotificationSetting struct {
+		ID         string     `json:"id"          db:"id,omitempty"`
+		UserID     string     `json:"user_id"     db:"user_id,omitempty,indexed"`
+		Events     []string   `json:"events"      db:"events"`
+		Email      string     `json:"email"       db:"email"       valid:"omitempty,email"`
+		WebhookURL string     `json:"webhook_url" db:"webhook_url" valid:"omitempty,url"`
+		CreatedAt  *time.Time `json:"created_at"  db:"created_at,omitempty"`
+		UpdatedAt  *time.Time `json:"updated_at"  db:"updated_at,omitempty"`
+
+		// EmailConfirmed is set when user confirmed owning the email address,
+		// emails are not sent to unconfirmed addresses.
+		EmailConfirmed bool   `json:"email_confirmed" db:"email_confirmed"`
+		EmailCode      string `json:"-"               db:"email_code"`
+	}
+
+	// NotificationEmailConfirm represents email confirmation code submitted by user.
+	NotificationEmailConfirm struct {
+		Code string `json:"code"`
+	}
+
+	// Notification represents a message sent to user channels.
+	Notification struct {
+		Event   string `json:"event"`
+		Subject string `json:"subject"`
+		Message string `json:"message"`
+		URL     string `json:"url,omitempty"`
+	}
+
+	// NotificationChannel provides access to a notification destination.
+	NotificationChannel interface {
+		// Name returns channel name used for logging.
+		Name() string
+
+		// Send delivers notification to the user destination, channels
+		// should skip sending when destination is not set on the setting.
+		Send(ctx context.Context, s NotificationSetting, n Notification) error
+	}
+
+	// NotificationService provides access to notification service.
+	NotificationService interface {
+		// Setting returns notification setting of the authenticated user.
+		Setting(context.Context) (*NotificationSetting, error)
+
+		// UpdateSetting saves notification setting of the authenticated user.
+		// A confirmation code is sent when email address changed.
+		UpdateSetting(context.Context, *NotificationSetting) error
+
+		// ConfirmEmail marks email address of the authenticated user as
+		// confirmed when code matches the one sent to it.
+		ConfirmEmail(ctx context.Context, code string) (*NotificationSetting, error)
+
+		// Notify sends notification to all channels of a user that
+		// subscribed to the notification event.
+		Notify(ctx context.Context, userID string, n Notification) error
+	}
+
+	// NotificationStorage defines operation for notification setting records.
+	NotificationStorage interface {
+		// GetByUserID returns notification setting of a user from data store.
+		GetByUserID(userID string) (*NotificationSetting, error)
+
+		// Create persists a new notification setting to data store.
+		Create(*NotificationSetting) error
+
+		// Update persists notification setting changes to data store.
+		Update(*NotificationSetting) error
+	}
+)
+
+// CheckUpdate validates field on saving notification setting.
+func (s NotificationSetting) CheckUpdate() error {
+	if err := validator.Struct(s); err != nil {
+		return err
+	}
+	if s.WebhookURL != "" {
+		if err := safeclient.CheckURL(s.WebhookURL); err != nil {
+			return NotificationErrInvalidWebhookURL
+		}
+	}
+
+	for _, e := range s.Events {
+		if !isNotificationEvent(e) {
+			return NotificationErrInvalidEvent
+		}
+	}
+
+	return nil
+}
+
+// HasEvent returns true when user subscribed to the event.
+func (s NotificationSetting) HasEvent(event string) bool {
+	for _, e := range s.Events {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isNotificationEvent(event string) bool {
+	for _, e := range NotificationEvents {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,207 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+// Offer error types.
+const (
+	OfferErrNotFound Errors = iota + 2400
+	OfferErrRequiredID
+	OfferErrRequiredFields
+	OfferErrInvalidPrice
+	OfferErrNotPending
+	OfferErrNotAllowed
+	OfferErrMarketNotAvailable
+	OfferErrDuplicate
+	OfferErrExpired
+)
+
+// sets error text definition.
+func init() {
+	appErrorText[OfferErrNotFound] = "offer not found"
+	appErrorText[OfferErrRequiredID] = "offer id is required"
+	appErrorText[OfferErrRequiredFields] = "offer fields are required"
+	appErrorText[OfferErrInvalidPrice] = "offer price should be above zero and lower than listing price"
+	appErrorText[OfferErrNotPending] = "offer is already resolved"
+	appErrorText[OfferErrNotAllowed] = "offer is waiting for the other side to respond"
+	appErrorText[OfferErrMarketNotAvailable] = "offer listing is no longer available"
+	appErrorText[OfferErrDuplicate] = "offer on this listing is still pending"
+	appErrorText[OfferErrExpired] = "offer is already expired"
+}
+
+// Offer statuses.
+const (
+	OfferStatusPending  OfferStatus = 100
+	OfferStatusAccepted OfferStatus = 200
+	OfferStatusRejected OfferStatus = 300
+	OfferStatusExpired  OfferStatus = 400
+)
+
+// OfferExpiration duration of a pending offer before it expires, counter
+// offers resets the expiration.
+const OfferExpiration = time.Hour * 48
+
+var offerStatusTexts = map[OfferStatus]string{
+	OfferStatusPending:  "pending",
+	OfferStatusAccepted: "accepted",
+	OfferStatusRejected: "rejected",
+	OfferStatusExpired:  "expired",
+}
+
+type (
+	// OfferStatus represents offer status.
+	OfferStatus uint
+
+	// Offer represents buyer's price proposal on a listing and its latest
+	// counter price. Proposer is the user who proposed the current price and
+	// only the other side can accept, reject or counter.
+	Offer struct {
+		ID         string      `json:"id"          db:"id,omitempty"`
+		MarketID   string      `json:"market_id"   db:"market_id,omitempty,indexed" valid:"required"`
+		ItemID     string      `json:"item_id"     db:"item_id,omitempty"`
+		SellerID   string      `json:"seller_id"   db:"seller_id,omitempty,indexed"`
+		BuyerID    string      `json:"buyer_id"    db:"buyer_id,omitempty,indexed"`
+		ProposerID string      `json:"proposer_id" db:"proposer_id,omitempty"`
+		AskPrice   float64     `json:"ask_price"   db:"ask_price,omitempty"`
+		Price      float64     `json:"price"       db:"price,omitempty"             valid:"required"`
+		Counters   int         `json:"counters"    db:"counters,omitempty"`
+		Status     OfferStatus `json:"status"      db:"status,omitempty,indexed"`
+		ExpiresAt  *time.Time  `json:"expires_at"  db:"expires_at,omitempty"`
+		CreatedAt  *time.Time  `json:"created_at"  db:"created_at,omitempty"`
+		UpdatedAt  *time.Time  `json:"updated_at"  db:"updated_at,omitempty"`
+	}
+
+	// OfferService provides access to offer service.
+	OfferService interface {
+		// Offers returns offers of the authenticated user as seller or buyer.
+		Offers(context.Context) ([]Offer, error)
+
+		// Create saves new offer of the authenticated user on a listing.
+		Create(context.Context, *Offer) error
+
+		// Accept agrees on the offer price and reserves the listing for the buyer.
+		Accept(ctx context.Context, id string) (*Offer, error)
+
+		// Reject declines the offer.
+		Reject(ctx context.Context, id string) (*Offer, error)
+
+		// Counter proposes a new price to the other side.
+		Counter(ctx context.Context, id string, price float64) (*Offer, error)
+
+		// Expire sets expired status on pending offers that were not resolved in time.
+		Expire(context.Context) error
+	}
+
+	// OfferStorage defines operation for offer records.
+	OfferStorage interface {
+		// Find returns a list of offers from data store.
+		Find(FindOpts) ([]Offer, error)
+
+		// Get returns offer details by id from data store.
+		Get(id string) (*Offer, error)
+
+		// Create persists a new offer to data store.
+		Create(*Offer) error
+
+		// Update persists offer changes to data store.
+		Update(*Offer) error
+	}
+)
+
+// CheckCreate validates field on creating new offer.
+func (o Offer) CheckCreate() error {
+	if err := validator.Struct(o); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetDefaults sets default values for a new offer on a listing.
+func (o *Offer) SetDefaults(ask Market, buyerID string, t time.Time) error {
+	o.Price = priceToTenths(o.Price)
+	if err := checkOfferPrice(o.Price, ask.Price); err != nil {
+		return err
+	}
+
+	o.ItemID = ask.ItemID
+	o.SellerID = ask.UserID
+	o.BuyerID = buyerID
+	o.ProposerID = buyerID
+	o.AskPrice = ask.Price
+	o.Counters = 0
+	o.Status = OfferStatusPending
+	exp := t.Add(OfferExpiration)
+	o.ExpiresAt = &exp
+	return nil
+}
+
+// Counter sets new proposed price of the user and resets the expiration.
+func (o *Offer) Counter(userID string, price float64, t time.Time) error {
+	price = priceToTenths(price)
+	if err := checkOfferPrice(price, o.AskPrice); err != nil {
+		return err
+	}
+
+	o.ProposerID = userID
+	o.Price = price
+	o.Counters++
+	exp := t.Add(OfferExpiration)
+	o.ExpiresAt = &exp
+	return nil
+}
+
+// CheckRespond validates user that responds to the current offer price at
+// time t, expired offers waiting for the expiration job are not respondable.
+func (o Offer) CheckRespond(userID string, t time.Time) error {
+	if !o.IsParty(userID) {
+		return OfferErrNotFound
+	}
+	if o.Status != OfferStatusPending {
+		return OfferErrNotPending
+	}
+	if o.IsExpired(t) {
+		return OfferErrExpired
+	}
+	if o.ProposerID == userID {
+		return OfferErrNotAllowed
+	}
+
+	return nil
+}
+
+// IsParty returns true when user is the seller or buyer of the offer.
+func (o Offer) IsParty(userID string) bool {
+	return userID == o.SellerID || userID == o.BuyerID
+}
+
+// CounterParty returns the other party of the user on the offer.
+func (o Offer) CounterParty(userID string) string {
+	if userID == o.SellerID {
+		return o.BuyerID
+	}
+
+	return o.SellerID
+}
+
+// IsExpired returns true when pending offer passed its expiration time.
+func (o Offer) IsExpired(t time.Time) bool {
+	return o.Status == OfferStatusPending && o.ExpiresAt != nil && !t.Before(*o.ExpiresAt)
+}
+
+// String returns text value of an offer status.
+func (s OfferStatus) String() string {
+	return offerStatusTexts[s]
+}
+
+// checkOfferPrice validates offer price that should be lower than the
+// listing price, otherwise buyer could just buy it on listing price.
+func checkOfferPrice(price, askPrice float64) error {
+	if price <= 0 || price >= askPrice {
+		return OfferErrInvalidPrice
+	}
+
+	return nil
+}
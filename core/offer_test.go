@@ -0,0 +1,70 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOffer_SetDefaults(t *testing.T) {
+	now := time.Now()
+	ask := Market{ID: "m", UserID: "seller", ItemID: "item", Type: MarketTypeAsk, Price: 10}
+	tests := []struct {
+		name  string
+		price float64
+		want  error
+	}{
+		{"zero price", 0, OfferErrInvalidPrice},
+		{"same as listing price", 10, OfferErrInvalidPrice},
+		{"above listing price", 12, OfferErrInvalidPrice},
+		{"below listing price", 8.555, nil},
+	}
+	for _, tc := range tests {
+		o := Offer{MarketID: ask.ID, Price: tc.price}
+		if err := o.SetDefaults(ask, "buyer", now); err != tc.want {
+			t.Errorf("%s: SetDefaults() error = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+
+	o := Offer{MarketID: ask.ID, Price: 8.555}
+	_ = o.SetDefaults(ask, "buyer", now)
+	if o.Price != 8.56 || o.SellerID != "seller" || o.ProposerID != "buyer" || o.Status != OfferStatusPending {
+		t.Errorf("unexpected offer defaults %+v", o)
+	}
+	if o.IsExpired(now) || !o.IsExpired(now.Add(OfferExpiration)) {
+		t.Error("offer should expire after its expiration duration")
+	}
+}
+
+func TestOffer_Counter(t *testing.T) {
+	now := time.Now()
+	ask := Market{ID: "m", UserID: "seller", ItemID: "item", Type: MarketTypeAsk, Price: 10}
+	o := Offer{MarketID: ask.ID, Price: 7}
+	_ = o.SetDefaults(ask, "buyer", now)
+
+	if err := o.CheckRespond("buyer", now); err != OfferErrNotAllowed {
+		t.Errorf("proposer should wait for response, got %v", err)
+	}
+	if err := o.CheckRespond("other", now); err != OfferErrNotFound {
+		t.Errorf("non-party should not respond, got %v", err)
+	}
+	if err := o.CheckRespond("seller", now); err != nil {
+		t.Errorf("seller should respond, got %v", err)
+	}
+	if err := o.CheckRespond("seller", now.Add(OfferExpiration)); err != OfferErrExpired {
+		t.Errorf("expired offer should not be respondable, got %v", err)
+	}
+
+	later := now.Add(time.Hour)
+	if err := o.Counter("seller", 9, later); err != nil {
+		t.Fatalf("Counter() error = %v", err)
+	}
+	if o.Price != 9 || o.Counters != 1 || o.CheckRespond("buyer", now) != nil || o.CheckRespond("seller", now) == nil {
+		t.Errorf("unexpected countered offer %+v", o)
+	}
+	if !o.ExpiresAt.Equal(later.Add(OfferExpiration)) {
+		t.Error("counter should reset offer expiration")
+	}
+	if err := o.Counter("buyer", 10, later); err != OfferErrInvalidPrice {
+		t.Errorf("counter on listing price should be invalid, got %v", err)
+	}
+}
@@ -0,0 +1,178 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Price history error types.
+const (
+	PriceHistoryErrNotFound Errors = iota + 2600
+	PriceHistoryErrInvalidInterval
+)
+
+// sets error text definition.
+func init() {
+	appErrorText[PriceHistoryErrNotFound] = "price candle not found"
+	appErrorText[PriceHistoryErrInvalidInterval] = "price history interval should be 1h or 1d"
+}
+
+// Price history intervals.
+const (
+	PriceIntervalHour = "1h"
+	PriceIntervalDay  = "1d"
+)
+
+// Price history sides.
+const (
+	PriceSideAsk  = "ask"
+	PriceSideBid  = "bid"
+	PriceSideSale = "sale"
+)
+
+// Price history default and max number of candles per side.
+const (
+	PriceHistoryDefaultLimit = 90
+	PriceHistoryMaxLimit     = 1000
+)
+
+// PriceIntervals lists supported candle intervals and its duration.
+var PriceIntervals = map[string]time.Duration{
+	PriceIntervalHour: time.Hour,
+	PriceIntervalDay:  time.Hour * 24,
+}
+
+type (
+	// PriceCandle represents open, high, low, close prices and volume of an
+	// item's asks, bids or sales within an interval. Candle key is used as
+	// its id so concurrent first prices create the same candle.
+	PriceCandle struct {
+		ID        string     `json:"-"          db:"id,omitempty"`
+		Key       string     `json:"-"          db:"key,omitempty,indexed"`
+		ItemID    string     `json:"item_id"    db:"item_id,omitempty,indexed"`
+		Interval  string     `json:"interval"   db:"interval,omitempty"`
+		Side      string     `json:"side"       db:"side,omitempty"`
+		Time      *time.Time `json:"time"       db:"time,omitempty,indexed"`
+		Open      float64    `json:"open"       db:"open,omitempty"`
+		High      float64    `json:"high"       db:"high,omitempty"`
+		Low       float64    `json:"low"        db:"low,omitempty"`
+		Close     float64    `json:"close"      db:"close,omitempty"`
+		Volume    int        `json:"volume"     db:"volume,omitempty"`
+		CreatedAt *time.Time `json:"-"          db:"created_at,omitempty"`
+		UpdatedAt *time.Time `json:"updated_at" db:"updated_at,omitempty"`
+	}
+
+	// PriceHistory represents item price candles of an interval by side.
+	PriceHistory struct {
+		ItemID   string        `json:"item_id"`
+		Interval string        `json:"interval"`
+		Asks     []PriceCandle `json:"asks"`
+		Bids     []PriceCandle `json:"bids"`
+		Sales    []PriceCandle `json:"sales"`
+	}
+
+	// PricePoint represents market price observed at a time.
+	PricePoint struct {
+		ItemID string
+		Side   string
+		Price  float64
+		Time   time.Time
+	}
+
+	// PriceHistoryService provides access to item price history.
+	PriceHistoryService interface {
+		// PriceHistory returns recent price candles of an item by catalog slug.
+		PriceHistory(ctx context.Context, slug, interval string, limit int) (*PriceHistory, error)
+
+		// Record adds market price point to its item candles.
+		Record(context.Context, PricePoint) error
+
+		// Backfill rebuilds item candles from existing market records.
+		Backfill(context.Context) error
+	}
+
+	// PriceHistoryStorage defines operation for price candle records.
+	PriceHistoryStorage interface {
+		// Find returns a list of price candles from data store.
+		Find(FindOpts) ([]PriceCandle, error)
+
+		// Get returns price candle details by id from data store.
+		Get(id string) (*PriceCandle, error)
+
+		// Create persists a new price candle to data store.
+		Create(*PriceCandle) error
+
+		// Update persists price candle changes to data store.
+		Update(*PriceCandle) error
+
+		// AddPrice adds price to the stored candle of the same key in a
+		// single atomic write and creates the candle on its first price.
+		AddPrice(c *PriceCandle, price float64) error
+	}
+)
+
+// NewPriceCandle returns empty candle of the interval that contains time t.
+func NewPriceCandle(itemID, interval, side string, t time.Time) PriceCandle {
+	bt := t.UTC().Truncate(PriceIntervals[interval])
+	return PriceCandle{
+		Key:      PriceCandleKey(itemID, interval, side, bt),
+		ItemID:   itemID,
+		Interval: interval,
+		Side:     side,
+		Time:     &bt,
+	}
+}
+
+// PriceCandleKey returns unique key of an item candle.
+func PriceCandleKey(itemID, interval, side string, t time.Time) string {
+	return fmt.Sprintf("%s:%s:%s:%d", itemID, interval, side, t.Unix())
+}
+
+// Add includes price to the candle, points are expected to be added in
+// chronological order.
+func (c *PriceCandle) Add(price float64) {
+	if c.Volume == 0 {
+		c.Open = price
+		c.High = price
+		c.Low = price
+	}
+	if price > c.High {
+		c.High = price
+	}
+	if price < c.Low {
+		c.Low = price
+	}
+	c.Close = price
+	c.Volume++
+}
+
+// PricePoints returns price points of a market entry, asks and bids on its
+// creation and sales when asks were reserved or sold.
+func (m Market) PricePoints() []PricePoint {
+	var pp []PricePoint
+	if m.CreatedAt != nil {
+		side := PriceSideAsk
+		if m.Type == MarketTypeBid {
+			side = PriceSideBid
+		}
+		pp = append(pp, PricePoint{m.ItemID, side, m.Price, *m.CreatedAt})
+	}
+	if m.Type == MarketTypeAsk && m.UpdatedAt != nil &&
+		(m.Status == MarketStatusReserved || m.Status == MarketStatusSold) {
+		pp = append(pp, PricePoint{m.ItemID, PriceSideSale, m.Price, *m.UpdatedAt})
+	}
+
+	return pp
+}
+
+// IsSaleTransition returns true when status change counts as a sale, sold
+// listings that were already reserved are counted on its reservation.
+func (m Market) IsSaleTransition(prev MarketStatus) bool {
+	if m.Type != MarketTypeAsk {
+		return false
+	}
+
+	return m.Status == MarketStatusReserved ||
+		(m.Status == MarketStatusSold && prev != MarketStatusReserved)
+}
@@ -0,0 +1,45 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPriceCandle_Add(t *testing.T) {
+	at := time.Date(2024, 1, 2, 15, 45, 0, 0, time.UTC)
+	c := NewPriceCandle("item", PriceIntervalDay, PriceSideSale, at)
+	if !c.Time.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("daily candle should start at midnight, got %s", c.Time)
+	}
+	if h := NewPriceCandle("item", PriceIntervalHour, PriceSideSale, at); !h.Time.Equal(at.Truncate(time.Hour)) || h.Key == c.Key {
+		t.Errorf("unexpected hourly candle %+v", h)
+	}
+
+	for _, p := range []float64{5, 8, 3, 6} {
+		c.Add(p)
+	}
+	want := PriceCandle{Open: 5, High: 8, Low: 3, Close: 6, Volume: 4}
+	if c.Open != want.Open || c.High != want.High || c.Low != want.Low || c.Close != want.Close || c.Volume != want.Volume {
+		t.Errorf("Add() candle = %+v, want %+v", c, want)
+	}
+}
+
+func TestMarket_IsSaleTransition(t *testing.T) {
+	tests := []struct {
+		name   string
+		market Market
+		prev   MarketStatus
+		want   bool
+	}{
+		{"reserved ask", Market{Type: MarketTypeAsk, Status: MarketStatusReserved}, MarketStatusLive, true},
+		{"sold reserved ask", Market{Type: MarketTypeAsk, Status: MarketStatusSold}, MarketStatusReserved, false},
+		{"sold live ask", Market{Type: MarketTypeAsk, Status: MarketStatusSold}, MarketStatusLive, true},
+		{"removed ask", Market{Type: MarketTypeAsk, Status: MarketStatusRemoved}, MarketStatusLive, false},
+		{"completed bid", Market{Type: MarketTypeBid, Status: MarketStatusBidCompleted}, MarketStatusLive, false},
+	}
+	for _, tc := range tests {
+		if got := tc.market.IsSaleTransition(tc.prev); got != tc.want {
+			t.Errorf("%s: IsSaleTransition() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
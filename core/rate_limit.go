@@ -0,0 +1,116 @@
+package core
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Rate limit error types.
+const (
+	RateLimitErrExceeded Errors = iota + 8000
+	RateLimitErrInvalid
+)
+
+// sets error text definition.
+func init() {
+	appErrorText[RateLimitErrExceeded] = "too many requests, try again later"
+	appErrorText[RateLimitErrInvalid] = "rate limit should be in requests/window format, e.g. 60/1m"
+}
+
+type (
+	// RateLimit represents number of requests allowed within a sliding
+	// window, zero limit disables it.
+	RateLimit struct {
+		Limit  int
+		Window time.Duration
+	}
+
+	// RateLimitResult represents remaining quota of a client after request.
+	RateLimitResult struct {
+		Allowed   bool
+		Limit     int
+		Remaining int
+		// Reset duration until current window ends.
+		Reset time.Duration
+	}
+
+	// RateLimiter defines operation for request counters shared across
+	// running instances.
+	RateLimiter interface {
+		// Allow counts request of key and returns its remaining quota,
+		// rejected requests are not counted.
+		Allow(key string, rl RateLimit) (*RateLimitResult, error)
+	}
+)
+
+// ParseRateLimit parses rate limit in requests/window format, e.g. 60/1m,
+// and empty value disables it.
+func ParseRateLimit(s string) (RateLimit, error) {
+	var rl RateLimit
+	if s = strings.TrimSpace(s); s == "" {
+		return rl, nil
+	}
+
+	limit, window, ok := strings.Cut(s, "/")
+	if !ok {
+		return rl, RateLimitErrInvalid
+	}
+	n, err := strconv.Atoi(limit)
+	if err != nil || n < 0 {
+		return rl, RateLimitErrInvalid
+	}
+	d, err := time.ParseDuration(window)
+	if err != nil || d <= 0 {
+		return rl, RateLimitErrInvalid
+	}
+
+	rl.Limit = n
+	rl.Window = d
+	return rl, nil
+}
+
+// UnmarshalText parses rate limit from config.
+func (rl *RateLimit) UnmarshalText(b []byte) (err error) {
+	*rl, err = ParseRateLimit(string(b))
+	return
+}
+
+// String returns rate limit in requests/window format.
+func (rl RateLimit) String() string {
+	if rl.IsZero() {
+		return ""
+	}
+
+	return fmt.Sprintf("%d/%s", rl.Limit, rl.Window)
+}
+
+// IsZero checks rate limit is disabled.
+func (rl RateLimit) IsZero() bool {
+	return rl.Limit == 0 || rl.Window == 0
+}
+
+// WindowStart returns start of fixed window at time t.
+func (rl RateLimit) WindowStart(t time.Time) time.Time {
+	return t.Truncate(rl.Window)
+}
+
+// Result returns quota at time t from request counts of previous and current
+// fixed window that includes the request, previous count is weighed by its
+// overlap with the sliding window.
+func (rl RateLimit) Result(t time.Time, prev, curr int) RateLimitResult {
+	elapsed := t.Sub(rl.WindowStart(t))
+	count := int(int64(prev)*int64(rl.Window-elapsed)/int64(rl.Window)) + curr
+
+	r := RateLimitResult{
+		Allowed: count <= rl.Limit,
+		Limit:   rl.Limit,
+		Reset:   rl.Window - elapsed,
+	}
+	if r.Allowed {
+		r.Remaining = rl.Limit - count
+	}
+
+	return r
+}
@@ -0,0 +1,59 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    RateLimit
+		wantErr bool
+	}{
+		{"60/1m", RateLimit{60, time.Minute}, false},
+		{" 5/10s ", RateLimit{5, time.Second * 10}, false},
+		{"", RateLimit{}, false},
+		{"60", RateLimit{}, true},
+		{"x/1m", RateLimit{}, true},
+		{"-1/1m", RateLimit{}, true},
+		{"60/0s", RateLimit{}, true},
+		{"60/minute", RateLimit{}, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := ParseRateLimit(tc.in)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ParseRateLimit() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("ParseRateLimit() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRateLimit_Result(t *testing.T) {
+	rl := RateLimit{10, time.Minute}
+	start := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		at         time.Time
+		prev, curr int
+		want       RateLimitResult
+	}{
+		{"first request", start, 0, 1, RateLimitResult{true, 10, 9, time.Minute}},
+		{"full previous window", start, 10, 1, RateLimitResult{false, 10, 0, time.Minute}},
+		{"half previous window", start.Add(time.Second * 30), 10, 5, RateLimitResult{true, 10, 0, time.Second * 30}},
+		{"half previous window exceeded", start.Add(time.Second * 30), 10, 6, RateLimitResult{false, 10, 0, time.Second * 30}},
+		{"previous window expiring", start.Add(time.Second * 54), 10, 8, RateLimitResult{true, 10, 1, time.Second * 6}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := rl.Result(tc.at, tc.prev, tc.curr); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Result() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Role error types.
+const (
+	RoleErrNotFound Errors = iota + 1300
+	RoleErrRequiredFields
+	RoleErrInvalid
+	RoleErrSelfRevoke
+)
+
+// sets error text definition.
+func init() {
+	appErrorText[RoleErrNotFound] = "role not found"
+	appErrorText[RoleErrRequiredFields] = "role fields are required"
+	appErrorText[RoleErrInvalid] = "role not supported"
+	appErrorText[RoleErrSelfRevoke] = "admin role cannot be revoked from yourself"
+}
+
+// Roles grants staff users permissions, regular users have no role.
+const (
+	RoleAdmin       = "admin"
+	RoleModerator   = "moderator"
+	RoleItemCurator = "item-curator"
+	RoleSupport     = "support"
+)
+
+// Permissions checked on routes and services.
+const (
+	PermissionUsersModerate      = "users:moderate"
+	PermissionItemsWrite         = "items:write"
+	PermissionSynonymsWrite      = "synonyms:write"
+	PermissionExchangeRatesWrite = "exchange_rates:write"
+	PermissionMarketsHistory     = "markets:history"
+	PermissionCatalogIndexRead   = "catalog_index:read"
+	PermissionRolesRead          = "roles:read"
+	PermissionRolesWrite         = "roles:write"
+)
+
+// RolePermissions lists permissions granted by each role.
+var RolePermissions = map[string][]string{
+	RoleAdmin: {
+		PermissionUsersModerate,
+		PermissionItemsWrite,
+		PermissionSynonymsWrite,
+		PermissionExchangeRatesWrite,
+		PermissionMarketsHistory,
+		PermissionCatalogIndexRead,
+		PermissionRolesRead,
+		PermissionRolesWrite,
+	},
+	RoleModerator: {
+		PermissionUsersModerate,
+		PermissionMarketsHistory,
+		PermissionRolesRead,
+	},
+	RoleItemCurator: {
+		PermissionItemsWrite,
+		PermissionSynonymsWrite,
+	},
+	RoleSupport: {
+		PermissionMarketsHistory,
+		PermissionCatalogIndexRead,
+	},
+}
+
+// Role audit actions.
+const (
+	RoleActionGrant  = "grant"
+	RoleActionRevoke = "revoke"
+)
+
+// roleLevelSep separates roles on JWT claims level.
+const roleLevelSep = ","
+
+type (
+	// RoleParams represents parameters to grant or revoke a role of user.
+	RoleParams struct {
+		UserID string `json:"user_id" valid:"required"` // accepts user id or steam id
+		Role   string `json:"role"    valid:"required"`
+		Reason string `json:"reason"`
+	}
+
+	// RoleAudit represents a record of role grant or revoke.
+	RoleAudit struct {
+		ID        string     `json:"id"         db:"id,omitempty"`
+		UserID    string     `json:"user_id"    db:"user_id,omitempty,indexed"`
+		Role      string     `json:"role"       db:"role,omitempty"`
+		Action    string     `json:"action"     db:"action,omitempty"`
+		ActorID   string     `json:"actor_id"   db:"actor_id,omitempty,indexed"`
+		Reason    string     `json:"reason"     db:"reason,omitempty"`
+		CreatedAt *time.Time `json:"created_at" db:"created_at,omitempty"`
+		UpdatedAt *time.Time `json:"updated_at" db:"updated_at,omitempty"`
+	}
+
+	// RoleService provides access to role service.
+	RoleService interface {
+		// Staff returns a list of users with roles.
+		Staff(context.Context) ([]User, error)
+
+		// Grant adds role to user and records who granted it.
+		Grant(context.Context, RoleParams) (*User, error)
+
+		// Revoke removes role from user and records who revoked it.
+		Revoke(context.Context, RoleParams) (*User, error)
+
+		// Audits returns a list of role grants and revokes.
+		Audits(context.Context, FindOpts) ([]RoleAudit, error)
+	}
+
+	// RoleAuditStorage defines operation for role audit records.
+	RoleAuditStorage interface {
+		// Find returns a list of role audits from data store.
+		Find(FindOpts) ([]RoleAudit, error)
+
+		// Create persists a new role audit to data store.
+		Create(*RoleAudit) error
+	}
+)
+
+// Validate checks role params and role support.
+func (p RoleParams) Validate() error {
+	if err := validator.Struct(p); err != nil {
+		return RoleErrRequiredFields
+	}
+	if !IsRole(p.Role) {
+		return RoleErrInvalid
+	}
+
+	return nil
+}
+
+// IsRole checks role is supported.
+func IsRole(role string) bool {
+	_, ok := RolePermissions[role]
+	return ok
+}
+
+// RolesHavePermission returns true when any of the roles grants the permission.
+func RolesHavePermission(roles []string, permission string) bool {
+	for _, r := range roles {
+		for _, p := range RolePermissions[r] {
+			if p == permission {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// RolesToLevel composes roles as JWT claims level.
+func RolesToLevel(roles []string) string {
+	return strings.Join(roles, roleLevelSep)
+}
+
+// RolesFromLevel returns supported roles of JWT claims level.
+func RolesFromLevel(level string) []string {
+	var roles []string
+	for _, r := range strings.Split(level, roleLevelSep) {
+		if r = strings.TrimSpace(r); IsRole(r) {
+			roles = append(roles, r)
+		}
+	}
+
+	return roles
+}
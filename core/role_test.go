@@ -0,0 +1,69 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRolesHavePermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		roles      []string
+		permission string
+		want       bool
+	}{
+		{"no roles", nil, PermissionItemsWrite, false},
+		{"admin", []string{RoleAdmin}, PermissionRolesWrite, true},
+		{"curator items", []string{RoleItemCurator}, PermissionItemsWrite, true},
+		{"curator ban", []string{RoleItemCurator}, PermissionUsersModerate, false},
+		{"multiple roles", []string{RoleSupport, RoleModerator}, PermissionUsersModerate, true},
+		{"unknown role", []string{"hammer"}, PermissionUsersModerate, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := RolesHavePermission(tc.roles, tc.permission); got != tc.want {
+				t.Errorf("RolesHavePermission() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRolesFromLevel(t *testing.T) {
+	tests := []struct {
+		level string
+		want  []string
+	}{
+		{"", nil},
+		{RolesToLevel([]string{RoleAdmin}), []string{RoleAdmin}},
+		{RolesToLevel([]string{RoleModerator, RoleSupport}), []string{RoleModerator, RoleSupport}},
+		{"support, item-curator", []string{RoleSupport, RoleItemCurator}},
+		{"root,admin", []string{RoleAdmin}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.level, func(t *testing.T) {
+			if got := RolesFromLevel(tc.level); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("RolesFromLevel() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRoleParams_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		params RoleParams
+		want   error
+	}{
+		{"valid", RoleParams{UserID: "u1", Role: RoleSupport}, nil},
+		{"no user", RoleParams{Role: RoleSupport}, RoleErrRequiredFields},
+		{"no role", RoleParams{UserID: "u1"}, RoleErrRequiredFields},
+		{"unknown role", RoleParams{UserID: "u1", Role: "hammer"}, RoleErrInvalid},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.params.Validate(); got != tc.want {
+				t.Errorf("Validate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package core
+
+// SearchMaxResults maximum number of search index hits resolved on keyword
+// searches ordered by relevance.
+const SearchMaxResults = 1000
+
+type (
+	// SearchIndex defines operation for full-text search index of catalogs and
+	// markets on item name, hero, origin, rarity and seller name.
+	SearchIndex interface {
+		SearchIndexWriter
+
+		// SearchCatalogs returns catalog ids that matches the keyword or its
+		// synonyms ordered by relevance.
+		SearchCatalogs(keyword string, synonyms SynonymDictionary) ([]string, error)
+
+		// SearchMarkets returns market ids that matches the keyword or its
+		// synonyms and the filter ordered by relevance.
+		SearchMarkets(keyword string, synonyms SynonymDictionary, filter MarketSearchFilter) ([]string, error)
+	}
+
+	// SearchIndexWriter defines operation for updating search index entries.
+	SearchIndexWriter interface {
+		// IndexCatalog adds or replaces catalog entry on the search index.
+		IndexCatalog(Catalog) error
+
+		// IndexMarket adds or replaces market entry on the search index.
+		IndexMarket(Market) error
+
+		// DeleteMarket removes market entry from the search index.
+		DeleteMarket(id string) error
+	}
+
+	// MarketSearchFilter represents exact match market fields applied on search
+	// index before limiting the hits, zero values are not filtered.
+	MarketSearchFilter struct {
+		Type   MarketType
+		Status MarketStatus
+		UserID string
+	}
+)
+
+// NewMarketSearchFilter returns search index filter from market find filter.
+func NewMarketSearchFilter(filter interface{}) MarketSearchFilter {
+	var m Market
+	switch f := filter.(type) {
+	case Market:
+		m = f
+	case *Market:
+		if f != nil {
+			m = *f
+		}
+	}
+
+	return MarketSearchFilter{m.Type, m.Status, m.UserID}
+}
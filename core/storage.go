@@ -5,10 +5,16 @@ const (
 	StorageUncaughtErr Errors = iota + 100
 	// StorageMergeErr storage object merge error.
 	StorageMergeErr
+	// StorageInvalidCursorErr storage pagination cursor error.
+	StorageInvalidCursorErr
+	// StorageInvalidFilterErr storage filter expression error.
+	StorageInvalidFilterErr
 )
 
 // init sets error text definition.
 func init() {
 	appErrorText[StorageUncaughtErr] = "un-handled storage error"
 	appErrorText[StorageMergeErr] = "object merge error"
+	appErrorText[StorageInvalidCursorErr] = "invalid pagination cursor"
+	appErrorText[StorageInvalidFilterErr] = "invalid filter expression"
 }
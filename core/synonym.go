@@ -0,0 +1,151 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Synonym error types.
+const (
+	SynonymErrNotFound Errors = iota + 2700
+	SynonymErrRequiredID
+	SynonymErrRequiredFields
+	SynonymErrInvalidTerm
+	SynonymErrDuplicate
+)
+
+// sets error text definition.
+func init() {
+	appErrorText[SynonymErrNotFound] = "synonym not found"
+	appErrorText[SynonymErrRequiredID] = "synonym id is required"
+	appErrorText[SynonymErrRequiredFields] = "synonym fields are required"
+	appErrorText[SynonymErrInvalidTerm] = "synonym term should be a single word"
+	appErrorText[SynonymErrDuplicate] = "synonym term already exists"
+}
+
+type (
+	// Synonym represents a search term and the words it stands for,
+	// e.g. hero nicknames like "am" for "anti-mage".
+	Synonym struct {
+		ID         string     `json:"id"         db:"id,omitempty"`
+		Term       string     `json:"term"       db:"term,omitempty,indexed" valid:"required"`
+		Expansions []string   `json:"expansions" db:"expansions,omitempty"   valid:"required,min=1"`
+		CreatedAt  *time.Time `json:"created_at" db:"created_at,omitempty"`
+		UpdatedAt  *time.Time `json:"updated_at" db:"updated_at,omitempty"`
+	}
+
+	// SynonymDictionary represents search term expansions by term.
+	SynonymDictionary map[string][]string
+
+	// SynonymService provides access to search synonym dictionary.
+	SynonymService interface {
+		// Synonyms returns a list of synonyms.
+		Synonyms(context.Context) ([]Synonym, error)
+
+		// Create saves new synonym and only accessible to item curators.
+		Create(context.Context, *Synonym) error
+
+		// Update saves synonym changes and only accessible to item curators.
+		Update(context.Context, *Synonym) error
+
+		// Delete removes synonym and only accessible to item curators.
+		Delete(ctx context.Context, id string) error
+
+		// Dictionary returns synonym dictionary for expanding search keywords.
+		Dictionary() (SynonymDictionary, error)
+	}
+
+	// SynonymStorage defines operation for synonym records.
+	SynonymStorage interface {
+		// Find returns a list of synonyms from data store.
+		Find(FindOpts) ([]Synonym, error)
+
+		// Get returns synonym details by id from data store.
+		Get(id string) (*Synonym, error)
+
+		// Create persists a new synonym to data store.
+		Create(*Synonym) error
+
+		// Update persists synonym changes to data store.
+		Update(*Synonym) error
+
+		// Delete removes synonym from data store.
+		Delete(id string) error
+	}
+)
+
+// CheckCreate validates and normalizes field on creating synonym.
+func (s *Synonym) CheckCreate() error {
+	s.Term = normalizeSynonym(s.Term)
+	var ee []string
+	for _, e := range s.Expansions {
+		if e = normalizeSynonym(e); e != "" && e != s.Term {
+			ee = append(ee, e)
+		}
+	}
+	s.Expansions = ee
+
+	if err := validator.Struct(s); err != nil {
+		return err
+	}
+	if strings.ContainsAny(s.Term, " \t") {
+		return SynonymErrInvalidTerm
+	}
+
+	return nil
+}
+
+// NewSynonymDictionary returns synonym dictionary by term.
+func NewSynonymDictionary(ss []Synonym) SynonymDictionary {
+	d := SynonymDictionary{}
+	for _, s := range ss {
+		t := normalizeSynonym(s.Term)
+		d[t] = append(d[t], s.Expansions...)
+	}
+
+	return d
+}
+
+// Expand returns words that the search term stands for.
+func (d SynonymDictionary) Expand(term string) []string {
+	return d[normalizeSynonym(term)]
+}
+
+func normalizeSynonym(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
+
+// DefaultSynonyms common hero nicknames used as initial synonym dictionary.
+var DefaultSynonyms = []Synonym{
+	{Term: "aa", Expansions: []string{"ancient apparition"}},
+	{Term: "alch", Expansions: []string{"alchemist"}},
+	{Term: "am", Expansions: []string{"anti-mage"}},
+	{Term: "bb", Expansions: []string{"bristleback"}},
+	{Term: "bh", Expansions: []string{"bounty hunter"}},
+	{Term: "bs", Expansions: []string{"bloodseeker"}},
+	{Term: "ck", Expansions: []string{"chaos knight"}},
+	{Term: "cm", Expansions: []string{"crystal maiden"}},
+	{Term: "dk", Expansions: []string{"dragon knight"}},
+	{Term: "dp", Expansions: []string{"death prophet"}},
+	{Term: "es", Expansions: []string{"earthshaker"}},
+	{Term: "jugg", Expansions: []string{"juggernaut"}},
+	{Term: "kotl", Expansions: []string{"keeper of the light"}},
+	{Term: "lc", Expansions: []string{"legion commander"}},
+	{Term: "ls", Expansions: []string{"lifestealer"}},
+	{Term: "naix", Expansions: []string{"lifestealer"}},
+	{Term: "od", Expansions: []string{"outworld destroyer"}},
+	{Term: "pa", Expansions: []string{"phantom assassin"}},
+	{Term: "pl", Expansions: []string{"phantom lancer"}},
+	{Term: "potm", Expansions: []string{"mirana"}},
+	{Term: "qop", Expansions: []string{"queen of pain"}},
+	{Term: "sb", Expansions: []string{"spirit breaker"}},
+	{Term: "sf", Expansions: []string{"shadow fiend"}},
+	{Term: "sk", Expansions: []string{"sand king", "wraith king"}},
+	{Term: "ss", Expansions: []string{"shadow shaman"}},
+	{Term: "ta", Expansions: []string{"templar assassin"}},
+	{Term: "tb", Expansions: []string{"terrorblade"}},
+	{Term: "wd", Expansions: []string{"witch doctor"}},
+	{Term: "wk", Expansions: []string{"wraith king"}},
+	{Term: "wr", Expansions: []string{"windranger"}},
+}
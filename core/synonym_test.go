@@ -0,0 +1,52 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSynonym_CheckCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      Synonym
+		want    Synonym
+		wantErr bool
+	}{
+		{"normalized", Synonym{Term: " AM ", Expansions: []string{"Anti-Mage "}}, Synonym{Term: "am", Expansions: []string{"anti-mage"}}, false},
+		{"drops empty and self expansions", Synonym{Term: "wk", Expansions: []string{"", "WK", "wraith  king"}}, Synonym{Term: "wk", Expansions: []string{"wraith king"}}, false},
+		{"multi word term", Synonym{Term: "anti mage", Expansions: []string{"am"}}, Synonym{}, true},
+		{"no expansions", Synonym{Term: "cm", Expansions: []string{" "}}, Synonym{}, true},
+		{"no term", Synonym{Expansions: []string{"crystal maiden"}}, Synonym{}, true},
+	}
+	for _, tc := range tests {
+		s := tc.in
+		err := s.CheckCreate()
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%s: CheckCreate() error = %v, wantErr %v", tc.name, err, tc.wantErr)
+			continue
+		}
+		if !tc.wantErr && !reflect.DeepEqual(s, tc.want) {
+			t.Errorf("%s: CheckCreate() = %v, want %v", tc.name, s, tc.want)
+		}
+	}
+}
+
+func TestSynonymDictionary_Expand(t *testing.T) {
+	dict := NewSynonymDictionary([]Synonym{
+		{Term: "sk", Expansions: []string{"sand king"}},
+		{Term: "SK", Expansions: []string{"wraith king"}},
+	})
+	tests := []struct {
+		term string
+		want []string
+	}{
+		{"sk", []string{"sand king", "wraith king"}},
+		{" Sk", []string{"sand king", "wraith king"}},
+		{"cm", nil},
+	}
+	for _, tc := range tests {
+		if got := dict.Expand(tc.term); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("Expand(%q) = %v, want %v", tc.term, got, tc.want)
+		}
+	}
+}
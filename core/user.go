@@ -67,7 +67,12 @@ type (
 		Subscription UserSubscription `json:"subscription"  db:"subscription,omitempty"`
 		SubscribedAt *time.Time       `json:"subscribed_at" db:"subscribed_at,omitempty"`
 		Boons        []string         `json:"boons"         db:"boons,omitempty"`
-		Hammer       bool             `json:"hammer"        db:"hammer,omitempty"`
+
+		// Roles grants staff permissions, see RolePermissions.
+		Roles []string `json:"roles" db:"roles,omitempty"`
+		// Deprecated: Hammer was the single admin flag before roles and only
+		// kept for migrating existing hammer users to admin role.
+		Hammer bool `json:"-" db:"hammer,omitempty"`
 	}
 
 	// UserService provides access to user service.
@@ -105,6 +110,9 @@ type (
 		// FindFlagged returns a list of flagged users from data store.
 		FindFlagged(opts FindOpts) ([]User, error)
 
+		// FindStaff returns a list of users with roles from data store.
+		FindStaff(opts FindOpts) ([]User, error)
+
 		// Get returns user details by id from data store.
 		Get(id string) (*User, error)
 
@@ -116,6 +124,14 @@ type (
 
 		// BaseUpdate persists user changes to data store without updating metadata.
 		BaseUpdate(*User) error
+
+		// AddRole adds role to user roles on data store in a single atomic
+		// write, role that user already has is kept once.
+		AddRole(id, role string) error
+
+		// RemoveRole removes role from user roles on data store in a single
+		// atomic write, unlike Update it persists empty roles.
+		RemoveRole(id, role string) error
 	}
 )
 
@@ -198,6 +214,21 @@ func (u User) HasBoon(ub UserBoon) bool {
 	return false
 }
 
+// HasRole returns true when user was granted the role.
+func (u User) HasRole(role string) bool {
+	for _, r := range u.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPermission returns true when any of user roles grants the permission.
+func (u User) HasPermission(permission string) bool {
+	return RolesHavePermission(u.Roles, permission)
+}
+
 var userSubscriptionLabels = map[UserSubscription]string{
 	UserSubscriptionSupporter: "SUPPORTER",
 	UserSubscriptionTrader:    "TRADER",
@@ -0,0 +1,193 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Watchlist error types.
+const (
+	WatchlistErrNotFound Errors = iota + 7200
+	WatchlistErrRequiredID
+	WatchlistErrRequiredFields
+	WatchlistErrRequiredThreshold
+	WatchlistErrDuplicateItem
+	WatchlistErrLimitReached
+)
+
+// sets error text definition.
+func init() {
+	appErrorText[WatchlistErrNotFound] = "watchlist not found"
+	appErrorText[WatchlistErrRequiredID] = "watchlist id is required"
+	appErrorText[WatchlistErrRequiredFields] = "watchlist fields are required"
+	appErrorText[WatchlistErrRequiredThreshold] = "watchlist requires ask below or bid above threshold"
+	appErrorText[WatchlistErrDuplicateItem] = "item is already on watchlist"
+	appErrorText[WatchlistErrLimitReached] = "watchlist limit per user reached"
+}
+
+// Watchlist alert types.
+const (
+	WatchlistAlertAskBelow = "ask_below"
+	WatchlistAlertBidAbove = "bid_above"
+)
+
+// MaxWatchlistPerUser limits watched items of a user.
+const MaxWatchlistPerUser = 100
+
+type (
+	// Watchlist represents user watched item with price alert thresholds.
+	// Thresholds are re-armed when the price moves back out of range so
+	// alerts are only recorded once per crossing.
+	Watchlist struct {
+		ID           string     `json:"id"            db:"id,omitempty"`
+		UserID       string     `json:"user_id"       db:"user_id,omitempty,indexed"`
+		ItemID       string     `json:"item_id"       db:"item_id,omitempty,indexed" valid:"required"`
+		AskBelow     float64    `json:"ask_below"     db:"ask_below,omitempty"       valid:"gte=0"`
+		BidAbove     float64    `json:"bid_above"     db:"bid_above,omitempty"       valid:"gte=0"`
+		AskTriggered *bool      `json:"ask_triggered" db:"ask_triggered,omitempty"`
+		BidTriggered *bool      `json:"bid_triggered" db:"bid_triggered,omitempty"`
+		CreatedAt    *time.Time `json:"created_at"    db:"created_at,omitempty"`
+		UpdatedAt    *time.Time `json:"updated_at"    db:"updated_at,omitempty"`
+		// Include related fields.
+		Item *Catalog `json:"item,omitempty" db:"-"`
+	}
+
+	// WatchlistAlert represents a triggered watchlist threshold.
+	WatchlistAlert struct {
+		ID          string     `json:"id"           db:"id,omitempty"`
+		WatchlistID string     `json:"watchlist_id" db:"watchlist_id,omitempty,indexed"`
+		UserID      string     `json:"user_id"      db:"user_id,omitempty,indexed"`
+		ItemID      string     `json:"item_id"      db:"item_id,omitempty"`
+		Type        string     `json:"type"         db:"type,omitempty"`
+		Threshold   float64    `json:"threshold"    db:"threshold,omitempty"`
+		Price       float64    `json:"price"        db:"price,omitempty"`
+		CreatedAt   *time.Time `json:"created_at"   db:"created_at,omitempty,indexed"`
+	}
+
+	// WatchlistService provides access to watchlist service.
+	WatchlistService interface {
+		// Watchlist returns watched items of the authenticated user.
+		Watchlist(context.Context) ([]Watchlist, error)
+
+		// Create adds item to the authenticated user watchlist.
+		Create(context.Context, *Watchlist) error
+
+		// Update saves watchlist threshold changes and re-arms its alerts.
+		Update(context.Context, *Watchlist) error
+
+		// Delete removes item from watchlist by id.
+		Delete(ctx context.Context, id string) error
+
+		// Alerts returns triggered alerts of the authenticated user ordered by recent first.
+		Alerts(context.Context) ([]WatchlistAlert, error)
+
+		// Evaluate checks catalog prices against item watchlist thresholds
+		// and records triggered alerts.
+		Evaluate(ctx context.Context, c Catalog) error
+	}
+
+	// WatchlistStorage defines operation for watchlist records.
+	WatchlistStorage interface {
+		// Find returns a list of watchlist from data store.
+		Find(FindOpts) ([]Watchlist, error)
+
+		// Get returns watchlist details by id from data store.
+		Get(id string) (*Watchlist, error)
+
+		// Create persists a new watchlist to data store.
+		Create(*Watchlist) error
+
+		// Update persists watchlist changes to data store.
+		Update(*Watchlist) error
+
+		// UpdateThresholds replaces alert thresholds and re-arms its alerts,
+		// unlike Update it persists zero threshold to clear it.
+		UpdateThresholds(*Watchlist) error
+
+		// Delete removes watchlist from data store.
+		Delete(id string) error
+	}
+
+	// WatchlistAlertStorage defines operation for watchlist alert records.
+	WatchlistAlertStorage interface {
+		// Find returns recent alerts of a user from data store.
+		Find(userID string, limit int) ([]WatchlistAlert, error)
+
+		// Create persists a new watchlist alert to data store.
+		Create(*WatchlistAlert) error
+	}
+)
+
+// CheckCreate validates field on creating watchlist.
+func (w Watchlist) CheckCreate() error {
+	if err := validator.Struct(w); err != nil {
+		return err
+	}
+
+	return w.checkThreshold()
+}
+
+// CheckUpdate validates field on updating watchlist.
+func (w Watchlist) CheckUpdate() error {
+	if w.ID == "" {
+		return WatchlistErrRequiredID
+	}
+	if w.AskBelow < 0 || w.BidAbove < 0 {
+		return fmt.Errorf("thresholds should not be negative")
+	}
+
+	return w.checkThreshold()
+}
+
+func (w Watchlist) checkThreshold() error {
+	if w.AskBelow == 0 && w.BidAbove == 0 {
+		return WatchlistErrRequiredThreshold
+	}
+
+	return nil
+}
+
+// Evaluate returns triggered alerts of catalog prices and updates the
+// watchlist alert state. Changed reports whether alert state needs saving.
+func (w *Watchlist) Evaluate(c Catalog) (alerts []WatchlistAlert, changed bool) {
+	// Lowest ask of zero means there are no live listings.
+	askHit := w.AskBelow > 0 && c.LowestAsk > 0 && c.LowestAsk < w.AskBelow
+	if toggleTrigger(&w.AskTriggered, askHit) {
+		changed = true
+		if askHit {
+			alerts = append(alerts, w.newAlert(WatchlistAlertAskBelow, w.AskBelow, c.LowestAsk))
+		}
+	}
+
+	bidHit := w.BidAbove > 0 && c.HighestBid > w.BidAbove
+	if toggleTrigger(&w.BidTriggered, bidHit) {
+		changed = true
+		if bidHit {
+			alerts = append(alerts, w.newAlert(WatchlistAlertBidAbove, w.BidAbove, c.HighestBid))
+		}
+	}
+
+	return alerts, changed
+}
+
+func (w *Watchlist) newAlert(typ string, threshold, price float64) WatchlistAlert {
+	return WatchlistAlert{
+		WatchlistID: w.ID,
+		UserID:      w.UserID,
+		ItemID:      w.ItemID,
+		Type:        typ,
+		Threshold:   threshold,
+		Price:       price,
+	}
+}
+
+// toggleTrigger sets triggered state to hit and reports whether it changed.
+func toggleTrigger(triggered **bool, hit bool) bool {
+	if cur := *triggered != nil && **triggered; cur == hit {
+		return false
+	}
+
+	*triggered = &hit
+	return true
+}
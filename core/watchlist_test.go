@@ -0,0 +1,36 @@
+package core
+
+import "testing"
+
+func TestWatchlist_Evaluate(t *testing.T) {
+	w := &Watchlist{ID: "w1", ItemID: "item", AskBelow: 10, BidAbove: 20}
+
+	tests := []struct {
+		name        string
+		catalog     Catalog
+		wantAlerts  []string
+		wantChanged bool
+	}{
+		{"out of range", Catalog{LowestAsk: 15, HighestBid: 5}, nil, false},
+		{"no live listings", Catalog{LowestAsk: 0, HighestBid: 5}, nil, false},
+		{"ask drops below", Catalog{LowestAsk: 9, HighestBid: 5}, []string{WatchlistAlertAskBelow}, true},
+		{"ask stays below", Catalog{LowestAsk: 8, HighestBid: 5}, nil, false},
+		{"bid rises above", Catalog{LowestAsk: 8, HighestBid: 21}, []string{WatchlistAlertBidAbove}, true},
+		{"both re-armed", Catalog{LowestAsk: 12, HighestBid: 19}, nil, true},
+		{"both triggered", Catalog{LowestAsk: 9.5, HighestBid: 25}, []string{WatchlistAlertAskBelow, WatchlistAlertBidAbove}, true},
+	}
+	for _, tc := range tests {
+		alerts, changed := w.Evaluate(tc.catalog)
+		if changed != tc.wantChanged {
+			t.Errorf("%s: changed got %v, want %v", tc.name, changed, tc.wantChanged)
+		}
+		if len(alerts) != len(tc.wantAlerts) {
+			t.Fatalf("%s: alerts got %d, want %d", tc.name, len(alerts), len(tc.wantAlerts))
+		}
+		for i, a := range alerts {
+			if a.Type != tc.wantAlerts[i] || a.WatchlistID != w.ID || a.ItemID != w.ItemID {
+				t.Errorf("%s: unexpected alert %+v", tc.name, a)
+			}
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package core
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/kudarap/dotagiftx/gokit/http/safeclient"
+)
+
+// Webhook error types.
+const (
+	WebhookErrNotFound Errors = iota + 7000
+	WebhookErrRequiredID
+	WebhookErrRequiredFields
+	WebhookErrInvalidEvent
+	WebhookErrLimitReached
+	WebhookErrInvalidURL
+)
+
+// sets error text definition.
+func init() {
+	appErrorText[WebhookErrNotFound] = "webhook not found"
+	appErrorText[WebhookErrRequiredID] = "webhook id is required"
+	appErrorText[WebhookErrRequiredFields] = "webhook fields are required"
+	appErrorText[WebhookErrInvalidEvent] = "webhook event not supported"
+	appErrorText[WebhookErrLimitReached] = "webhook limit per user reached"
+	appErrorText[WebhookErrInvalidURL] = "webhook url must be a valid https url"
+}
+
+// Webhook events sent to the owner of the market.
+const (
+	WebhookEventMarketReserved         = "market.reserved"
+	WebhookEventMarketSold             = "market.sold"
+	WebhookEventMarketExpired          = "market.expired"
+	WebhookEventMarketDeliveryVerified = "market.delivery_verified"
+	WebhookEventBidCompleted           = "bid.completed"
+)
+
+// WebhookEvents lists supported webhook events.
+var WebhookEvents = []string{
+	WebhookEventMarketReserved,
+	WebhookEventMarketSold,
+	WebhookEventMarketExpired,
+	WebhookEventMarketDeliveryVerified,
+	WebhookEventBidCompleted,
+}
+
+// Webhook delivery statuses.
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = 100
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = 200
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = 300
+)
+
+// Webhook request headers.
+const (
+	WebhookHeaderEvent     = "X-Dotagiftx-Event"
+	WebhookHeaderDelivery  = "X-Dotagiftx-Delivery"
+	WebhookHeaderTimestamp = "X-Dotagiftx-Timestamp"
+	WebhookHeaderSignature = "X-Dotagiftx-Signature"
+)
+
+const (
+	// MaxWebhooksPerUser limits registered webhooks of a user.
+	MaxWebhooksPerUser = 5
+
+	// WebhookRetryLimit number of attempts before delivery is marked failed.
+	WebhookRetryLimit = 6
+
+	// webhookRetryBackoff base delay of retries that doubles every attempt.
+	webhookRetryBackoff = time.Minute
+)
+
+type (
+	// WebhookDeliveryStatus represents webhook delivery status.
+	WebhookDeliveryStatus uint
+
+	// Webhook represents user registered URL that receives market events.
+	Webhook struct {
+		ID        string     `json:"id"         db:"id,omitempty"`
+		UserID    string     `json:"user_id"    db:"user_id,omitempty,indexed"`
+		URL       string     `json:"url"        db:"url,omitempty"        valid:"required,url"`
+		Events    []string   `json:"events"     db:"events,omitempty"     valid:"required,min=1"`
+		Secret    string     `json:"secret"     db:"secret,omitempty"`
+		Disabled  *bool      `json:"disabled"   db:"disabled,omitempty"`
+		CreatedAt *time.Time `json:"created_at" db:"created_at,omitempty"`
+		UpdatedAt *time.Time `json:"updated_at" db:"updated_at,omitempty"`
+	}
+
+	// WebhookDelivery represents a webhook request log and its retry state.
+	WebhookDelivery struct {
+		ID            string                `json:"id"              db:"id,omitempty"`
+		WebhookID     string                `json:"webhook_id"      db:"webhook_id,omitempty,indexed"`
+		Event         string                `json:"event"           db:"event,omitempty"`
+		Payload       string                `json:"payload"         db:"payload,omitempty"`
+		Status        WebhookDeliveryStatus `json:"status"          db:"status,omitempty,indexed"`
+		Attempts      int                   `json:"attempts"        db:"attempts,omitempty"`
+		ResponseCode  int                   `json:"response_code"   db:"response_code,omitempty"`
+		Error         string                `json:"error"           db:"error,omitempty"`
+		NextAttemptAt *time.Time            `json:"next_attempt_at" db:"next_attempt_at,omitempty"`
+		CreatedAt     *time.Time            `json:"created_at"      db:"created_at,omitempty,indexed"`
+		UpdatedAt     *time.Time            `json:"updated_at"      db:"updated_at,omitempty"`
+	}
+
+	// WebhookPayload represents request body sent to webhook URL.
+	WebhookPayload struct {
+		ID        string      `json:"id"`
+		Event     string      `json:"event"`
+		CreatedAt time.Time   `json:"created_at"`
+		Data      interface{} `json:"data"`
+	}
+
+	// WebhookService provides access to webhook service.
+	WebhookService interface {
+		// Webhooks returns a list of webhooks of the authenticated user.
+		Webhooks(context.Context) ([]Webhook, error)
+
+		// Webhook returns webhook details by id.
+		Webhook(ctx context.Context, id string) (*Webhook, error)
+
+		// Create saves new webhook and generates its signing secret.
+		Create(context.Context, *Webhook) error
+
+		// Update saves webhook changes.
+		Update(context.Context, *Webhook) error
+
+		// Delete removes webhook by id.
+		Delete(ctx context.Context, id string) error
+
+		// Deliveries returns request logs of a webhook ordered by recent first.
+		Deliveries(ctx context.Context, id string) ([]WebhookDelivery, error)
+
+		// Queue creates pending deliveries on user webhooks subscribed to the event.
+		Queue(userID, event string, data interface{}) error
+	}
+
+	// WebhookStorage defines operation for webhook records.
+	WebhookStorage interface {
+		// Find returns a list of webhooks from data store.
+		Find(FindOpts) ([]Webhook, error)
+
+		// Get returns webhook details by id from data store.
+		Get(id string) (*Webhook, error)
+
+		// Create persists a new webhook to data store.
+		Create(*Webhook) error
+
+		// Update persists webhook changes to data store.
+		Update(*Webhook) error
+
+		// Delete removes webhook from data store.
+		Delete(id string) error
+	}
+
+	// WebhookDeliveryStorage defines operation for webhook delivery records.
+	WebhookDeliveryStorage interface {
+		// Find returns deliveries of a webhook ordered by recent first.
+		Find(webhookID string, limit int) ([]WebhookDelivery, error)
+
+		// ToDeliver returns pending deliveries that are due for attempt.
+		ToDeliver(limit int) ([]WebhookDelivery, error)
+
+		// Create persists a new webhook delivery to data store.
+		Create(*WebhookDelivery) error
+
+		// Update persists webhook delivery changes to data store.
+		Update(*WebhookDelivery) error
+	}
+)
+
+// CheckCreate validates field on creating new webhook.
+func (w Webhook) CheckCreate() error {
+	// Check required fields.
+	if err := validator.Struct(w); err != nil {
+		return err
+	}
+	if err := safeclient.CheckURL(w.URL); err != nil {
+		return WebhookErrInvalidURL
+	}
+
+	return w.checkEvents()
+}
+
+// CheckUpdate validates field on updating webhook.
+func (w Webhook) CheckUpdate() error {
+	if w.ID == "" {
+		return WebhookErrRequiredID
+	}
+
+	if w.URL != "" {
+		if err := validator.Var(w.URL, "url"); err != nil {
+			return err
+		}
+		if err := safeclient.CheckURL(w.URL); err != nil {
+			return WebhookErrInvalidURL
+		}
+	}
+
+	return w.checkEvents()
+}
+
+func (w Webhook) checkEvents() error {
+	for _, e := range w.Events {
+		if !isWebhookEvent(e) {
+			return WebhookErrInvalidEvent
+		}
+	}
+
+	return nil
+}
+
+// IsDisabled returns true when webhook should not receive events.
+func (w Webhook) IsDisabled() bool {
+	return w.Disabled != nil && *w.Disabled
+}
+
+// HasEvent returns true when webhook is subscribed to the event.
+func (w Webhook) HasEvent(event string) bool {
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isWebhookEvent(event string) bool {
+	for _, e := range WebhookEvents {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Sign returns hex encoded HMAC-SHA256 signature of timestamp and body
+// joined by a dot using webhook secret.
+func (w Webhook) Sign(ts time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(w.Secret))
+	mac.Write([]byte(strconv.FormatInt(ts.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return fmt.Sprintf("sha256=%s", hex.EncodeToString(mac.Sum(nil)))
+}
+
+// Failed records failed delivery attempt and schedules the next retry
+// with exponential backoff, status is set to failed when retry limit reached.
+func (d *WebhookDelivery) Failed(code int, err error, t time.Time) {
+	d.Attempts++
+	d.ResponseCode = code
+	d.Error = err.Error()
+	if d.Attempts >= WebhookRetryLimit {
+		d.Status = WebhookDeliveryStatusFailed
+		return
+	}
+
+	backoff := webhookRetryBackoff * time.Duration(math.Pow(2, float64(d.Attempts-1)))
+	next := t.Add(backoff)
+	d.NextAttemptAt = &next
+}
+
+// Delivered records successful delivery attempt.
+func (d *WebhookDelivery) Delivered(code int) {
+	d.Attempts++
+	d.ResponseCode = code
+	d.Status = WebhookDeliveryStatusDelivered
+}
+
+var webhookDeliveryStatusTexts = map[WebhookDeliveryStatus]string{
+	WebhookDeliveryStatusPending:   "Pending",
+	WebhookDeliveryStatusDelivered: "Delivered",
+	WebhookDeliveryStatusFailed:    "Failed",
+}
+
+func (s WebhookDeliveryStatus) String() string {
+	t, ok := webhookDeliveryStatusTexts[s]
+	if !ok {
+		return strconv.Itoa(int(s))
+	}
+
+	return t
+}
@@ -0,0 +1,80 @@
+package core
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestWebhook_Sign(t *testing.T) {
+	ts := time.Unix(1600000000, 0)
+	body := []byte(`{"event":"market.sold"}`)
+	wh := Webhook{Secret: "secret"}
+
+	got := wh.Sign(ts, body)
+	if got != wh.Sign(ts, body) {
+		t.Error("signature should be deterministic")
+	}
+	if len(got) != len("sha256=")+64 {
+		t.Errorf("unexpected signature format %s", got)
+	}
+	if got == (Webhook{Secret: "other"}).Sign(ts, body) {
+		t.Error("signature should depend on secret")
+	}
+	if got == wh.Sign(ts.Add(time.Second), body) {
+		t.Error("signature should depend on timestamp")
+	}
+}
+
+func TestWebhookDelivery_Failed(t *testing.T) {
+	now := time.Now()
+	d := &WebhookDelivery{Status: WebhookDeliveryStatusPending}
+
+	tests := []struct {
+		wantStatus  WebhookDeliveryStatus
+		wantBackoff time.Duration
+	}{
+		{WebhookDeliveryStatusPending, time.Minute},
+		{WebhookDeliveryStatusPending, time.Minute * 2},
+		{WebhookDeliveryStatusPending, time.Minute * 4},
+		{WebhookDeliveryStatusPending, time.Minute * 8},
+		{WebhookDeliveryStatusPending, time.Minute * 16},
+		{WebhookDeliveryStatusFailed, 0},
+	}
+	for i, tc := range tests {
+		d.NextAttemptAt = nil
+		d.Failed(500, fmt.Errorf("server error"), now)
+		if d.Status != tc.wantStatus {
+			t.Errorf("attempt %d: status got %s, want %s", i+1, d.Status, tc.wantStatus)
+		}
+		if tc.wantBackoff == 0 {
+			if d.NextAttemptAt != nil {
+				t.Errorf("attempt %d: should not schedule next attempt", i+1)
+			}
+			continue
+		}
+		if d.NextAttemptAt == nil || d.NextAttemptAt.Sub(now) != tc.wantBackoff {
+			t.Errorf("attempt %d: next attempt got %v, want %s", i+1, d.NextAttemptAt, tc.wantBackoff)
+		}
+	}
+}
+
+func TestWebhook_CheckCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		webhook Webhook
+		wantErr bool
+	}{
+		{"valid", Webhook{URL: "https://bot.example.com/hook", Events: []string{WebhookEventMarketSold}}, false},
+		{"missing url", Webhook{Events: []string{WebhookEventMarketSold}}, true},
+		{"invalid url", Webhook{URL: "not a url", Events: []string{WebhookEventMarketSold}}, true},
+		{"plain http url", Webhook{URL: "http://bot.example.com/hook", Events: []string{WebhookEventMarketSold}}, true},
+		{"no events", Webhook{URL: "https://bot.example.com/hook"}, true},
+		{"unknown event", Webhook{URL: "https://bot.example.com/hook", Events: []string{"market.unknown"}}, true},
+	}
+	for _, tc := range tests {
+		if err := tc.webhook.CheckCreate(); (err != nil) != tc.wantErr {
+			t.Errorf("%s: CheckCreate() error = %v, wantErr %v", tc.name, err, tc.wantErr)
+		}
+	}
+}
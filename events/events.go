@@ -0,0 +1,187 @@
+package events
+
+import (
+	"context"
+	"fmt"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/kudarap/dotagiftx/core"
+)
+
+var json = jsoniter.ConfigFastest
+
+// Event types.
+const (
+	TypeMarketCreated       Type = "market.created"
+	TypeMarketUpdated       Type = "market.updated"
+	TypeMarketStatusChanged Type = "market.status_changed"
+	TypeDeliveryVerified    Type = "delivery.verified"
+	TypeInventoryVerified   Type = "inventory.verified"
+	TypeCatalogIndexed      Type = "catalog.indexed"
+	TypeUserBanned          Type = "user.banned"
+	TypeUserSuspended       Type = "user.suspended"
+	TypeUserLifted          Type = "user.lifted"
+)
+
+type (
+	// Type represents event type name.
+	Type string
+
+	// Event represents a domain event that happened on the system.
+	Event interface {
+		EventType() Type
+	}
+
+	// Handler reacts on published events.
+	Handler func(ctx context.Context, e Event) error
+
+	// Publisher provides access to publish events.
+	Publisher interface {
+		// Publish sends event to subscribed handlers.
+		Publish(ctx context.Context, e Event) error
+	}
+
+	// Subscriber provides access to subscribe on events.
+	Subscriber interface {
+		// Subscribe registers handler on event type.
+		Subscribe(t Type, h Handler)
+	}
+
+	// Bus represents event publisher and subscriber.
+	Bus interface {
+		Publisher
+		Subscriber
+	}
+)
+
+type (
+	// MarketCreated represents new market entry.
+	MarketCreated struct {
+		Market core.Market `json:"market"`
+	}
+
+	// MarketUpdated represents market entry changes.
+	MarketUpdated struct {
+		Market core.Market `json:"market"`
+	}
+
+	// MarketStatusChanged represents market status transition.
+	MarketStatusChanged struct {
+		Market     core.Market       `json:"market"`
+		PrevStatus core.MarketStatus `json:"prev_status"`
+		ActorID    string            `json:"actor_id"`
+	}
+
+	// DeliveryVerified represents delivery verification result of a market.
+	DeliveryVerified struct {
+		Delivery core.Delivery `json:"delivery"`
+	}
+
+	// InventoryVerified represents inventory verification result of a market.
+	InventoryVerified struct {
+		Inventory core.Inventory `json:"inventory"`
+	}
+
+	// CatalogIndexed represents catalog market summary that was re-indexed.
+	CatalogIndexed struct {
+		Catalog core.Catalog `json:"catalog"`
+	}
+
+	// UserBanned represents user that was banned by hammer user.
+	UserBanned struct {
+		User    core.User `json:"user"`
+		ActorID string    `json:"actor_id"`
+	}
+
+	// UserSuspended represents user that was suspended by hammer user.
+	UserSuspended struct {
+		User    core.User `json:"user"`
+		ActorID string    `json:"actor_id"`
+	}
+
+	// UserLifted represents user that ban or suspension was lifted by hammer user.
+	UserLifted struct {
+		User            core.User `json:"user"`
+		ActorID         string    `json:"actor_id"`
+		RestoreListings bool      `json:"restore_listings"`
+	}
+)
+
+func (MarketCreated) EventType() Type       { return TypeMarketCreated }
+func (MarketUpdated) EventType() Type       { return TypeMarketUpdated }
+func (MarketStatusChanged) EventType() Type { return TypeMarketStatusChanged }
+func (DeliveryVerified) EventType() Type    { return TypeDeliveryVerified }
+func (InventoryVerified) EventType() Type   { return TypeInventoryVerified }
+func (CatalogIndexed) EventType() Type      { return TypeCatalogIndexed }
+func (UserBanned) EventType() Type          { return TypeUserBanned }
+func (UserSuspended) EventType() Type       { return TypeUserSuspended }
+func (UserLifted) EventType() Type          { return TypeUserLifted }
+
+// registry maps event types to its decoder.
+var registry = map[Type]func(data []byte) (Event, error){
+	TypeMarketCreated: func(data []byte) (Event, error) {
+		var e MarketCreated
+		err := json.Unmarshal(data, &e)
+		return e, err
+	},
+	TypeMarketUpdated: func(data []byte) (Event, error) {
+		var e MarketUpdated
+		err := json.Unmarshal(data, &e)
+		return e, err
+	},
+	TypeMarketStatusChanged: func(data []byte) (Event, error) {
+		var e MarketStatusChanged
+		err := json.Unmarshal(data, &e)
+		return e, err
+	},
+	TypeDeliveryVerified: func(data []byte) (Event, error) {
+		var e DeliveryVerified
+		err := json.Unmarshal(data, &e)
+		return e, err
+	},
+	TypeInventoryVerified: func(data []byte) (Event, error) {
+		var e InventoryVerified
+		err := json.Unmarshal(data, &e)
+		return e, err
+	},
+	TypeCatalogIndexed: func(data []byte) (Event, error) {
+		var e CatalogIndexed
+		err := json.Unmarshal(data, &e)
+		return e, err
+	},
+	TypeUserBanned: func(data []byte) (Event, error) {
+		var e UserBanned
+		err := json.Unmarshal(data, &e)
+		return e, err
+	},
+	TypeUserSuspended: func(data []byte) (Event, error) {
+		var e UserSuspended
+		err := json.Unmarshal(data, &e)
+		return e, err
+	},
+	TypeUserLifted: func(data []byte) (Event, error) {
+		var e UserLifted
+		err := json.Unmarshal(data, &e)
+		return e, err
+	},
+}
+
+// Encode returns JSON representation of an event.
+func Encode(e Event) ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// Decode returns event value of type from its JSON representation.
+func Decode(t Type, data []byte) (Event, error) {
+	fn, ok := registry[t]
+	if !ok {
+		return nil, fmt.Errorf("event type %s not supported", t)
+	}
+
+	e, err := fn(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode %s event: %s", t, err)
+	}
+
+	return e, nil
+}
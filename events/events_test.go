@@ -0,0 +1,109 @@
+package events
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/gokit/log"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Event
+	}{
+		{"market created", MarketCreated{Market: core.Market{ID: "m1", Type: core.MarketTypeAsk}}},
+		{"market status changed", MarketStatusChanged{
+			Market:     core.Market{ID: "m1", Status: core.MarketStatusSold},
+			PrevStatus: core.MarketStatusReserved,
+			ActorID:    "u1",
+		}},
+		{"delivery verified", DeliveryVerified{Delivery: core.Delivery{MarketID: "m1"}}},
+		{"catalog indexed", CatalogIndexed{Catalog: core.Catalog{ID: "i1", LowestAsk: 1.5, HighestBid: 2}}},
+		{"user lifted", UserLifted{User: core.User{ID: "u1"}, ActorID: "u2", RestoreListings: true}},
+	}
+	for _, tc := range tests {
+		b, err := Encode(tc.in)
+		if err != nil {
+			t.Fatalf("%s: could not encode: %s", tc.name, err)
+		}
+		got, err := Decode(tc.in.EventType(), b)
+		if err != nil {
+			t.Fatalf("%s: could not decode: %s", tc.name, err)
+		}
+		if !reflect.DeepEqual(got, tc.in) {
+			t.Errorf("%s: got %#v, want %#v", tc.name, got, tc.in)
+		}
+	}
+
+	if _, err := Decode("unknown", []byte("{}")); err == nil {
+		t.Error("unknown event type should error")
+	}
+}
+
+func TestLocal(t *testing.T) {
+	var calls []string
+	b := NewLocal(log.Default())
+	b.Subscribe(TypeMarketCreated, func(_ context.Context, e Event) error {
+		calls = append(calls, "first:"+e.(MarketCreated).Market.ID)
+		return core.MarketErrNotFound
+	})
+	b.Subscribe(TypeMarketCreated, func(_ context.Context, e Event) error {
+		calls = append(calls, "second:"+e.(MarketCreated).Market.ID)
+		return nil
+	})
+
+	if err := b.Publish(context.Background(), MarketCreated{Market: core.Market{ID: "m1"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Publish(context.Background(), MarketUpdated{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"first:m1", "second:m1"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls got %v, want %v", calls, want)
+	}
+}
+
+func TestLocal_DispatchExcept(t *testing.T) {
+	var calls []string
+	failing := true
+	b := NewLocal(log.Default())
+	b.Subscribe(TypeMarketCreated, func(_ context.Context, e Event) error {
+		calls = append(calls, "first")
+		return nil
+	})
+	b.Subscribe(TypeMarketCreated, func(_ context.Context, e Event) error {
+		calls = append(calls, "second")
+		if failing {
+			return core.MarketErrNotFound
+		}
+		return nil
+	})
+
+	e := MarketCreated{Market: core.Market{ID: "m1"}}
+	ok, err := b.DispatchExcept(context.Background(), e, nil)
+	if err != core.MarketErrNotFound {
+		t.Fatalf("err got %v, want %v", err, core.MarketErrNotFound)
+	}
+	if !reflect.DeepEqual(ok, []int{0}) {
+		t.Fatalf("succeeded got %v, want [0]", ok)
+	}
+
+	// Redelivery only runs the failed handler.
+	failing = false
+	ok, err = b.DispatchExcept(context.Background(), e, map[int]bool{0: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ok, []int{1}) {
+		t.Errorf("succeeded got %v, want [1]", ok)
+	}
+	want := []string{"first", "second", "second"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls got %v, want %v", calls, want)
+	}
+}
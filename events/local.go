@@ -0,0 +1,71 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kudarap/dotagiftx/gokit/log"
+)
+
+// NewLocal returns an in-process event bus.
+func NewLocal(lg log.Logger) *Local {
+	return &Local{handlers: map[Type][]Handler{}, logger: lg}
+}
+
+// Local represents an in-process event bus that runs subscribed handlers
+// synchronously in order of subscription.
+type Local struct {
+	mu       sync.RWMutex
+	handlers map[Type][]Handler
+	logger   log.Logger
+}
+
+// Subscribe registers handler on event type.
+func (b *Local) Subscribe(t Type, h Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[t] = append(b.handlers[t], h)
+}
+
+// Publish runs all handlers subscribed on event type. Handler errors are
+// logged and will not stop the remaining handlers from running.
+func (b *Local) Publish(ctx context.Context, e Event) error {
+	_ = b.Dispatch(ctx, e)
+	return nil
+}
+
+// Dispatch runs all handlers subscribed on event type like Publish and
+// returns the first handler error so callers could redeliver the event.
+func (b *Local) Dispatch(ctx context.Context, e Event) error {
+	_, err := b.DispatchExcept(ctx, e, nil)
+	return err
+}
+
+// DispatchExcept runs handlers subscribed on event type that are not in
+// handled subscription indexes and returns indexes of the succeeded ones with
+// the first handler error, so callers could redeliver the event to failed
+// handlers only.
+func (b *Local) DispatchExcept(ctx context.Context, e Event, handled map[int]bool) ([]int, error) {
+	b.mu.RLock()
+	hh := b.handlers[e.EventType()]
+	b.mu.RUnlock()
+
+	var ok []int
+	var first error
+	for i, h := range hh {
+		if handled[i] {
+			continue
+		}
+		if err := h(ctx, e); err != nil {
+			b.logger.Errorf("could not handle %s event: %s", e.EventType(), err)
+			if first == nil {
+				first = err
+			}
+			continue
+		}
+		ok = append(ok, i)
+	}
+
+	return ok, first
+}
@@ -93,7 +93,7 @@ func GenerateCompletedBid(marketStore core.MarketStorage, userStore core.UserSto
 }
 
 // AutoCompleteBid searches for exiting reservations that has buy order and resolve it.
-func AutoCompleteBid(marketSvc core.MarketService) {
+func AutoCompleteBid(marketSvc core.MarketService) error {
 	ctx := context.Background()
 	f := core.Market{
 		Type:   core.MarketTypeAsk,
@@ -101,8 +101,7 @@ func AutoCompleteBid(marketSvc core.MarketService) {
 	}
 	res, _, err := marketSvc.Markets(ctx, core.FindOpts{Filter: f})
 	if err != nil {
-		log.Println("err", err)
-		return
+		return err
 	}
 
 	for _, m := range res {
@@ -113,14 +112,16 @@ func AutoCompleteBid(marketSvc core.MarketService) {
 
 		log.Println("bid completed", m.ItemID, m.PartnerSteamID)
 	}
+
+	return nil
 }
 
-func ResolveCompletedBidSteamID(store core.MarketStorage, steam core.SteamClient) {
+// ResolveCompletedBidSteamID replaces partner steam profile URL into steam ID.
+func ResolveCompletedBidSteamID(store core.MarketStorage, steam core.SteamClient) error {
 	o := core.FindOpts{Filter: core.Market{Status: core.MarketStatusBidCompleted}}
 	res, err := store.Find(o)
 	if err != nil {
-		log.Println("err", err)
-		return
+		return err
 	}
 
 	for _, m := range res {
@@ -141,4 +142,6 @@ func ResolveCompletedBidSteamID(store core.MarketStorage, steam core.SteamClient
 
 		log.Println(m.PartnerSteamID, "fixed")
 	}
+
+	return nil
 }
@@ -9,17 +9,23 @@ import (
 	"github.com/kudarap/dotagiftx/core"
 )
 
+// ReIndexAll rebuilds catalog entries of all items.
 func ReIndexAll(
 	itemStg core.ItemStorage,
 	catalogStg core.CatalogStorage,
-) {
-	ii, _ := itemStg.Find(core.FindOpts{})
+) error {
+	ii, err := itemStg.Find(core.FindOpts{})
+	if err != nil {
+		return err
+	}
+
 	for _, item := range ii {
-		if _, err := catalogStg.Index(item.ID); err != nil {
+		if _, err = catalogStg.Index(item.ID); err != nil {
 			log.Println("err", err)
 		}
-
 	}
+
+	return nil
 }
 
 func GenerateFakeMarket(
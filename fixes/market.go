@@ -8,10 +8,11 @@ import (
 	"github.com/kudarap/dotagiftx/steam"
 )
 
-func MarketSetRankingScores(userSvc core.UserService, marketSvc core.MarketService) {
+// MarketSetRankingScores updates user rank score of all market entries.
+func MarketSetRankingScores(userSvc core.UserService, marketSvc core.MarketService) error {
 	users, err := userSvc.Users(core.FindOpts{})
 	if err != nil {
-		panic(err)
+		return err
 	}
 
 	for _, uu := range users {
@@ -24,10 +25,15 @@ func MarketSetRankingScores(userSvc core.UserService, marketSvc core.MarketServi
 	}
 
 	fmt.Println("market user score done!")
+	return nil
 }
 
-func MarketIndexRebuild(marketStg core.MarketStorage) {
-	res, _ := marketStg.Find(core.FindOpts{})
+// MarketIndexRebuild re-indexes all market entries search text and related fields.
+func MarketIndexRebuild(marketStg core.MarketStorage) error {
+	res, err := marketStg.Find(core.FindOpts{})
+	if err != nil {
+		return err
+	}
 
 	for _, rr := range res {
 		if _, err := marketStg.Index(rr.ID); err != nil {
@@ -39,6 +45,7 @@ func MarketIndexRebuild(marketStg core.MarketStorage) {
 	}
 
 	fmt.Println("market index done!")
+	return nil
 }
 
 // MarketExtractProfileURLFromNotes WARNING! only use these once and just keeping for reference.
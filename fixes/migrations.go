@@ -0,0 +1,82 @@
+package fixes
+
+import (
+	"context"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/migration"
+)
+
+// Migrations returns data fixes as tracked migrations. Fixes rewrites existing
+// records and some calls external services per record, they are not safe to
+// re-apply and has nothing to revert so they are only applied explicitly with
+// migrate fixes command and never on start.
+func Migrations(
+	itemStg core.ItemStorage,
+	catalogStg core.CatalogStorage,
+	marketStg core.MarketStorage,
+	synonymStg core.SynonymStorage,
+	userSvc core.UserService,
+	marketSvc core.MarketService,
+	steam core.SteamClient,
+	priceSvc core.PriceHistoryService,
+) []migration.Migration {
+	return []migration.Migration{
+		{
+			Name: "0100_catalog_reindex_all",
+			Up: func() error {
+				return ReIndexAll(itemStg, catalogStg)
+			},
+		},
+		{
+			Name: "0101_market_index_rebuild",
+			Up: func() error {
+				return MarketIndexRebuild(marketStg)
+			},
+		},
+		{
+			Name: "0102_market_ranking_scores",
+			Up: func() error {
+				return MarketSetRankingScores(userSvc, marketSvc)
+			},
+		},
+		{
+			Name: "0103_bid_resolve_completed_steam_id",
+			Up: func() error {
+				return ResolveCompletedBidSteamID(marketStg, steam)
+			},
+		},
+		{
+			Name: "0104_bid_auto_complete",
+			Up: func() error {
+				return AutoCompleteBid(marketSvc)
+			},
+		},
+		{
+			Name: "0105_price_history_backfill",
+			Up: func() error {
+				return priceSvc.Backfill(context.Background())
+			},
+		},
+		{
+			Name: "0106_search_synonyms_seed",
+			Up: func() error {
+				return SeedSynonyms(synonymStg)
+			},
+		},
+	}
+}
+
+// DeployMigrations returns data fixes required by the running version that
+// are safe to re-apply, they are applied along with schema migrations on
+// migrate up and on start when auto-migrate is enabled.
+func DeployMigrations(userStg core.UserStorage, roleAuditStg core.RoleAuditStorage) []migration.Migration {
+	return []migration.Migration{
+		{
+			Name: "0107_hammer_users_admin_role",
+			Up: func() error {
+				return HammerUsersToAdmin(userStg, roleAuditStg)
+			},
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package fixes
+
+import (
+	"fmt"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+// roleMigrationActor identifies role audits made by data fixes.
+const roleMigrationActor = "migration"
+
+// HammerUsersToAdmin grants admin role to users with the deprecated hammer flag.
+func HammerUsersToAdmin(userStg core.UserStorage, auditStg core.RoleAuditStorage) error {
+	uu, err := userStg.Find(core.FindOpts{Filter: core.User{Hammer: true}})
+	if err != nil {
+		return err
+	}
+
+	var n int
+	for _, u := range uu {
+		if !u.Hammer || u.HasRole(core.RoleAdmin) {
+			continue
+		}
+
+		if err = userStg.AddRole(u.ID, core.RoleAdmin); err != nil {
+			return err
+		}
+		err = auditStg.Create(&core.RoleAudit{
+			UserID:  u.ID,
+			Role:    core.RoleAdmin,
+			Action:  core.RoleActionGrant,
+			ActorID: roleMigrationActor,
+			Reason:  "migrated from hammer flag",
+		})
+		if err != nil {
+			return err
+		}
+		n++
+	}
+
+	fmt.Println("hammer users to admin done!", n)
+	return nil
+}
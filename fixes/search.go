@@ -0,0 +1,58 @@
+package fixes
+
+import (
+	"fmt"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+// SearchIndexRebuild adds all catalogs and market entries to the search index.
+func SearchIndexRebuild(
+	searchIdx core.SearchIndex,
+	catalogStg core.CatalogStorage,
+	marketStg core.MarketStorage,
+) error {
+	cc, err := catalogStg.Find(core.FindOpts{})
+	if err != nil {
+		return err
+	}
+	for _, c := range cc {
+		if err = searchIdx.IndexCatalog(c); err != nil {
+			fmt.Println("catalog search index error:", err)
+		}
+	}
+
+	mm, err := marketStg.Find(core.FindOpts{})
+	if err != nil {
+		return err
+	}
+	for _, m := range mm {
+		if err = searchIdx.IndexMarket(m); err != nil {
+			fmt.Println("market search index error:", err)
+		}
+	}
+
+	fmt.Println("search index done!", len(cc), len(mm))
+	return nil
+}
+
+// SeedSynonyms creates default synonyms that are not yet in the dictionary.
+func SeedSynonyms(synonymStg core.SynonymStorage) error {
+	res, err := synonymStg.Find(core.FindOpts{})
+	if err != nil {
+		return err
+	}
+	dict := core.NewSynonymDictionary(res)
+
+	for _, s := range core.DefaultSynonyms {
+		if _, ok := dict[s.Term]; ok {
+			continue
+		}
+		s := s
+		if err = synonymStg.Create(&s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+// Package safeclient provides HTTP client for requesting user supplied URLs
+// that refuses to connect to private, loopback and link-local addresses.
+package safeclient
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// ErrBlockedAddress returned when a URL host resolves to a non-public address.
+var ErrBlockedAddress = errors.New("address is not allowed")
+
+// New returns HTTP client that checks every dialed address, including the ones
+// resolved on redirects and DNS re-binding, is publicly routable.
+func New(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: control,
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// Proxy is not used since the dialed address would be the proxy.
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+	}
+}
+
+// CheckURL validates URL uses https scheme and has a host.
+func CheckURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("url scheme must be https")
+	}
+	if u.Hostname() == "" {
+		return fmt.Errorf("url host is required")
+	}
+
+	return nil
+}
+
+// IsBlocked returns true when error is caused by a blocked address.
+func IsBlocked(err error) bool {
+	return errors.Is(err, ErrBlockedAddress)
+}
+
+// control runs after the host was resolved and before connecting to it.
+func control(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, host)
+	}
+
+	return nil
+}
+
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() {
+		return false
+	}
+	for _, n := range blockedNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// blockedNets lists special purpose ranges not covered by net.IP checks.
+var blockedNets = func() []*net.IPNet {
+	var nets []*net.IPNet
+	for _, cidr := range []string{
+		"0.0.0.0/8",     // this network
+		"100.64.0.0/10", // carrier-grade NAT
+		"192.0.0.0/24",  // IETF protocol assignments
+		"198.18.0.0/15", // benchmarking
+		"240.0.0.0/4",   // reserved
+		"64:ff9b::/96",  // IPv4/IPv6 translation
+	} {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, n)
+	}
+	return nets
+}()
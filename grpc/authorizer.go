@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+	"github.com/kudarap/dotagiftx/gokit/http/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const authMetadataKey = "authorization"
+
+// unaryInterceptor authenticates requests and converts returned errors
+// into gRPC status.
+func unaryInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := handler(ctx, req)
+	return res, toStatus(err)
+}
+
+// streamInterceptor authenticates streams and converts returned errors
+// into gRPC status.
+func streamInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	return toStatus(handler(srv, &authStream{ss, ctx}))
+}
+
+// authenticate injects auth details when request has a valid access token
+// on "authorization" metadata using the same bearer format as the REST API,
+// requests without token are anonymous.
+func authenticate(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	token := md.Get(authMetadataKey)
+	if len(token) == 0 {
+		return ctx, nil
+	}
+
+	c, err := jwt.ParseFromHeader(http.Header{"Authorization": token})
+	if err != nil {
+		return nil, toStatus(errors.New(core.AuthErrNoAccess, err))
+	}
+	if c.UserID == "" {
+		return nil, toStatus(core.AuthErrNoAccess)
+	}
+
+	return core.AuthToContext(ctx, &core.Auth{
+		UserID: c.UserID,
+	}), nil
+}
+
+// authStream overrides server stream context with auth details.
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+// requireAuth returns error when request is anonymous.
+func requireAuth(ctx context.Context) error {
+	if core.AuthFromContext(ctx) == nil {
+		return core.AuthErrNoAccess
+	}
+
+	return nil
+}
+
+// publicContext removes auth details from context for services that scope
+// results to authorized user.
+func publicContext(ctx context.Context) context.Context {
+	return core.AuthToContext(ctx, nil)
+}
+
+// isAnonymous checks request has no auth details and buyer details should
+// be redacted.
+func isAnonymous(ctx context.Context) bool {
+	return core.AuthFromContext(ctx) == nil
+}
@@ -0,0 +1,207 @@
+package grpc
+
+import (
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/grpc/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func toUser(u *core.User) *pb.User {
+	if u == nil {
+		return nil
+	}
+
+	return &pb.User{
+		Id:           u.ID,
+		SteamId:      u.SteamID,
+		Name:         u.Name,
+		Url:          u.URL,
+		Avatar:       u.Avatar,
+		Status:       uint32(u.Status),
+		RankScore:    int32(u.RankScore),
+		Subscription: uint32(u.Subscription),
+		Boons:        u.Boons,
+		MarketStats:  toMarketStatusCount(&u.MarketStats),
+		CreatedAt:    toTimestamp(u.CreatedAt),
+		UpdatedAt:    toTimestamp(u.UpdatedAt),
+	}
+}
+
+func toItem(i *core.Item) *pb.Item {
+	if i == nil {
+		return nil
+	}
+
+	return &pb.Item{
+		Id:        i.ID,
+		Slug:      i.Slug,
+		Name:      i.Name,
+		Hero:      i.Hero,
+		Image:     i.Image,
+		Origin:    i.Origin,
+		Rarity:    i.Rarity,
+		ViewCount: int32(i.ViewCount),
+		CreatedAt: toTimestamp(i.CreatedAt),
+		UpdatedAt: toTimestamp(i.UpdatedAt),
+	}
+}
+
+func toItems(list []core.Item) []*pb.Item {
+	res := make([]*pb.Item, len(list))
+	for i := range list {
+		res[i] = toItem(&list[i])
+	}
+
+	return res
+}
+
+// toMarket converts market and leaves out buyer details of bids when redact
+// is set, same as public market listing.
+func toMarket(m *core.Market, redact bool) *pb.Market {
+	if m == nil {
+		return nil
+	}
+
+	res := &pb.Market{
+		Id:              m.ID,
+		UserId:          m.UserID,
+		ItemId:          m.ItemID,
+		Type:            pb.MarketType(m.Type),
+		Status:          pb.MarketStatus(m.Status),
+		Price:           m.Price,
+		Currency:        m.Currency,
+		ListPrice:       m.ListPrice,
+		ListCurrency:    m.ListCurrency,
+		Notes:           m.Notes,
+		InventoryStatus: uint32(m.InventoryStatus),
+		DeliveryStatus:  uint32(m.DeliveryStatus),
+		UserRankScore:   int32(m.UserRankScore),
+		CreatedAt:       toTimestamp(m.CreatedAt),
+		UpdatedAt:       toTimestamp(m.UpdatedAt),
+		User:            toUser(m.User),
+		Item:            toItem(m.Item),
+	}
+	if redact && m.Type == core.MarketTypeBid {
+		res.UserId = ""
+		res.User = nil
+	}
+
+	return res
+}
+
+func toMarkets(list []core.Market, redact bool) []*pb.Market {
+	res := make([]*pb.Market, len(list))
+	for i := range list {
+		res[i] = toMarket(&list[i], redact)
+	}
+
+	return res
+}
+
+func toCatalog(c *core.Catalog, redact bool) *pb.Catalog {
+	if c == nil {
+		return nil
+	}
+
+	return &pb.Catalog{
+		Id:            c.ID,
+		Slug:          c.Slug,
+		Name:          c.Name,
+		Hero:          c.Hero,
+		Image:         c.Image,
+		Origin:        c.Origin,
+		Rarity:        c.Rarity,
+		ViewCount:     int32(c.ViewCount),
+		Quantity:      int32(c.Quantity),
+		LowestAsk:     c.LowestAsk,
+		MedianAsk:     c.MedianAsk,
+		HighestBid:    c.HighestBid,
+		BidCount:      int32(c.BidCount),
+		ReservedCount: int32(c.ReservedCount),
+		SoldCount:     int32(c.SoldCount),
+		SaleCount:     int32(c.SaleCount),
+		AvgSale:       c.AvgSale,
+		RecentAsk:     toTimestamp(c.RecentAsk),
+		RecentBid:     toTimestamp(c.RecentBid),
+		RecentSale:    toTimestamp(c.RecentSale),
+		CreatedAt:     toTimestamp(c.CreatedAt),
+		UpdatedAt:     toTimestamp(c.UpdatedAt),
+		Asks:          toMarkets(c.Asks, redact),
+		Bids:          toMarkets(c.Bids, redact),
+	}
+}
+
+func toCatalogs(list []core.Catalog, redact bool) []*pb.Catalog {
+	res := make([]*pb.Catalog, len(list))
+	for i := range list {
+		res[i] = toCatalog(&list[i], redact)
+	}
+
+	return res
+}
+
+func toCatalogFacets(f *core.CatalogFacets) *pb.CatalogFacets {
+	if f == nil {
+		return nil
+	}
+
+	res := &pb.CatalogFacets{
+		Hero:   toFacetCounts(f.Hero),
+		Rarity: toFacetCounts(f.Rarity),
+		Origin: toFacetCounts(f.Origin),
+	}
+	for _, p := range f.Price {
+		res.Price = append(res.Price, &pb.PriceRangeCount{
+			Min:   p.Min,
+			Max:   p.Max,
+			Count: int32(p.Count),
+		})
+	}
+
+	return res
+}
+
+func toFacetCounts(list []core.FacetCount) []*pb.FacetCount {
+	res := make([]*pb.FacetCount, len(list))
+	for i, f := range list {
+		res[i] = &pb.FacetCount{Value: f.Value, Count: int32(f.Count)}
+	}
+
+	return res
+}
+
+func toMarketStatusCount(c *core.MarketStatusCount) *pb.MarketStatusCount {
+	if c == nil {
+		return nil
+	}
+
+	return &pb.MarketStatusCount{
+		Pending:                int32(c.Pending),
+		Live:                   int32(c.Live),
+		Reserved:               int32(c.Reserved),
+		Sold:                   int32(c.Sold),
+		Removed:                int32(c.Removed),
+		Cancelled:              int32(c.Cancelled),
+		BidLive:                int32(c.BidLive),
+		BidCompleted:           int32(c.BidCompleted),
+		DeliveryNoHit:          int32(c.DeliveryNoHit),
+		DeliveryNameVerified:   int32(c.DeliveryNameVerified),
+		DeliverySenderVerified: int32(c.DeliverySenderVerified),
+		DeliveryPrivate:        int32(c.DeliveryPrivate),
+		DeliveryError:          int32(c.DeliveryError),
+		InventoryNoHit:         int32(c.InventoryNoHit),
+		InventoryVerified:      int32(c.InventoryVerified),
+		InventoryPrivate:       int32(c.InventoryPrivate),
+		InventoryError:         int32(c.InventoryError),
+	}
+}
@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// notFoundErrors maps resource not found error types to NotFound code.
+var notFoundErrors = map[core.Errors]struct{}{
+	core.ItemErrNotFound:    {},
+	core.CatalogErrNotFound: {},
+	core.MarketErrNotFound:  {},
+	core.UserErrNotFound:    {},
+}
+
+// toStatus converts handled errors into gRPC status with the same error
+// message as the REST API.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	code := codes.Internal
+	if cErr, ok := errors.Parse(err); ok {
+		code = codes.InvalidArgument
+		if _, ok = notFoundErrors[cErr.Type]; ok {
+			code = codes.NotFound
+		} else if cErr.Fatal || cErr.IsEqual(core.StorageUncaughtErr) {
+			code = codes.Internal
+		} else if cErr.IsEqual(core.AuthErrNoAccess) {
+			code = codes.Unauthenticated
+		} else if cErr.IsEqual(core.AuthErrForbidden) {
+			code = codes.PermissionDenied
+		}
+	}
+
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+	"github.com/kudarap/dotagiftx/grpc/pb"
+)
+
+const (
+	defaultPageLimit = 10
+	maxPageLimit     = 100
+)
+
+// findOpts returns find options from list options that follows the same
+// sort, cursor and filter expression format as listing query.
+func findOpts(o *pb.ListOptions, filter interface{}) (core.FindOpts, error) {
+	opts := core.FindOpts{
+		Keyword:  o.GetKeyword(),
+		Page:     int(o.GetPage()),
+		Limit:    int(o.GetLimit()),
+		Filter:   filter,
+		WithMeta: true,
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = defaultPageLimit
+	} else if opts.Limit > maxPageLimit {
+		opts.Limit = maxPageLimit
+	}
+	opts.Sort, opts.Desc = parseSort(o.GetSort())
+
+	// Set cursor pagination that continues on its own sort.
+	if c := o.GetCursor(); c != "" {
+		cur, err := core.DecodeCursor(c)
+		if err != nil {
+			return core.FindOpts{}, err
+		}
+		opts.Cursor = cur
+		opts.Sort, opts.Desc = cur.Sort, cur.Desc
+	}
+
+	exprs, err := filterExprs(o.GetWhere(), filter)
+	if err != nil {
+		return core.FindOpts{}, err
+	}
+	opts.FilterExprs = exprs
+
+	return opts, nil
+}
+
+const sortDescSuffix = ":desc"
+
+func parseSort(s string) (field string, isDesc bool) {
+	field = strings.Split(s, ":")[0]
+	return field, strings.HasSuffix(s, sortDescSuffix)
+}
+
+// filterExprs returns filter expressions like price[gte]=5 validated against
+// filter resource fields. List values on "in" operator are comma separated.
+func filterExprs(where map[string]string, filter interface{}) ([]core.FilterExpr, error) {
+	if len(where) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(where))
+	for k := range where {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	f, ok := filter.(core.ExprFilterer)
+	if !ok {
+		return nil, errors.New(core.StorageInvalidFilterErr, fmt.Errorf("filter expressions are not supported"))
+	}
+
+	var exprs []core.FilterExpr
+	for _, k := range keys {
+		e, err := core.ParseFilterExpr(f.FilterExprFields(), k, where[k])
+		if err != nil {
+			return nil, errors.New(core.StorageInvalidFilterErr, err)
+		}
+		exprs = append(exprs, *e)
+	}
+
+	return exprs, nil
+}
+
+func pageInfo(md *core.FindMetadata) *pb.PageInfo {
+	if md == nil {
+		return nil
+	}
+
+	return &pb.PageInfo{
+		ResultCount: int32(md.ResultCount),
+		TotalCount:  int32(md.TotalCount),
+		NextCursor:  md.NextCursor,
+	}
+}
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/grpc/pb"
+)
+
+func TestFindOpts(t *testing.T) {
+	filter := &core.Market{ItemID: "i1"}
+	cursor := core.Cursor{Sort: "price", Desc: true, Value: 2.5, ID: "m1"}
+	tests := []struct {
+		name    string
+		in      *pb.ListOptions
+		want    core.FindOpts
+		wantErr bool
+	}{
+		{
+			"defaults",
+			nil,
+			core.FindOpts{Limit: defaultPageLimit, Filter: filter, WithMeta: true},
+			false,
+		},
+		{
+			"sort and page",
+			&pb.ListOptions{Keyword: "axe", Sort: "price:desc", Page: 2, Limit: 500},
+			core.FindOpts{Keyword: "axe", Sort: "price", Desc: true, Page: 2, Limit: maxPageLimit, Filter: filter, WithMeta: true},
+			false,
+		},
+		{
+			"cursor overrides sort",
+			&pb.ListOptions{Sort: "created_at", Cursor: cursor.Encode()},
+			core.FindOpts{Sort: "price", Desc: true, Limit: defaultPageLimit, Cursor: &cursor, Filter: filter, WithMeta: true},
+			false,
+		},
+		{
+			"filter expressions",
+			&pb.ListOptions{Where: map[string]string{"price[lt]": "9", "status[in]": "200,300"}},
+			core.FindOpts{Limit: defaultPageLimit, Filter: filter, WithMeta: true, FilterExprs: []core.FilterExpr{
+				{Field: "price", Op: core.FilterOpLt, Type: core.FilterTypeNumber, Values: []interface{}{9.0}},
+				{Field: "status", Op: core.FilterOpIn, Type: core.FilterTypeNumber, Values: []interface{}{200.0, 300.0}},
+			}},
+			false,
+		},
+		{
+			"invalid cursor",
+			&pb.ListOptions{Cursor: "not-a-cursor"},
+			core.FindOpts{},
+			true,
+		},
+		{
+			"unknown filter field",
+			&pb.ListOptions{Where: map[string]string{"notes[in]": "a"}},
+			core.FindOpts{},
+			true,
+		},
+		{
+			"invalid filter key",
+			&pb.ListOptions{Where: map[string]string{"price": "9"}},
+			core.FindOpts{},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findOpts(tt.in, filter)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("findOpts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("findOpts() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/events"
+	"github.com/kudarap/dotagiftx/gokit/log"
+	"github.com/kudarap/dotagiftx/grpc/pb"
+)
+
+// marketStreamBuffer number of pending events per stream before it starts
+// dropping events of slow clients.
+const marketStreamBuffer = 64
+
+// marketPublicStatuses lists statuses of markets streamed to other users,
+// owners receives events of all statuses of their markets.
+var marketPublicStatuses = map[core.MarketStatus]bool{
+	core.MarketStatusLive:         true,
+	core.MarketStatusReserved:     true,
+	core.MarketStatusSold:         true,
+	core.MarketStatusBidCompleted: true,
+}
+
+// marketBroker fans out market events to subscribed streams of this server,
+// it relies on broadcast subscription so every server receives all events.
+type marketBroker struct {
+	mu     sync.RWMutex
+	subs   map[*marketSubscription]struct{}
+	logger log.Logger
+}
+
+type marketSubscription struct {
+	filter *pb.StreamMarketsRequest
+	// userID of authenticated stream client, empty on anonymous.
+	userID  string
+	events  chan marketEvent
+	dropped uint64
+}
+
+type marketEvent struct {
+	eventType  pb.MarketEventType
+	market     core.Market
+	prevStatus core.MarketStatus
+	sentAt     time.Time
+}
+
+func newMarketBroker(lg log.Logger) *marketBroker {
+	return &marketBroker{subs: map[*marketSubscription]struct{}{}, logger: lg}
+}
+
+func (b *marketBroker) subscribe(filter *pb.StreamMarketsRequest, userID string) *marketSubscription {
+	sub := &marketSubscription{
+		filter: filter,
+		userID: userID,
+		events: make(chan marketEvent, marketStreamBuffer),
+	}
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+	return sub
+}
+
+func (b *marketBroker) unsubscribe(sub *marketSubscription) {
+	b.mu.Lock()
+	delete(b.subs, sub)
+	b.mu.Unlock()
+
+	if n := atomic.LoadUint64(&sub.dropped); n != 0 {
+		b.logger.Errorf("market stream closed with %d dropped events", n)
+	}
+}
+
+// handle publishes market events to matching streams and never blocks the
+// event bus, events are dropped when stream buffer is full.
+func (b *marketBroker) handle(_ context.Context, e events.Event) error {
+	me := marketEvent{sentAt: time.Now()}
+	switch ev := e.(type) {
+	case events.MarketCreated:
+		me.eventType = pb.MarketEventType_MARKET_EVENT_TYPE_CREATED
+		me.market = ev.Market
+	case events.MarketUpdated:
+		me.eventType = pb.MarketEventType_MARKET_EVENT_TYPE_UPDATED
+		me.market = ev.Market
+	case events.MarketStatusChanged:
+		me.eventType = pb.MarketEventType_MARKET_EVENT_TYPE_STATUS_CHANGED
+		me.market = ev.Market
+		me.prevStatus = ev.PrevStatus
+	default:
+		return nil
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for sub := range b.subs {
+		if !sub.match(me.market) {
+			continue
+		}
+		select {
+		case sub.events <- me:
+		default:
+			// Logs once per buffer size to avoid flooding on slow clients.
+			if n := atomic.AddUint64(&sub.dropped, 1); n%marketStreamBuffer == 1 {
+				b.logger.Errorf("market stream buffer is full, dropped %d events", n)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *marketSubscription) match(m core.Market) bool {
+	if !s.owns(m) && !marketPublicStatuses[m.Status] {
+		return false
+	}
+
+	f := s.filter
+	if f.GetItemId() != "" && f.GetItemId() != m.ItemID {
+		return false
+	}
+	if f.GetUserId() != "" && f.GetUserId() != m.UserID {
+		return false
+	}
+	if f.GetType() != pb.MarketType_MARKET_TYPE_UNSPECIFIED && core.MarketType(f.GetType()) != m.Type {
+		return false
+	}
+
+	return true
+}
+
+// owns returns true when market belongs to authenticated stream client.
+func (s *marketSubscription) owns(m core.Market) bool {
+	return s.userID != "" && s.userID == m.UserID
+}
+
+// message returns stream message of event, private notes are only sent to
+// the market owner.
+func (s *marketSubscription) message(e marketEvent) *pb.MarketEvent {
+	m := toMarket(&e.market, s.userID == "")
+	if !s.owns(e.market) {
+		m.Notes = ""
+	}
+
+	return &pb.MarketEvent{
+		Type:       e.eventType,
+		Market:     m,
+		PrevStatus: pb.MarketStatus(e.prevStatus),
+		SentAt:     toTimestamp(&e.sentAt),
+	}
+}
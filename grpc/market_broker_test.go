@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/events"
+	"github.com/kudarap/dotagiftx/gokit/log"
+	"github.com/kudarap/dotagiftx/grpc/pb"
+)
+
+func TestMarketBroker(t *testing.T) {
+	b := newMarketBroker(log.Default())
+	anon := b.subscribe(&pb.StreamMarketsRequest{}, "")
+	owner := b.subscribe(&pb.StreamMarketsRequest{}, "u1")
+
+	tests := []struct {
+		name      string
+		status    core.MarketStatus
+		wantAnon  bool
+		wantOwner bool
+	}{
+		{"live", core.MarketStatusLive, true, true},
+		{"pending", core.MarketStatusPending, false, true},
+		{"removed", core.MarketStatusRemoved, false, true},
+		{"cancelled", core.MarketStatusCancelled, false, true},
+		{"sold", core.MarketStatusSold, true, true},
+	}
+	for _, tc := range tests {
+		m := core.Market{ID: "m1", UserID: "u1", Status: tc.status, Notes: "private"}
+		if err := b.handle(context.Background(), events.MarketUpdated{Market: m}); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, s := range []struct {
+			sub  *marketSubscription
+			want bool
+		}{{anon, tc.wantAnon}, {owner, tc.wantOwner}} {
+			select {
+			case e := <-s.sub.events:
+				if !s.want {
+					t.Errorf("%s: subscriber %q should not receive event", tc.name, s.sub.userID)
+				}
+				notes := s.sub.message(e).GetMarket().GetNotes()
+				if wantNotes := s.sub.owns(m); (notes != "") != wantNotes {
+					t.Errorf("%s: subscriber %q got notes %q", tc.name, s.sub.userID, notes)
+				}
+			default:
+				if s.want {
+					t.Errorf("%s: subscriber %q should receive event", tc.name, s.sub.userID)
+				}
+			}
+		}
+	}
+}
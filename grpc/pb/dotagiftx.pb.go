@@ -0,0 +1,3616 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: dotagiftx.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MarketType represents market entry type and uses the same values as the
+// REST API.
+type MarketType int32
+
+const (
+	MarketType_MARKET_TYPE_UNSPECIFIED MarketType = 0
+	MarketType_MARKET_TYPE_ASK         MarketType = 10
+	MarketType_MARKET_TYPE_BID         MarketType = 20
+)
+
+// Enum value maps for MarketType.
+var (
+	MarketType_name = map[int32]string{
+		0:  "MARKET_TYPE_UNSPECIFIED",
+		10: "MARKET_TYPE_ASK",
+		20: "MARKET_TYPE_BID",
+	}
+	MarketType_value = map[string]int32{
+		"MARKET_TYPE_UNSPECIFIED": 0,
+		"MARKET_TYPE_ASK":         10,
+		"MARKET_TYPE_BID":         20,
+	}
+)
+
+func (x MarketType) Enum() *MarketType {
+	p := new(MarketType)
+	*p = x
+	return p
+}
+
+func (x MarketType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketType) Descriptor() protoreflect.EnumDescriptor {
+	return file_dotagiftx_proto_enumTypes[0].Descriptor()
+}
+
+func (MarketType) Type() protoreflect.EnumType {
+	return &file_dotagiftx_proto_enumTypes[0]
+}
+
+func (x MarketType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketType.Descriptor instead.
+func (MarketType) EnumDescriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{0}
+}
+
+// MarketStatus represents market entry status and uses the same values as
+// the REST API.
+type MarketStatus int32
+
+const (
+	MarketStatus_MARKET_STATUS_UNSPECIFIED     MarketStatus = 0
+	MarketStatus_MARKET_STATUS_PENDING         MarketStatus = 100
+	MarketStatus_MARKET_STATUS_LIVE            MarketStatus = 200
+	MarketStatus_MARKET_STATUS_RESERVE_PENDING MarketStatus = 250
+	MarketStatus_MARKET_STATUS_RESERVED        MarketStatus = 300
+	MarketStatus_MARKET_STATUS_SOLD            MarketStatus = 400
+	MarketStatus_MARKET_STATUS_BID_COMPLETED   MarketStatus = 410
+	MarketStatus_MARKET_STATUS_REMOVED         MarketStatus = 500
+	MarketStatus_MARKET_STATUS_CANCELLED       MarketStatus = 600
+	MarketStatus_MARKET_STATUS_EXPIRED         MarketStatus = 700
+)
+
+// Enum value maps for MarketStatus.
+var (
+	MarketStatus_name = map[int32]string{
+		0:   "MARKET_STATUS_UNSPECIFIED",
+		100: "MARKET_STATUS_PENDING",
+		200: "MARKET_STATUS_LIVE",
+		250: "MARKET_STATUS_RESERVE_PENDING",
+		300: "MARKET_STATUS_RESERVED",
+		400: "MARKET_STATUS_SOLD",
+		410: "MARKET_STATUS_BID_COMPLETED",
+		500: "MARKET_STATUS_REMOVED",
+		600: "MARKET_STATUS_CANCELLED",
+		700: "MARKET_STATUS_EXPIRED",
+	}
+	MarketStatus_value = map[string]int32{
+		"MARKET_STATUS_UNSPECIFIED":     0,
+		"MARKET_STATUS_PENDING":         100,
+		"MARKET_STATUS_LIVE":            200,
+		"MARKET_STATUS_RESERVE_PENDING": 250,
+		"MARKET_STATUS_RESERVED":        300,
+		"MARKET_STATUS_SOLD":            400,
+		"MARKET_STATUS_BID_COMPLETED":   410,
+		"MARKET_STATUS_REMOVED":         500,
+		"MARKET_STATUS_CANCELLED":       600,
+		"MARKET_STATUS_EXPIRED":         700,
+	}
+)
+
+func (x MarketStatus) Enum() *MarketStatus {
+	p := new(MarketStatus)
+	*p = x
+	return p
+}
+
+func (x MarketStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_dotagiftx_proto_enumTypes[1].Descriptor()
+}
+
+func (MarketStatus) Type() protoreflect.EnumType {
+	return &file_dotagiftx_proto_enumTypes[1]
+}
+
+func (x MarketStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketStatus.Descriptor instead.
+func (MarketStatus) EnumDescriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{1}
+}
+
+// MarketEventType represents kind of market change.
+type MarketEventType int32
+
+const (
+	MarketEventType_MARKET_EVENT_TYPE_UNSPECIFIED    MarketEventType = 0
+	MarketEventType_MARKET_EVENT_TYPE_CREATED        MarketEventType = 1
+	MarketEventType_MARKET_EVENT_TYPE_UPDATED        MarketEventType = 2
+	MarketEventType_MARKET_EVENT_TYPE_STATUS_CHANGED MarketEventType = 3
+)
+
+// Enum value maps for MarketEventType.
+var (
+	MarketEventType_name = map[int32]string{
+		0: "MARKET_EVENT_TYPE_UNSPECIFIED",
+		1: "MARKET_EVENT_TYPE_CREATED",
+		2: "MARKET_EVENT_TYPE_UPDATED",
+		3: "MARKET_EVENT_TYPE_STATUS_CHANGED",
+	}
+	MarketEventType_value = map[string]int32{
+		"MARKET_EVENT_TYPE_UNSPECIFIED":    0,
+		"MARKET_EVENT_TYPE_CREATED":        1,
+		"MARKET_EVENT_TYPE_UPDATED":        2,
+		"MARKET_EVENT_TYPE_STATUS_CHANGED": 3,
+	}
+)
+
+func (x MarketEventType) Enum() *MarketEventType {
+	p := new(MarketEventType)
+	*p = x
+	return p
+}
+
+func (x MarketEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_dotagiftx_proto_enumTypes[2].Descriptor()
+}
+
+func (MarketEventType) Type() protoreflect.EnumType {
+	return &file_dotagiftx_proto_enumTypes[2]
+}
+
+func (x MarketEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketEventType.Descriptor instead.
+func (MarketEventType) EnumDescriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{2}
+}
+
+// ListOptions represents pagination, sorting and filtering of lists.
+type ListOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Keyword full-text search.
+	Keyword string `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	// Sort field with optional ":desc" suffix, e.g. "price:desc".
+	Sort string `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`
+	Page int32  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// Limit defaults to 10.
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Cursor continues from next_cursor of previous page and its sort.
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Where filter expressions keyed by field and operator,
+	// e.g. {"price[gte]": "5"} or {"hero[in]": "axe,lina"}.
+	Where map[string]string `protobuf:"bytes,6,rep,name=where,proto3" json:"where,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ListOptions) Reset() {
+	*x = ListOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOptions) ProtoMessage() {}
+
+func (x *ListOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOptions.ProtoReflect.Descriptor instead.
+func (*ListOptions) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListOptions) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *ListOptions) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListOptions) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListOptions) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListOptions) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListOptions) GetWhere() map[string]string {
+	if x != nil {
+		return x.Where
+	}
+	return nil
+}
+
+// PageInfo represents list result metadata.
+type PageInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResultCount int32  `protobuf:"varint,1,opt,name=result_count,json=resultCount,proto3" json:"result_count,omitempty"`
+	TotalCount  int32  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	NextCursor  string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *PageInfo) Reset() {
+	*x = PageInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageInfo) ProtoMessage() {}
+
+func (x *PageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageInfo.ProtoReflect.Descriptor instead.
+func (*PageInfo) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PageInfo) GetResultCount() int32 {
+	if x != nil {
+		return x.ResultCount
+	}
+	return 0
+}
+
+func (x *PageInfo) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *PageInfo) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SteamId      string                 `protobuf:"bytes,2,opt,name=steam_id,json=steamId,proto3" json:"steam_id,omitempty"`
+	Name         string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Url          string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Avatar       string                 `protobuf:"bytes,5,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Status       uint32                 `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	RankScore    int32                  `protobuf:"varint,7,opt,name=rank_score,json=rankScore,proto3" json:"rank_score,omitempty"`
+	Subscription uint32                 `protobuf:"varint,8,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	Boons        []string               `protobuf:"bytes,9,rep,name=boons,proto3" json:"boons,omitempty"`
+	MarketStats  *MarketStatusCount     `protobuf:"bytes,10,opt,name=market_stats,json=marketStats,proto3" json:"market_stats,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetSteamId() string {
+	if x != nil {
+		return x.SteamId
+	}
+	return ""
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *User) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *User) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *User) GetRankScore() int32 {
+	if x != nil {
+		return x.RankScore
+	}
+	return 0
+}
+
+func (x *User) GetSubscription() uint32 {
+	if x != nil {
+		return x.Subscription
+	}
+	return 0
+}
+
+func (x *User) GetBoons() []string {
+	if x != nil {
+		return x.Boons
+	}
+	return nil
+}
+
+func (x *User) GetMarketStats() *MarketStatusCount {
+	if x != nil {
+		return x.MarketStats
+	}
+	return nil
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug      string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Hero      string                 `protobuf:"bytes,4,opt,name=hero,proto3" json:"hero,omitempty"`
+	Image     string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	Origin    string                 `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"`
+	Rarity    string                 `protobuf:"bytes,7,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	ViewCount int32                  `protobuf:"varint,8,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Item) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Item) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Item) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Item) GetHero() string {
+	if x != nil {
+		return x.Hero
+	}
+	return ""
+}
+
+func (x *Item) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Item) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *Item) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Item) GetViewCount() int32 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *Item) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Item) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Market struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ItemId          string                 `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Type            MarketType             `protobuf:"varint,4,opt,name=type,proto3,enum=dotagiftx.v1.MarketType" json:"type,omitempty"`
+	Status          MarketStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=dotagiftx.v1.MarketStatus" json:"status,omitempty"`
+	Price           float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	Currency        string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	ListPrice       float64                `protobuf:"fixed64,8,opt,name=list_price,json=listPrice,proto3" json:"list_price,omitempty"`
+	ListCurrency    string                 `protobuf:"bytes,9,opt,name=list_currency,json=listCurrency,proto3" json:"list_currency,omitempty"`
+	Notes           string                 `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
+	InventoryStatus uint32                 `protobuf:"varint,11,opt,name=inventory_status,json=inventoryStatus,proto3" json:"inventory_status,omitempty"`
+	DeliveryStatus  uint32                 `protobuf:"varint,12,opt,name=delivery_status,json=deliveryStatus,proto3" json:"delivery_status,omitempty"`
+	UserRankScore   int32                  `protobuf:"varint,13,opt,name=user_rank_score,json=userRankScore,proto3" json:"user_rank_score,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// User is not included on bids of anonymous requests.
+	User *User `protobuf:"bytes,16,opt,name=user,proto3" json:"user,omitempty"`
+	Item *Item `protobuf:"bytes,17,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *Market) Reset() {
+	*x = Market{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Market) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Market) ProtoMessage() {}
+
+func (x *Market) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Market.ProtoReflect.Descriptor instead.
+func (*Market) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Market) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Market) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Market) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *Market) GetType() MarketType {
+	if x != nil {
+		return x.Type
+	}
+	return MarketType_MARKET_TYPE_UNSPECIFIED
+}
+
+func (x *Market) GetStatus() MarketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MarketStatus_MARKET_STATUS_UNSPECIFIED
+}
+
+func (x *Market) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Market) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Market) GetListPrice() float64 {
+	if x != nil {
+		return x.ListPrice
+	}
+	return 0
+}
+
+func (x *Market) GetListCurrency() string {
+	if x != nil {
+		return x.ListCurrency
+	}
+	return ""
+}
+
+func (x *Market) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Market) GetInventoryStatus() uint32 {
+	if x != nil {
+		return x.InventoryStatus
+	}
+	return 0
+}
+
+func (x *Market) GetDeliveryStatus() uint32 {
+	if x != nil {
+		return x.DeliveryStatus
+	}
+	return 0
+}
+
+func (x *Market) GetUserRankScore() int32 {
+	if x != nil {
+		return x.UserRankScore
+	}
+	return 0
+}
+
+func (x *Market) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Market) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Market) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Market) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type Catalog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Hero          string                 `protobuf:"bytes,4,opt,name=hero,proto3" json:"hero,omitempty"`
+	Image         string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	Origin        string                 `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"`
+	Rarity        string                 `protobuf:"bytes,7,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	ViewCount     int32                  `protobuf:"varint,8,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	Quantity      int32                  `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	LowestAsk     float64                `protobuf:"fixed64,10,opt,name=lowest_ask,json=lowestAsk,proto3" json:"lowest_ask,omitempty"`
+	MedianAsk     float64                `protobuf:"fixed64,11,opt,name=median_ask,json=medianAsk,proto3" json:"median_ask,omitempty"`
+	HighestBid    float64                `protobuf:"fixed64,12,opt,name=highest_bid,json=highestBid,proto3" json:"highest_bid,omitempty"`
+	BidCount      int32                  `protobuf:"varint,13,opt,name=bid_count,json=bidCount,proto3" json:"bid_count,omitempty"`
+	ReservedCount int32                  `protobuf:"varint,14,opt,name=reserved_count,json=reservedCount,proto3" json:"reserved_count,omitempty"`
+	SoldCount     int32                  `protobuf:"varint,15,opt,name=sold_count,json=soldCount,proto3" json:"sold_count,omitempty"`
+	SaleCount     int32                  `protobuf:"varint,16,opt,name=sale_count,json=saleCount,proto3" json:"sale_count,omitempty"`
+	AvgSale       float64                `protobuf:"fixed64,17,opt,name=avg_sale,json=avgSale,proto3" json:"avg_sale,omitempty"`
+	RecentAsk     *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=recent_ask,json=recentAsk,proto3" json:"recent_ask,omitempty"`
+	RecentBid     *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=recent_bid,json=recentBid,proto3" json:"recent_bid,omitempty"`
+	RecentSale    *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=recent_sale,json=recentSale,proto3" json:"recent_sale,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Asks          []*Market              `protobuf:"bytes,23,rep,name=asks,proto3" json:"asks,omitempty"`
+	Bids          []*Market              `protobuf:"bytes,24,rep,name=bids,proto3" json:"bids,omitempty"`
+}
+
+func (x *Catalog) Reset() {
+	*x = Catalog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Catalog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Catalog) ProtoMessage() {}
+
+func (x *Catalog) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Catalog.ProtoReflect.Descriptor instead.
+func (*Catalog) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Catalog) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Catalog) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Catalog) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Catalog) GetHero() string {
+	if x != nil {
+		return x.Hero
+	}
+	return ""
+}
+
+func (x *Catalog) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Catalog) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *Catalog) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Catalog) GetViewCount() int32 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *Catalog) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Catalog) GetLowestAsk() float64 {
+	if x != nil {
+		return x.LowestAsk
+	}
+	return 0
+}
+
+func (x *Catalog) GetMedianAsk() float64 {
+	if x != nil {
+		return x.MedianAsk
+	}
+	return 0
+}
+
+func (x *Catalog) GetHighestBid() float64 {
+	if x != nil {
+		return x.HighestBid
+	}
+	return 0
+}
+
+func (x *Catalog) GetBidCount() int32 {
+	if x != nil {
+		return x.BidCount
+	}
+	return 0
+}
+
+func (x *Catalog) GetReservedCount() int32 {
+	if x != nil {
+		return x.ReservedCount
+	}
+	return 0
+}
+
+func (x *Catalog) GetSoldCount() int32 {
+	if x != nil {
+		return x.SoldCount
+	}
+	return 0
+}
+
+func (x *Catalog) GetSaleCount() int32 {
+	if x != nil {
+		return x.SaleCount
+	}
+	return 0
+}
+
+func (x *Catalog) GetAvgSale() float64 {
+	if x != nil {
+		return x.AvgSale
+	}
+	return 0
+}
+
+func (x *Catalog) GetRecentAsk() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecentAsk
+	}
+	return nil
+}
+
+func (x *Catalog) GetRecentBid() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecentBid
+	}
+	return nil
+}
+
+func (x *Catalog) GetRecentSale() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecentSale
+	}
+	return nil
+}
+
+func (x *Catalog) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Catalog) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Catalog) GetAsks() []*Market {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *Catalog) GetBids() []*Market {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+type FacetCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetCount) ProtoMessage() {}
+
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FacetCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PriceRangeCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min float64 `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	// Max is zero on the last open-ended range.
+	Max   float64 `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+	Count int32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *PriceRangeCount) Reset() {
+	*x = PriceRangeCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceRangeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceRangeCount) ProtoMessage() {}
+
+func (x *PriceRangeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceRangeCount.ProtoReflect.Descriptor instead.
+func (*PriceRangeCount) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PriceRangeCount) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *PriceRangeCount) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *PriceRangeCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type CatalogFacets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hero   []*FacetCount      `protobuf:"bytes,1,rep,name=hero,proto3" json:"hero,omitempty"`
+	Rarity []*FacetCount      `protobuf:"bytes,2,rep,name=rarity,proto3" json:"rarity,omitempty"`
+	Origin []*FacetCount      `protobuf:"bytes,3,rep,name=origin,proto3" json:"origin,omitempty"`
+	Price  []*PriceRangeCount `protobuf:"bytes,4,rep,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *CatalogFacets) Reset() {
+	*x = CatalogFacets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatalogFacets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogFacets) ProtoMessage() {}
+
+func (x *CatalogFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogFacets.ProtoReflect.Descriptor instead.
+func (*CatalogFacets) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CatalogFacets) GetHero() []*FacetCount {
+	if x != nil {
+		return x.Hero
+	}
+	return nil
+}
+
+func (x *CatalogFacets) GetRarity() []*FacetCount {
+	if x != nil {
+		return x.Rarity
+	}
+	return nil
+}
+
+func (x *CatalogFacets) GetOrigin() []*FacetCount {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *CatalogFacets) GetPrice() []*PriceRangeCount {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type MarketStatusCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pending                int32 `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	Live                   int32 `protobuf:"varint,2,opt,name=live,proto3" json:"live,omitempty"`
+	Reserved               int32 `protobuf:"varint,3,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Sold                   int32 `protobuf:"varint,4,opt,name=sold,proto3" json:"sold,omitempty"`
+	Removed                int32 `protobuf:"varint,5,opt,name=removed,proto3" json:"removed,omitempty"`
+	Cancelled              int32 `protobuf:"varint,6,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	BidLive                int32 `protobuf:"varint,7,opt,name=bid_live,json=bidLive,proto3" json:"bid_live,omitempty"`
+	BidCompleted           int32 `protobuf:"varint,8,opt,name=bid_completed,json=bidCompleted,proto3" json:"bid_completed,omitempty"`
+	DeliveryNoHit          int32 `protobuf:"varint,9,opt,name=delivery_no_hit,json=deliveryNoHit,proto3" json:"delivery_no_hit,omitempty"`
+	DeliveryNameVerified   int32 `protobuf:"varint,10,opt,name=delivery_name_verified,json=deliveryNameVerified,proto3" json:"delivery_name_verified,omitempty"`
+	DeliverySenderVerified int32 `protobuf:"varint,11,opt,name=delivery_sender_verified,json=deliverySenderVerified,proto3" json:"delivery_sender_verified,omitempty"`
+	DeliveryPrivate        int32 `protobuf:"varint,12,opt,name=delivery_private,json=deliveryPrivate,proto3" json:"delivery_private,omitempty"`
+	DeliveryError          int32 `protobuf:"varint,13,opt,name=delivery_error,json=deliveryError,proto3" json:"delivery_error,omitempty"`
+	InventoryNoHit         int32 `protobuf:"varint,14,opt,name=inventory_no_hit,json=inventoryNoHit,proto3" json:"inventory_no_hit,omitempty"`
+	InventoryVerified      int32 `protobuf:"varint,15,opt,name=inventory_verified,json=inventoryVerified,proto3" json:"inventory_verified,omitempty"`
+	InventoryPrivate       int32 `protobuf:"varint,16,opt,name=inventory_private,json=inventoryPrivate,proto3" json:"inventory_private,omitempty"`
+	InventoryError         int32 `protobuf:"varint,17,opt,name=inventory_error,json=inventoryError,proto3" json:"inventory_error,omitempty"`
+}
+
+func (x *MarketStatusCount) Reset() {
+	*x = MarketStatusCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketStatusCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketStatusCount) ProtoMessage() {}
+
+func (x *MarketStatusCount) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketStatusCount.ProtoReflect.Descriptor instead.
+func (*MarketStatusCount) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MarketStatusCount) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *MarketStatusCount) GetLive() int32 {
+	if x != nil {
+		return x.Live
+	}
+	return 0
+}
+
+func (x *MarketStatusCount) GetReserved() int32 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *MarketStatusCount) GetSold() int32 {
+	if x != nil {
+		return x.Sold
+	}
+	return 0
+}
+
+func (x *MarketStatusCount) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+func (x *MarketStatusCount) GetCancelled() int32 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *MarketStatusCount) GetBidLive() int32 {
+	if x != nil {
+		return x.BidLive
+	}
+	return 0
+}
+
+func (x *MarketStatusCount) GetBidCompleted() int32 {
+	if x != nil {
+		return x.BidCompleted
+	}
+	return 0
+}
+
+func (x *MarketStatusCount) GetDeliveryNoHit() int32 {
+	if x != nil {
+		return x.DeliveryNoHit
+	}
+	return 0
+}
+
+func (x *MarketStatusCount) GetDeliveryNameVerified() int32 {
+	if x != nil {
+		return x.DeliveryNameVerified
+	}
+	return 0
+}
+
+func (x *MarketStatusCount) GetDeliverySenderVerified() int32 {
+	if x != nil {
+		return x.DeliverySenderVerified
+	}
+	return 0
+}
+
+func (x *MarketStatusCount) GetDeliveryPrivate() int32 {
+	if x != nil {
+		return x.DeliveryPrivate
+	}
+	return 0
+}
+
+func (x *MarketStatusCount) GetDeliveryError() int32 {
+	if x != nil {
+		return x.DeliveryError
+	}
+	return 0
+}
+
+func (x *MarketStatusCount) GetInventoryNoHit() int32 {
+	if x != nil {
+		return x.InventoryNoHit
+	}
+	return 0
+}
+
+func (x *MarketStatusCount) GetInventoryVerified() int32 {
+	if x != nil {
+		return x.InventoryVerified
+	}
+	return 0
+}
+
+func (x *MarketStatusCount) GetInventoryPrivate() int32 {
+	if x != nil {
+		return x.InventoryPrivate
+	}
+	return 0
+}
+
+func (x *MarketStatusCount) GetInventoryError() int32 {
+	if x != nil {
+		return x.InventoryError
+	}
+	return 0
+}
+
+type ListItemsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options *ListOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	Hero    string       `protobuf:"bytes,2,opt,name=hero,proto3" json:"hero,omitempty"`
+	Origin  string       `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	Rarity  string       `protobuf:"bytes,4,opt,name=rarity,proto3" json:"rarity,omitempty"`
+}
+
+func (x *ListItemsRequest) Reset() {
+	*x = ListItemsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsRequest) ProtoMessage() {}
+
+func (x *ListItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListItemsRequest) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListItemsRequest) GetOptions() *ListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ListItemsRequest) GetHero() string {
+	if x != nil {
+		return x.Hero
+	}
+	return ""
+}
+
+func (x *ListItemsRequest) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *ListItemsRequest) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+type ListItemsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data     []*Item   `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	PageInfo *PageInfo `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+}
+
+func (x *ListItemsResponse) Reset() {
+	*x = ListItemsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsResponse) ProtoMessage() {}
+
+func (x *ListItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListItemsResponse) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListItemsResponse) GetData() []*Item {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListItemsResponse) GetPageInfo() *PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+type GetItemRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID accepts item id or slug.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetItemRequest) Reset() {
+	*x = GetItemRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemRequest) ProtoMessage() {}
+
+func (x *GetItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemRequest.ProtoReflect.Descriptor instead.
+func (*GetItemRequest) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListCatalogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options *ListOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	Hero    string       `protobuf:"bytes,2,opt,name=hero,proto3" json:"hero,omitempty"`
+	Origin  string       `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	Rarity  string       `protobuf:"bytes,4,opt,name=rarity,proto3" json:"rarity,omitempty"`
+}
+
+func (x *ListCatalogsRequest) Reset() {
+	*x = ListCatalogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCatalogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCatalogsRequest) ProtoMessage() {}
+
+func (x *ListCatalogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCatalogsRequest.ProtoReflect.Descriptor instead.
+func (*ListCatalogsRequest) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListCatalogsRequest) GetOptions() *ListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ListCatalogsRequest) GetHero() string {
+	if x != nil {
+		return x.Hero
+	}
+	return ""
+}
+
+func (x *ListCatalogsRequest) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *ListCatalogsRequest) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+type ListCatalogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data     []*Catalog `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	PageInfo *PageInfo  `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	// Facets are only included on keyword searches.
+	Facets *CatalogFacets `protobuf:"bytes,3,opt,name=facets,proto3" json:"facets,omitempty"`
+}
+
+func (x *ListCatalogsResponse) Reset() {
+	*x = ListCatalogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCatalogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCatalogsResponse) ProtoMessage() {}
+
+func (x *ListCatalogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCatalogsResponse.ProtoReflect.Descriptor instead.
+func (*ListCatalogsResponse) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListCatalogsResponse) GetData() []*Catalog {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListCatalogsResponse) GetPageInfo() *PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+func (x *ListCatalogsResponse) GetFacets() *CatalogFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+type GetCatalogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID accepts item id or slug.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetCatalogRequest) Reset() {
+	*x = GetCatalogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogRequest) ProtoMessage() {}
+
+func (x *GetCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCatalogRequest.ProtoReflect.Descriptor instead.
+func (*GetCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCatalogRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListTrendingCatalogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTrendingCatalogsRequest) Reset() {
+	*x = ListTrendingCatalogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrendingCatalogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingCatalogsRequest) ProtoMessage() {}
+
+func (x *ListTrendingCatalogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingCatalogsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrendingCatalogsRequest) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{16}
+}
+
+type ListMarketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options *ListOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	UserId  string       `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ItemId  string       `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Type    MarketType   `protobuf:"varint,4,opt,name=type,proto3,enum=dotagiftx.v1.MarketType" json:"type,omitempty"`
+	Status  MarketStatus `protobuf:"varint,5,opt,name=status,proto3,enum=dotagiftx.v1.MarketStatus" json:"status,omitempty"`
+}
+
+func (x *ListMarketsRequest) Reset() {
+	*x = ListMarketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMarketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarketsRequest) ProtoMessage() {}
+
+func (x *ListMarketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarketsRequest.ProtoReflect.Descriptor instead.
+func (*ListMarketsRequest) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListMarketsRequest) GetOptions() *ListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ListMarketsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListMarketsRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ListMarketsRequest) GetType() MarketType {
+	if x != nil {
+		return x.Type
+	}
+	return MarketType_MARKET_TYPE_UNSPECIFIED
+}
+
+func (x *ListMarketsRequest) GetStatus() MarketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MarketStatus_MARKET_STATUS_UNSPECIFIED
+}
+
+type ListMarketsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data     []*Market `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	PageInfo *PageInfo `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+}
+
+func (x *ListMarketsResponse) Reset() {
+	*x = ListMarketsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMarketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarketsResponse) ProtoMessage() {}
+
+func (x *ListMarketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarketsResponse.ProtoReflect.Descriptor instead.
+func (*ListMarketsResponse) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListMarketsResponse) GetData() []*Market {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListMarketsResponse) GetPageInfo() *PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+type GetMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetMarketRequest) Reset() {
+	*x = GetMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketRequest) ProtoMessage() {}
+
+func (x *GetMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketRequest) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetMarketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StreamMarketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional filters, empty values matches all.
+	ItemId string     `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	UserId string     `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type   MarketType `protobuf:"varint,3,opt,name=type,proto3,enum=dotagiftx.v1.MarketType" json:"type,omitempty"`
+}
+
+func (x *StreamMarketsRequest) Reset() {
+	*x = StreamMarketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamMarketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMarketsRequest) ProtoMessage() {}
+
+func (x *StreamMarketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMarketsRequest.ProtoReflect.Descriptor instead.
+func (*StreamMarketsRequest) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StreamMarketsRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *StreamMarketsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamMarketsRequest) GetType() MarketType {
+	if x != nil {
+		return x.Type
+	}
+	return MarketType_MARKET_TYPE_UNSPECIFIED
+}
+
+type MarketEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   MarketEventType `protobuf:"varint,1,opt,name=type,proto3,enum=dotagiftx.v1.MarketEventType" json:"type,omitempty"`
+	Market *Market         `protobuf:"bytes,2,opt,name=market,proto3" json:"market,omitempty"`
+	// Previous status is only set on status changes.
+	PrevStatus MarketStatus           `protobuf:"varint,3,opt,name=prev_status,json=prevStatus,proto3,enum=dotagiftx.v1.MarketStatus" json:"prev_status,omitempty"`
+	SentAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+}
+
+func (x *MarketEvent) Reset() {
+	*x = MarketEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketEvent) ProtoMessage() {}
+
+func (x *MarketEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketEvent.ProtoReflect.Descriptor instead.
+func (*MarketEvent) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MarketEvent) GetType() MarketEventType {
+	if x != nil {
+		return x.Type
+	}
+	return MarketEventType_MARKET_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *MarketEvent) GetMarket() *Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *MarketEvent) GetPrevStatus() MarketStatus {
+	if x != nil {
+		return x.PrevStatus
+	}
+	return MarketStatus_MARKET_STATUS_UNSPECIFIED
+}
+
+func (x *MarketEvent) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetMeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMeRequest) Reset() {
+	*x = GetMeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeRequest) ProtoMessage() {}
+
+func (x *GetMeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeRequest.ProtoReflect.Descriptor instead.
+func (*GetMeRequest) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{23}
+}
+
+type GetMarketSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// User summary includes bid counts on asks summary.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ItemId string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+}
+
+func (x *GetMarketSummaryRequest) Reset() {
+	*x = GetMarketSummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMarketSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketSummaryRequest) ProtoMessage() {}
+
+func (x *GetMarketSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetMarketSummaryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetMarketSummaryRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type MarketSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asks *MarketStatusCount `protobuf:"bytes,1,opt,name=asks,proto3" json:"asks,omitempty"`
+	Bids *MarketStatusCount `protobuf:"bytes,2,opt,name=bids,proto3" json:"bids,omitempty"`
+}
+
+func (x *MarketSummary) Reset() {
+	*x = MarketSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketSummary) ProtoMessage() {}
+
+func (x *MarketSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketSummary.ProtoReflect.Descriptor instead.
+func (*MarketSummary) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MarketSummary) GetAsks() *MarketStatusCount {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *MarketSummary) GetBids() *MarketStatusCount {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+type GraphMarketSalesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GraphMarketSalesRequest) Reset() {
+	*x = GraphMarketSalesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraphMarketSalesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphMarketSalesRequest) ProtoMessage() {}
+
+func (x *GraphMarketSalesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphMarketSalesRequest.ProtoReflect.Descriptor instead.
+func (*GraphMarketSalesRequest) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GraphMarketSalesRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *GraphMarketSalesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type MarketSalesPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Avg   float64                `protobuf:"fixed64,2,opt,name=avg,proto3" json:"avg,omitempty"`
+	Count int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *MarketSalesPoint) Reset() {
+	*x = MarketSalesPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketSalesPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketSalesPoint) ProtoMessage() {}
+
+func (x *MarketSalesPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketSalesPoint.ProtoReflect.Descriptor instead.
+func (*MarketSalesPoint) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MarketSalesPoint) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *MarketSalesPoint) GetAvg() float64 {
+	if x != nil {
+		return x.Avg
+	}
+	return 0
+}
+
+func (x *MarketSalesPoint) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GraphMarketSalesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*MarketSalesPoint `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GraphMarketSalesResponse) Reset() {
+	*x = GraphMarketSalesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraphMarketSalesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphMarketSalesResponse) ProtoMessage() {}
+
+func (x *GraphMarketSalesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphMarketSalesResponse.ProtoReflect.Descriptor instead.
+func (*GraphMarketSalesResponse) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GraphMarketSalesResponse) GetData() []*MarketSalesPoint {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListTopKeywordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTopKeywordsRequest) Reset() {
+	*x = ListTopKeywordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTopKeywordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopKeywordsRequest) ProtoMessage() {}
+
+func (x *ListTopKeywordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopKeywordsRequest.ProtoReflect.Descriptor instead.
+func (*ListTopKeywordsRequest) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{29}
+}
+
+type KeywordScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyword string `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Score   int32  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *KeywordScore) Reset() {
+	*x = KeywordScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeywordScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeywordScore) ProtoMessage() {}
+
+func (x *KeywordScore) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeywordScore.ProtoReflect.Descriptor instead.
+func (*KeywordScore) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *KeywordScore) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *KeywordScore) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type ListTopKeywordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*KeywordScore `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListTopKeywordsResponse) Reset() {
+	*x = ListTopKeywordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dotagiftx_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTopKeywordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopKeywordsResponse) ProtoMessage() {}
+
+func (x *ListTopKeywordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dotagiftx_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopKeywordsResponse.ProtoReflect.Descriptor instead.
+func (*ListTopKeywordsResponse) Descriptor() ([]byte, []int) {
+	return file_dotagiftx_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListTopKeywordsResponse) GetData() []*KeywordScore {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_dotagiftx_proto protoreflect.FileDescriptor
+
+var file_dotagiftx_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0c, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xf3, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x3a, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x1a, 0x38, 0x0a, 0x0a,
+	0x57, 0x68, 0x65, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6f, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x9a, 0x03, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xad, 0x02, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x72, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x65, 0x72, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xfa, 0x04, 0x0a, 0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x69, 0x73,
+	0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74,
+	0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12,
+	0x29, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x65, 0x6e,
+	0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x6e, 0x6b,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x75, 0x73,
+	0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69,
+	0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x22, 0xcf, 0x06, 0x0a, 0x07, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x72, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x65, 0x72, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f,
+	0x77, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x73, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x6c, 0x6f, 0x77, 0x65, 0x73, 0x74, 0x41, 0x73, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x6e, 0x5f, 0x61, 0x73, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x6e, 0x41, 0x73, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x69, 0x67, 0x68,
+	0x65, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x68,
+	0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x69,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x6f, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x73, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x73, 0x61, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x76, 0x67, 0x5f, 0x73, 0x61, 0x6c, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x61,
+	0x76, 0x67, 0x53, 0x61, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x73, 0x6b, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x41, 0x73,
+	0x6b, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x69, 0x64, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x0b,
+	0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x6c, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72,
+	0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x61, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x28, 0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x62, 0x69, 0x64,
+	0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69,
+	0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x04, 0x62,
+	0x69, 0x64, 0x73, 0x22, 0x38, 0x0a, 0x0a, 0x46, 0x61, 0x63, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4b, 0x0a,
+	0x0f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d,
+	0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x6d, 0x61, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd6, 0x01, 0x0a, 0x0d, 0x43,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x04,
+	0x68, 0x65, 0x72, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x6f, 0x74,
+	0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04, 0x68, 0x65, 0x72, 0x6f, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x6f, 0x74,
+	0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64,
+	0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x65,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x33,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x22, 0x82, 0x05, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x73, 0x6f, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x62, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x62, 0x69, 0x64, 0x4c, 0x69, 0x76, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x69,
+	0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x62, 0x69, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
+	0x26, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x6e, 0x6f, 0x5f, 0x68,
+	0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x4e, 0x6f, 0x48, 0x69, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x38, 0x0a,
+	0x18, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x16, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6e, 0x6f, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x4e, 0x6f,
+	0x48, 0x69, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x11, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x69,
+	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x6f, 0x72, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x8b, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x72, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x65, 0x72, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x22, 0x70, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x6f, 0x74, 0x61,
+	0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x33, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66,
+	0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x72, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x65, 0x72, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x22, 0xab, 0x01, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x33, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x33, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x46, 0x61, 0x63, 0x65, 0x74,
+	0x73, 0x52, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1d,
+	0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x61,
+	0x74, 0x61, 0x6c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdd, 0x01,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74,
+	0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x64, 0x6f, 0x74, 0x61,
+	0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x6f, 0x74, 0x61,
+	0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x74, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x33,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x76, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0xe0, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x12, 0x3b, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74,
+	0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a,
+	0x07, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74,
+	0x41, 0x74, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x22, 0x79, 0x0a, 0x0d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x33, 0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x33, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74,
+	0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x22, 0x4b, 0x0a, 0x17,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x61, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6a, 0x0a, 0x10, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2e, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x76, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x61, 0x76, 0x67, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4e, 0x0a, 0x18, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x18, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70,
+	0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x3e, 0x0a, 0x0c, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22,
+	0x49, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67,
+	0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x53, 0x0a, 0x0a, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x52, 0x4b,
+	0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x4b, 0x10, 0x0a, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x41,
+	0x52, 0x4b, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x49, 0x44, 0x10, 0x14, 0x2a,
+	0xb3, 0x02, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x64, 0x12, 0x17, 0x0a, 0x12, 0x4d, 0x41,
+	0x52, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4c, 0x49, 0x56, 0x45,
+	0x10, 0xc8, 0x01, 0x12, 0x22, 0x0a, 0x1d, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x5f, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0xfa, 0x01, 0x12, 0x1b, 0x0a, 0x16, 0x4d, 0x41, 0x52, 0x4b, 0x45,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45,
+	0x44, 0x10, 0xac, 0x02, 0x12, 0x17, 0x0a, 0x12, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x4f, 0x4c, 0x44, 0x10, 0x90, 0x03, 0x12, 0x20, 0x0a,
+	0x1b, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x42,
+	0x49, 0x44, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x9a, 0x03, 0x12,
+	0x1a, 0x0a, 0x15, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0xf4, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x4d,
+	0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0xd8, 0x04, 0x12, 0x1a, 0x0a, 0x15, 0x4d, 0x41, 0x52,
+	0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0xbc, 0x05, 0x2a, 0x98, 0x01, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x41, 0x52,
+	0x4b, 0x45, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19,
+	0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4d,
+	0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x4d, 0x41,
+	0x52, 0x4b, 0x45, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x03,
+	0x32, 0x98, 0x01, 0x0a, 0x0b, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x4c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1e, 0x2e,
+	0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x07, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1c, 0x2e, 0x64, 0x6f, 0x74, 0x61,
+	0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69,
+	0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x32, 0x94, 0x02, 0x0a, 0x0e,
+	0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55,
+	0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x21,
+	0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61,
+	0x6c, 0x6f, 0x67, 0x12, 0x1f, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x12, 0x65, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x73, 0x12, 0x29, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0xce, 0x02, 0x0a, 0x0d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74,
+	0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x64, 0x6f, 0x74, 0x61,
+	0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x6f,
+	0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x64, 0x6f,
+	0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x6f,
+	0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x12, 0x50, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x73, 0x12, 0x22, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66,
+	0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x30, 0x01, 0x32, 0x83, 0x01, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c,
+	0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x64,
+	0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x37, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x12, 0x1a, 0x2e, 0x64, 0x6f, 0x74, 0x61,
+	0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74,
+	0x78, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x32, 0xa9, 0x02, 0x0a, 0x0c, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x25,
+	0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74,
+	0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x61, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66,
+	0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70,
+	0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x24, 0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67,
+	0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x4b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x64, 0x6f, 0x74, 0x61, 0x67, 0x69, 0x66, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x6f, 0x70, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x64, 0x61, 0x72, 0x61, 0x70, 0x2f, 0x64, 0x6f, 0x74, 0x61,
+	0x67, 0x69, 0x66, 0x74, 0x78, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dotagiftx_proto_rawDescOnce sync.Once
+	file_dotagiftx_proto_rawDescData = file_dotagiftx_proto_rawDesc
+)
+
+func file_dotagiftx_proto_rawDescGZIP() []byte {
+	file_dotagiftx_proto_rawDescOnce.Do(func() {
+		file_dotagiftx_proto_rawDescData = protoimpl.X.CompressGZIP(file_dotagiftx_proto_rawDescData)
+	})
+	return file_dotagiftx_proto_rawDescData
+}
+
+var file_dotagiftx_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_dotagiftx_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_dotagiftx_proto_goTypes = []interface{}{
+	(MarketType)(0),                     // 0: dotagiftx.v1.MarketType
+	(MarketStatus)(0),                   // 1: dotagiftx.v1.MarketStatus
+	(MarketEventType)(0),                // 2: dotagiftx.v1.MarketEventType
+	(*ListOptions)(nil),                 // 3: dotagiftx.v1.ListOptions
+	(*PageInfo)(nil),                    // 4: dotagiftx.v1.PageInfo
+	(*User)(nil),                        // 5: dotagiftx.v1.User
+	(*Item)(nil),                        // 6: dotagiftx.v1.Item
+	(*Market)(nil),                      // 7: dotagiftx.v1.Market
+	(*Catalog)(nil),                     // 8: dotagiftx.v1.Catalog
+	(*FacetCount)(nil),                  // 9: dotagiftx.v1.FacetCount
+	(*PriceRangeCount)(nil),             // 10: dotagiftx.v1.PriceRangeCount
+	(*CatalogFacets)(nil),               // 11: dotagiftx.v1.CatalogFacets
+	(*MarketStatusCount)(nil),           // 12: dotagiftx.v1.MarketStatusCount
+	(*ListItemsRequest)(nil),            // 13: dotagiftx.v1.ListItemsRequest
+	(*ListItemsResponse)(nil),           // 14: dotagiftx.v1.ListItemsResponse
+	(*GetItemRequest)(nil),              // 15: dotagiftx.v1.GetItemRequest
+	(*ListCatalogsRequest)(nil),         // 16: dotagiftx.v1.ListCatalogsRequest
+	(*ListCatalogsResponse)(nil),        // 17: dotagiftx.v1.ListCatalogsResponse
+	(*GetCatalogRequest)(nil),           // 18: dotagiftx.v1.GetCatalogRequest
+	(*ListTrendingCatalogsRequest)(nil), // 19: dotagiftx.v1.ListTrendingCatalogsRequest
+	(*ListMarketsRequest)(nil),          // 20: dotagiftx.v1.ListMarketsRequest
+	(*ListMarketsResponse)(nil),         // 21: dotagiftx.v1.ListMarketsResponse
+	(*GetMarketRequest)(nil),            // 22: dotagiftx.v1.GetMarketRequest
+	(*StreamMarketsRequest)(nil),        // 23: dotagiftx.v1.StreamMarketsRequest
+	(*MarketEvent)(nil),                 // 24: dotagiftx.v1.MarketEvent
+	(*GetUserRequest)(nil),              // 25: dotagiftx.v1.GetUserRequest
+	(*GetMeRequest)(nil),                // 26: dotagiftx.v1.GetMeRequest
+	(*GetMarketSummaryRequest)(nil),     // 27: dotagiftx.v1.GetMarketSummaryRequest
+	(*MarketSummary)(nil),               // 28: dotagiftx.v1.MarketSummary
+	(*GraphMarketSalesRequest)(nil),     // 29: dotagiftx.v1.GraphMarketSalesRequest
+	(*MarketSalesPoint)(nil),            // 30: dotagiftx.v1.MarketSalesPoint
+	(*GraphMarketSalesResponse)(nil),    // 31: dotagiftx.v1.GraphMarketSalesResponse
+	(*ListTopKeywordsRequest)(nil),      // 32: dotagiftx.v1.ListTopKeywordsRequest
+	(*KeywordScore)(nil),                // 33: dotagiftx.v1.KeywordScore
+	(*ListTopKeywordsResponse)(nil),     // 34: dotagiftx.v1.ListTopKeywordsResponse
+	nil,                                 // 35: dotagiftx.v1.ListOptions.WhereEntry
+	(*timestamppb.Timestamp)(nil),       // 36: google.protobuf.Timestamp
+}
+var file_dotagiftx_proto_depIdxs = []int32{
+	35, // 0: dotagiftx.v1.ListOptions.where:type_name -> dotagiftx.v1.ListOptions.WhereEntry
+	12, // 1: dotagiftx.v1.User.market_stats:type_name -> dotagiftx.v1.MarketStatusCount
+	36, // 2: dotagiftx.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	36, // 3: dotagiftx.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	36, // 4: dotagiftx.v1.Item.created_at:type_name -> google.protobuf.Timestamp
+	36, // 5: dotagiftx.v1.Item.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: dotagiftx.v1.Market.type:type_name -> dotagiftx.v1.MarketType
+	1,  // 7: dotagiftx.v1.Market.status:type_name -> dotagiftx.v1.MarketStatus
+	36, // 8: dotagiftx.v1.Market.created_at:type_name -> google.protobuf.Timestamp
+	36, // 9: dotagiftx.v1.Market.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 10: dotagiftx.v1.Market.user:type_name -> dotagiftx.v1.User
+	6,  // 11: dotagiftx.v1.Market.item:type_name -> dotagiftx.v1.Item
+	36, // 12: dotagiftx.v1.Catalog.recent_ask:type_name -> google.protobuf.Timestamp
+	36, // 13: dotagiftx.v1.Catalog.recent_bid:type_name -> google.protobuf.Timestamp
+	36, // 14: dotagiftx.v1.Catalog.recent_sale:type_name -> google.protobuf.Timestamp
+	36, // 15: dotagiftx.v1.Catalog.created_at:type_name -> google.protobuf.Timestamp
+	36, // 16: dotagiftx.v1.Catalog.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 17: dotagiftx.v1.Catalog.asks:type_name -> dotagiftx.v1.Market
+	7,  // 18: dotagiftx.v1.Catalog.bids:type_name -> dotagiftx.v1.Market
+	9,  // 19: dotagiftx.v1.CatalogFacets.hero:type_name -> dotagiftx.v1.FacetCount
+	9,  // 20: dotagiftx.v1.CatalogFacets.rarity:type_name -> dotagiftx.v1.FacetCount
+	9,  // 21: dotagiftx.v1.CatalogFacets.origin:type_name -> dotagiftx.v1.FacetCount
+	10, // 22: dotagiftx.v1.CatalogFacets.price:type_name -> dotagiftx.v1.PriceRangeCount
+	3,  // 23: dotagiftx.v1.ListItemsRequest.options:type_name -> dotagiftx.v1.ListOptions
+	6,  // 24: dotagiftx.v1.ListItemsResponse.data:type_name -> dotagiftx.v1.Item
+	4,  // 25: dotagiftx.v1.ListItemsResponse.page_info:type_name -> dotagiftx.v1.PageInfo
+	3,  // 26: dotagiftx.v1.ListCatalogsRequest.options:type_name -> dotagiftx.v1.ListOptions
+	8,  // 27: dotagiftx.v1.ListCatalogsResponse.data:type_name -> dotagiftx.v1.Catalog
+	4,  // 28: dotagiftx.v1.ListCatalogsResponse.page_info:type_name -> dotagiftx.v1.PageInfo
+	11, // 29: dotagiftx.v1.ListCatalogsResponse.facets:type_name -> dotagiftx.v1.CatalogFacets
+	3,  // 30: dotagiftx.v1.ListMarketsRequest.options:type_name -> dotagiftx.v1.ListOptions
+	0,  // 31: dotagiftx.v1.ListMarketsRequest.type:type_name -> dotagiftx.v1.MarketType
+	1,  // 32: dotagiftx.v1.ListMarketsRequest.status:type_name -> dotagiftx.v1.MarketStatus
+	7,  // 33: dotagiftx.v1.ListMarketsResponse.data:type_name -> dotagiftx.v1.Market
+	4,  // 34: dotagiftx.v1.ListMarketsResponse.page_info:type_name -> dotagiftx.v1.PageInfo
+	0,  // 35: dotagiftx.v1.StreamMarketsRequest.type:type_name -> dotagiftx.v1.MarketType
+	2,  // 36: dotagiftx.v1.MarketEvent.type:type_name -> dotagiftx.v1.MarketEventType
+	7,  // 37: dotagiftx.v1.MarketEvent.market:type_name -> dotagiftx.v1.Market
+	1,  // 38: dotagiftx.v1.MarketEvent.prev_status:type_name -> dotagiftx.v1.MarketStatus
+	36, // 39: dotagiftx.v1.MarketEvent.sent_at:type_name -> google.protobuf.Timestamp
+	12, // 40: dotagiftx.v1.MarketSummary.asks:type_name -> dotagiftx.v1.MarketStatusCount
+	12, // 41: dotagiftx.v1.MarketSummary.bids:type_name -> dotagiftx.v1.MarketStatusCount
+	36, // 42: dotagiftx.v1.MarketSalesPoint.date:type_name -> google.protobuf.Timestamp
+	30, // 43: dotagiftx.v1.GraphMarketSalesResponse.data:type_name -> dotagiftx.v1.MarketSalesPoint
+	33, // 44: dotagiftx.v1.ListTopKeywordsResponse.data:type_name -> dotagiftx.v1.KeywordScore
+	13, // 45: dotagiftx.v1.ItemService.ListItems:input_type -> dotagiftx.v1.ListItemsRequest
+	15, // 46: dotagiftx.v1.ItemService.GetItem:input_type -> dotagiftx.v1.GetItemRequest
+	16, // 47: dotagiftx.v1.CatalogService.ListCatalogs:input_type -> dotagiftx.v1.ListCatalogsRequest
+	18, // 48: dotagiftx.v1.CatalogService.GetCatalog:input_type -> dotagiftx.v1.GetCatalogRequest
+	19, // 49: dotagiftx.v1.CatalogService.ListTrendingCatalogs:input_type -> dotagiftx.v1.ListTrendingCatalogsRequest
+	20, // 50: dotagiftx.v1.MarketService.ListMarkets:input_type -> dotagiftx.v1.ListMarketsRequest
+	20, // 51: dotagiftx.v1.MarketService.ListMyMarkets:input_type -> dotagiftx.v1.ListMarketsRequest
+	22, // 52: dotagiftx.v1.MarketService.GetMarket:input_type -> dotagiftx.v1.GetMarketRequest
+	23, // 53: dotagiftx.v1.MarketService.StreamMarkets:input_type -> dotagiftx.v1.StreamMarketsRequest
+	25, // 54: dotagiftx.v1.UserService.GetUser:input_type -> dotagiftx.v1.GetUserRequest
+	26, // 55: dotagiftx.v1.UserService.GetMe:input_type -> dotagiftx.v1.GetMeRequest
+	27, // 56: dotagiftx.v1.StatsService.GetMarketSummary:input_type -> dotagiftx.v1.GetMarketSummaryRequest
+	29, // 57: dotagiftx.v1.StatsService.GraphMarketSales:input_type -> dotagiftx.v1.GraphMarketSalesRequest
+	32, // 58: dotagiftx.v1.StatsService.ListTopKeywords:input_type -> dotagiftx.v1.ListTopKeywordsRequest
+	14, // 59: dotagiftx.v1.ItemService.ListItems:output_type -> dotagiftx.v1.ListItemsResponse
+	6,  // 60: dotagiftx.v1.ItemService.GetItem:output_type -> dotagiftx.v1.Item
+	17, // 61: dotagiftx.v1.CatalogService.ListCatalogs:output_type -> dotagiftx.v1.ListCatalogsResponse
+	8,  // 62: dotagiftx.v1.CatalogService.GetCatalog:output_type -> dotagiftx.v1.Catalog
+	17, // 63: dotagiftx.v1.CatalogService.ListTrendingCatalogs:output_type -> dotagiftx.v1.ListCatalogsResponse
+	21, // 64: dotagiftx.v1.MarketService.ListMarkets:output_type -> dotagiftx.v1.ListMarketsResponse
+	21, // 65: dotagiftx.v1.MarketService.ListMyMarkets:output_type -> dotagiftx.v1.ListMarketsResponse
+	7,  // 66: dotagiftx.v1.MarketService.GetMarket:output_type -> dotagiftx.v1.Market
+	24, // 67: dotagiftx.v1.MarketService.StreamMarkets:output_type -> dotagiftx.v1.MarketEvent
+	5,  // 68: dotagiftx.v1.UserService.GetUser:output_type -> dotagiftx.v1.User
+	5,  // 69: dotagiftx.v1.UserService.GetMe:output_type -> dotagiftx.v1.User
+	28, // 70: dotagiftx.v1.StatsService.GetMarketSummary:output_type -> dotagiftx.v1.MarketSummary
+	31, // 71: dotagiftx.v1.StatsService.GraphMarketSales:output_type -> dotagiftx.v1.GraphMarketSalesResponse
+	34, // 72: dotagiftx.v1.StatsService.ListTopKeywords:output_type -> dotagiftx.v1.ListTopKeywordsResponse
+	59, // [59:73] is the sub-list for method output_type
+	45, // [45:59] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
+}
+
+func init() { file_dotagiftx_proto_init() }
+func file_dotagiftx_proto_init() {
+	if File_dotagiftx_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_dotagiftx_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PageInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Market); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Catalog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FacetCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceRangeCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatalogFacets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketStatusCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListItemsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListItemsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCatalogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCatalogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCatalogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTrendingCatalogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMarketsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMarketsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamMarketsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketSummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraphMarketSalesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketSalesPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraphMarketSalesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTopKeywordsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeywordScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dotagiftx_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTopKeywordsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dotagiftx_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   33,
+			NumExtensions: 0,
+			NumServices:   5,
+		},
+		GoTypes:           file_dotagiftx_proto_goTypes,
+		DependencyIndexes: file_dotagiftx_proto_depIdxs,
+		EnumInfos:         file_dotagiftx_proto_enumTypes,
+		MessageInfos:      file_dotagiftx_proto_msgTypes,
+	}.Build()
+	File_dotagiftx_proto = out.File
+	file_dotagiftx_proto_rawDesc = nil
+	file_dotagiftx_proto_goTypes = nil
+	file_dotagiftx_proto_depIdxs = nil
+}
@@ -0,0 +1,99 @@
+// Package grpc provides gRPC server of item, catalog, market, user and stats
+// services for bots and internal services. Protobuf definitions and its
+// generated clients are on pb package.
+package grpc
+
+import (
+	"net"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/events"
+	"github.com/kudarap/dotagiftx/gokit/http/jwt"
+	"github.com/kudarap/dotagiftx/gokit/log"
+	"github.com/kudarap/dotagiftx/grpc/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+const shutdownTimeout = 10 * time.Second
+
+// Config represents gRPC server config.
+type Config struct {
+	// Addr listen address and leave it empty to disable gRPC server.
+	Addr string
+}
+
+// NewServer returns new gRPC server that authenticates requests with the
+// same access tokens as http server.
+func NewServer(
+	sigKey string,
+	us core.UserService,
+	its core.ItemService,
+	ms core.MarketService,
+	ss core.StatsService,
+	l log.Logger,
+) *Server {
+	jwt.SigKey = sigKey
+	s := &Server{
+		broker: newMarketBroker(l),
+		logger: l,
+	}
+
+	s.srv = grpc.NewServer(
+		grpc.UnaryInterceptor(unaryInterceptor),
+		grpc.StreamInterceptor(streamInterceptor),
+	)
+	pb.RegisterItemServiceServer(s.srv, &itemServer{svc: its})
+	pb.RegisterCatalogServiceServer(s.srv, &catalogServer{marketSvc: ms})
+	pb.RegisterMarketServiceServer(s.srv, &marketServer{svc: ms, broker: s.broker})
+	pb.RegisterUserServiceServer(s.srv, &userServer{svc: us})
+	pb.RegisterStatsServiceServer(s.srv, &statsServer{svc: ss})
+	reflection.Register(s.srv)
+	return s
+}
+
+// Server represents gRPC server.
+type Server struct {
+	Addr string
+
+	srv    *grpc.Server
+	broker *marketBroker
+	logger log.Logger
+}
+
+// SubscribeMarket streams market events to StreamMarkets clients, subscriber
+// should deliver every event to each running server since clients are only
+// connected to one of them.
+func (s *Server) SubscribeMarket(sub events.Subscriber) {
+	sub.Subscribe(events.TypeMarketCreated, s.broker.handle)
+	sub.Subscribe(events.TypeMarketUpdated, s.broker.handle)
+	sub.Subscribe(events.TypeMarketStatusChanged, s.broker.handle)
+}
+
+// Run listens and serves gRPC requests until server stops.
+func (s *Server) Run() error {
+	lis, err := net.Listen("tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Println("grpc server running on", s.Addr)
+	return s.srv.Serve(lis)
+}
+
+// Stop waits for pending requests to finish and forcibly closes open
+// streams after shutdownTimeout.
+func (s *Server) Stop() {
+	done := make(chan struct{})
+	go func() {
+		s.srv.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(shutdownTimeout):
+		s.srv.Stop()
+	}
+}
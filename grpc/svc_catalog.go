@@ -0,0 +1,84 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/grpc/pb"
+)
+
+// catalogMarketLimit number of live asks and bids included on catalog details.
+const catalogMarketLimit = 10
+
+type catalogServer struct {
+	pb.UnimplementedCatalogServiceServer
+	marketSvc core.MarketService
+}
+
+func (s *catalogServer) ListCatalogs(ctx context.Context, req *pb.ListCatalogsRequest) (*pb.ListCatalogsResponse, error) {
+	opts, err := findOpts(req.GetOptions(), &core.Catalog{
+		Hero:   req.GetHero(),
+		Origin: req.GetOrigin(),
+		Rarity: req.GetRarity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list, md, err := s.marketSvc.Catalog(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.ListCatalogsResponse{
+		Data:     toCatalogs(list, isAnonymous(ctx)),
+		PageInfo: pageInfo(md),
+	}
+	if md != nil {
+		res.Facets = toCatalogFacets(md.Facets)
+	}
+	return res, nil
+}
+
+func (s *catalogServer) GetCatalog(ctx context.Context, req *pb.GetCatalogRequest) (*pb.Catalog, error) {
+	if req.GetId() == "" {
+		return nil, core.CatalogErrRequiredID
+	}
+
+	c, err := s.marketSvc.CatalogDetails(req.GetId(), core.FindOpts{
+		Filter:   &core.Market{Type: core.MarketTypeAsk, Status: core.MarketStatusLive},
+		Sort:     "price",
+		Limit:    catalogMarketLimit,
+		WithMeta: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Catalog details only includes asks and bids are listed separately.
+	if c.ID != "" {
+		c.Bids, _, err = s.marketSvc.Markets(publicContext(ctx), core.FindOpts{
+			Filter: &core.Market{ItemID: c.ID, Type: core.MarketTypeBid, Status: core.MarketStatusLive},
+			Sort:   "price",
+			Desc:   true,
+			Limit:  catalogMarketLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return toCatalog(c, isAnonymous(ctx)), nil
+}
+
+func (s *catalogServer) ListTrendingCatalogs(ctx context.Context, _ *pb.ListTrendingCatalogsRequest) (*pb.ListCatalogsResponse, error) {
+	list, md, err := s.marketSvc.TrendingCatalog(core.FindOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListCatalogsResponse{
+		Data:     toCatalogs(list, isAnonymous(ctx)),
+		PageInfo: pageInfo(md),
+	}, nil
+}
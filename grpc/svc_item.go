@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/grpc/pb"
+)
+
+type itemServer struct {
+	pb.UnimplementedItemServiceServer
+	svc core.ItemService
+}
+
+func (s *itemServer) ListItems(ctx context.Context, req *pb.ListItemsRequest) (*pb.ListItemsResponse, error) {
+	opts, err := findOpts(req.GetOptions(), &core.Item{
+		Hero:   req.GetHero(),
+		Origin: req.GetOrigin(),
+		Rarity: req.GetRarity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list, md, err := s.svc.Items(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListItemsResponse{
+		Data:     toItems(list),
+		PageInfo: pageInfo(md),
+	}, nil
+}
+
+func (s *itemServer) GetItem(ctx context.Context, req *pb.GetItemRequest) (*pb.Item, error) {
+	if req.GetId() == "" {
+		return nil, core.ItemErrRequiredID
+	}
+
+	i, err := s.svc.Item(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return toItem(i), nil
+}
@@ -0,0 +1,99 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/grpc/pb"
+)
+
+type marketServer struct {
+	pb.UnimplementedMarketServiceServer
+	svc    core.MarketService
+	broker *marketBroker
+}
+
+func (s *marketServer) ListMarkets(ctx context.Context, req *pb.ListMarketsRequest) (*pb.ListMarketsResponse, error) {
+	opts, err := marketFindOpts(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Public listing should not be scoped to authorized user.
+	list, md, err := s.svc.Markets(publicContext(ctx), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListMarketsResponse{
+		Data:     toMarkets(list, isAnonymous(ctx)),
+		PageInfo: pageInfo(md),
+	}, nil
+}
+
+func (s *marketServer) ListMyMarkets(ctx context.Context, req *pb.ListMarketsRequest) (*pb.ListMarketsResponse, error) {
+	if err := requireAuth(ctx); err != nil {
+		return nil, err
+	}
+	opts, err := marketFindOpts(req)
+	if err != nil {
+		return nil, err
+	}
+
+	list, md, err := s.svc.Markets(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListMarketsResponse{
+		Data:     toMarkets(list, false),
+		PageInfo: pageInfo(md),
+	}, nil
+}
+
+func (s *marketServer) GetMarket(ctx context.Context, req *pb.GetMarketRequest) (*pb.Market, error) {
+	if req.GetId() == "" {
+		return nil, core.MarketErrRequiredID
+	}
+
+	m, err := s.svc.Market(publicContext(ctx), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return toMarket(m, isAnonymous(ctx)), nil
+}
+
+func (s *marketServer) StreamMarkets(req *pb.StreamMarketsRequest, stream pb.MarketService_StreamMarketsServer) error {
+	ctx := stream.Context()
+	var userID string
+	if au := core.AuthFromContext(ctx); au != nil {
+		userID = au.UserID
+	}
+
+	sub := s.broker.subscribe(req, userID)
+	defer s.broker.unsubscribe(sub)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-sub.events:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(sub.message(e)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func marketFindOpts(req *pb.ListMarketsRequest) (core.FindOpts, error) {
+	return findOpts(req.GetOptions(), &core.Market{
+		UserID: req.GetUserId(),
+		ItemID: req.GetItemId(),
+		Type:   core.MarketType(req.GetType()),
+		Status: core.MarketStatus(req.GetStatus()),
+	})
+}
@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/grpc/pb"
+)
+
+type statsServer struct {
+	pb.UnimplementedStatsServiceServer
+	svc core.StatsService
+}
+
+func (s *statsServer) GetMarketSummary(ctx context.Context, req *pb.GetMarketSummaryRequest) (*pb.MarketSummary, error) {
+	// User summary counts bids along with its asks.
+	if req.GetUserId() != "" {
+		stats, err := s.svc.CountUserMarketStatus(req.GetUserId())
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.MarketSummary{
+			Asks: toMarketStatusCount(stats),
+			Bids: toMarketStatusCount(&core.MarketStatusCount{
+				BidLive:      stats.BidLive,
+				BidCompleted: stats.BidCompleted,
+			}),
+		}, nil
+	}
+
+	f := &core.Market{ItemID: req.GetItemId(), Type: core.MarketTypeAsk}
+	asks, err := s.svc.CountMarketStatus(core.FindOpts{Filter: f})
+	if err != nil {
+		return nil, err
+	}
+	f = &core.Market{ItemID: req.GetItemId(), Type: core.MarketTypeBid}
+	bids, err := s.svc.CountMarketStatus(core.FindOpts{Filter: f})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MarketSummary{
+		Asks: toMarketStatusCount(asks),
+		Bids: toMarketStatusCount(bids),
+	}, nil
+}
+
+func (s *statsServer) GraphMarketSales(ctx context.Context, req *pb.GraphMarketSalesRequest) (*pb.GraphMarketSalesResponse, error) {
+	res, err := s.svc.GraphMarketSales(core.FindOpts{Filter: &core.Market{
+		ItemID: req.GetItemId(),
+		UserID: req.GetUserId(),
+	}})
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pb.MarketSalesPoint, len(res))
+	for i, g := range res {
+		data[i] = &pb.MarketSalesPoint{
+			Date:  toTimestamp(g.Date),
+			Avg:   g.Avg,
+			Count: int32(g.Count),
+		}
+	}
+	return &pb.GraphMarketSalesResponse{Data: data}, nil
+}
+
+func (s *statsServer) ListTopKeywords(ctx context.Context, _ *pb.ListTopKeywordsRequest) (*pb.ListTopKeywordsResponse, error) {
+	res, err := s.svc.TopKeywords()
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pb.KeywordScore, len(res))
+	for i, k := range res {
+		data[i] = &pb.KeywordScore{Keyword: k.Keyword, Score: int32(k.Score)}
+	}
+	return &pb.ListTopKeywordsResponse{Data: data}, nil
+}
@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/grpc/pb"
+)
+
+type userServer struct {
+	pb.UnimplementedUserServiceServer
+	svc core.UserService
+}
+
+func (s *userServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	if req.GetId() == "" {
+		return nil, core.UserErrRequiredID
+	}
+
+	u, err := s.svc.User(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return toUser(u), nil
+}
+
+func (s *userServer) GetMe(ctx context.Context, _ *pb.GetMeRequest) (*pb.User, error) {
+	if err := requireAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	u, err := s.svc.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toUser(u), nil
+}
@@ -1,15 +1,70 @@
 package http
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/go-chi/chi"
 	"github.com/kudarap/dotagiftx/core"
 	"github.com/kudarap/dotagiftx/errors"
 	"github.com/kudarap/dotagiftx/gokit/http/jwt"
 )
 
+// accessTokenScopes lists routes that accepts personal access tokens and its
+// required scope, other routes are only accessible with login access token.
+var accessTokenScopes = map[string]string{
+	"GET /my/profile":               core.AccessTokenScopeProfileRead,
+	"GET /my/markets":               core.AccessTokenScopeMarketsRead,
+	"GET /my/markets/{id}":          core.AccessTokenScopeMarketsRead,
+	"GET /markets/{id}/history":     core.AccessTokenScopeMarketsRead,
+	"GET /my/matches":               core.AccessTokenScopeMarketsRead,
+	"POST /my/markets":              core.AccessTokenScopeMarketsWrite,
+	"PATCH /my/markets/{id}":        core.AccessTokenScopeMarketsWrite,
+	"POST /my/matches/{id}/confirm": core.AccessTokenScopeMarketsWrite,
+	"POST /my/matches/{id}/decline": core.AccessTokenScopeMarketsWrite,
+	"GET /my/offers":                core.AccessTokenScopeOffersRead,
+	"POST /my/offers":               core.AccessTokenScopeOffersWrite,
+	"POST /my/offers/{id}/accept":   core.AccessTokenScopeOffersWrite,
+	"POST /my/offers/{id}/reject":   core.AccessTokenScopeOffersWrite,
+	"POST /my/offers/{id}/counter":  core.AccessTokenScopeOffersWrite,
+}
+
+// routePermissions lists staff routes and its required role permission that
+// is checked against current roles of the user, roles on login access token
+// level could be revoked since it was issued.
+var routePermissions = map[string]string{
+	"POST /items":               core.PermissionItemsWrite,
+	"POST /items_import":        core.PermissionItemsWrite,
+	"POST /synonyms":            core.PermissionSynonymsWrite,
+	"PUT /synonyms/{id}":        core.PermissionSynonymsWrite,
+	"DELETE /synonyms/{id}":     core.PermissionSynonymsWrite,
+	"PUT /exchange_rates":       core.PermissionExchangeRatesWrite,
+	"POST /hammer/ban":          core.PermissionUsersModerate,
+	"POST /hammer/suspend":      core.PermissionUsersModerate,
+	"POST /hammer/lift":         core.PermissionUsersModerate,
+	"GET /hammer/catalog_index": core.PermissionCatalogIndexRead,
+	"GET /roles":                core.PermissionRolesRead,
+	"GET /roles/audits":         core.PermissionRolesRead,
+	"POST /roles/grant":         core.PermissionRolesWrite,
+	"POST /roles/revoke":        core.PermissionRolesWrite,
+}
+
 func (s *Server) authorizer(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Personal access tokens are checked against route scope.
+		if token := bearerToken(r.Header); core.IsAccessToken(token) {
+			ctx, err := s.authorizeAccessToken(r, token)
+			if err != nil {
+				respondError(w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+			return
+		}
+
 		// Validate token from header.
 		c, err := jwt.ParseFromHeader(r.Header)
 		if err != nil {
@@ -18,13 +73,87 @@ func (s *Server) authorizer(next http.Handler) http.Handler {
 		}
 
 		// Checks auth level required.
+		roles := core.RolesFromLevel(c.Level)
+		if p, ok := routePermissions[s.routeKey(r)]; ok {
+			u, err := s.userSvc.User(c.UserID)
+			if err != nil {
+				respondError(w, errors.New(core.AuthErrNoAccess, err))
+				return
+			}
+			if roles = u.Roles; !u.HasPermission(p) {
+				respondError(w, errors.New(core.AuthErrForbidden, fmt.Errorf("route requires %s permission", p)))
+				return
+			}
+		}
 
 		// Inject auth details to context that will later be use as
 		// context user and authorizer level.
 		ctx := core.AuthToContext(r.Context(), &core.Auth{
 			UserID: c.UserID,
+			Roles:  roles,
 		})
 
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+// authenticator injects auth details when request has a valid token and lets
+// anonymous requests through.
+func (s *Server) authenticator(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := jwt.ParseFromHeader(r.Header)
+		if err != nil || c.UserID == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := core.AuthToContext(r.Context(), &core.Auth{
+			UserID: c.UserID,
+			Roles:  core.RolesFromLevel(c.Level),
+		})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// authorizeAccessToken injects auth details of personal access token when
+// it was granted the scope of requested route.
+func (s *Server) authorizeAccessToken(r *http.Request, token string) (context.Context, error) {
+	t, err := s.tokenSvc.Authenticate(token)
+	if err != nil {
+		return nil, errors.New(core.AuthErrNoAccess, err)
+	}
+
+	scope, ok := accessTokenScopes[s.routeKey(r)]
+	if !ok {
+		return nil, errors.New(core.AuthErrForbidden, fmt.Errorf("route does not accept access tokens"))
+	}
+	if !t.HasScope(scope) {
+		return nil, errors.New(core.AuthErrForbidden, fmt.Errorf("access token requires %s scope", scope))
+	}
+
+	ctx := core.AuthToContext(r.Context(), &core.Auth{
+		UserID: t.UserID,
+	})
+	return core.AccessTokenToContext(ctx, t), nil
+}
+
+// routeKey returns method and pattern of the matching route, same format
+// used on route documentation.
+func (s *Server) routeKey(r *http.Request) string {
+	rctx := chi.NewRouteContext()
+	if !s.router.Match(rctx, r.Method, r.URL.Path) {
+		return ""
+	}
+
+	return r.Method + " " + openAPIPath(rctx.RoutePattern())
+}
+
+// bearerToken returns bearer token from authorization header.
+func bearerToken(h http.Header) string {
+	parts := strings.Fields(h.Get("Authorization"))
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
+		return ""
+	}
+
+	return parts[1]
+}
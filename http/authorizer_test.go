@@ -0,0 +1,84 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/gokit/http/jwt"
+)
+
+type testAccessTokenService struct {
+	core.AccessTokenService
+	token core.AccessToken
+}
+
+func (s testAccessTokenService) Authenticate(string) (*core.AccessToken, error) {
+	return &s.token, nil
+}
+
+type testUserService struct {
+	core.UserService
+	roles map[string][]string
+}
+
+func (s testUserService) User(id string) (*core.User, error) {
+	roles, ok := s.roles[id]
+	if !ok {
+		return nil, core.UserErrNotFound
+	}
+	return &core.User{ID: id, Roles: roles}, nil
+}
+
+func TestAuthorizer(t *testing.T) {
+	s := newTestServer()
+	s.tokenSvc = testAccessTokenService{token: core.AccessToken{
+		UserID: "u1",
+		Scopes: []string{core.AccessTokenScopeMarketsRead},
+	}}
+	s.userSvc = testUserService{roles: map[string][]string{
+		"u1": {core.RoleItemCurator},
+		"u2": {core.RoleSupport},
+		"u3": nil,
+	}}
+	h := s.authorizer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	jwtToken := func(userID, level string) string {
+		token, err := jwt.New(userID, level, time.Now().Add(time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+	pat := core.AccessTokenPrefix + "test"
+
+	tests := []struct {
+		name, method, path, token string
+		want                      int
+	}{
+		{"pat with scope", http.MethodGet, "/my/markets", pat, http.StatusOK},
+		{"pat on route without scope mapping", http.MethodGet, "/my/notifications", pat, http.StatusForbidden},
+		{"pat on staff route", http.MethodPost, "/items", pat, http.StatusForbidden},
+		{"pat missing scope", http.MethodPost, "/my/markets", pat, http.StatusForbidden},
+		{"staff route without permission", http.MethodPost, "/items", jwtToken("u2", core.RoleSupport), http.StatusForbidden},
+		{"staff route without role", http.MethodPost, "/roles/grant", jwtToken("u1", ""), http.StatusForbidden},
+		{"staff route with permission", http.MethodPost, "/items", jwtToken("u1", core.RoleItemCurator), http.StatusOK},
+		{"staff route with role granted after login", http.MethodPost, "/items", jwtToken("u1", ""), http.StatusOK},
+		{"staff route with revoked role", http.MethodPost, "/items", jwtToken("u3", core.RoleItemCurator), http.StatusForbidden},
+		{"staff route with unknown user", http.MethodPost, "/items", jwtToken("u9", core.RoleItemCurator), http.StatusUnauthorized},
+		{"private route without role", http.MethodGet, "/my/markets", jwtToken("u3", ""), http.StatusOK},
+	}
+	for _, tc := range tests {
+		r := httptest.NewRequest(tc.method, tc.path, nil)
+		r.Header.Set("Authorization", "Bearer "+tc.token)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		if w.Code != tc.want {
+			t.Errorf("%s: %s %s status = %d, want %d", tc.name, tc.method, tc.path, w.Code, tc.want)
+		}
+	}
+}
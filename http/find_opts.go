@@ -1,12 +1,15 @@
 package http
 
 import (
+	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/gorilla/schema"
 	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
 )
 
 const defaultPageLimit = 10
@@ -28,11 +31,26 @@ func findOptsFromURL(u *url.URL, filter interface{}) (core.FindOpts, error) {
 	// Sets sort.
 	opts.Sort, opts.Desc = parseSort(get("sort"))
 
+	// Set cursor pagination that continues on its own sort.
+	if c := get("cursor"); c != "" {
+		cur, err := core.DecodeCursor(c)
+		if err != nil {
+			return core.FindOpts{}, err
+		}
+		opts.Cursor = cur
+		opts.Sort, opts.Desc = cur.Sort, cur.Desc
+	}
+
 	// Set filter.
 	if err := findOptsFilter(u, filter); err != nil {
 		return core.FindOpts{}, err
 	}
 	opts.Filter = filter
+	exprs, err := findOptsFilterExprs(u, filter)
+	if err != nil {
+		return core.FindOpts{}, err
+	}
+	opts.FilterExprs = exprs
 	opts.WithMeta = true
 
 	return opts, nil
@@ -60,9 +78,52 @@ func parseSort(sortStr string) (field string, isDesc bool) {
 const defaultFilterTag = "json"
 
 func findOptsFilter(u *url.URL, filter interface{}) error {
+	// Currency converts prices and not used as filter.
+	query := u.Query()
+	query.Del(currencyQueryField)
+	for k := range query {
+		if core.IsFilterExprKey(k) {
+			query.Del(k)
+		}
+	}
+
 	// Sets search filters.
 	d := schema.NewDecoder()
 	d.SetAliasTag(defaultFilterTag)
 	d.IgnoreUnknownKeys(true)
-	return d.Decode(filter, u.Query())
+	return d.Decode(filter, query)
+}
+
+// findOptsFilterExprs returns filter expressions like price[gte]=5 validated
+// against filter resource fields.
+func findOptsFilterExprs(u *url.URL, filter interface{}) ([]core.FilterExpr, error) {
+	query := u.Query()
+	var keys []string
+	for k := range query {
+		if core.IsFilterExprKey(k) {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	sort.Strings(keys)
+
+	f, ok := filter.(core.ExprFilterer)
+	if !ok {
+		return nil, errors.New(core.StorageInvalidFilterErr, fmt.Errorf("filter expressions are not supported"))
+	}
+
+	var exprs []core.FilterExpr
+	for _, k := range keys {
+		for _, v := range query[k] {
+			e, err := core.ParseFilterExpr(f.FilterExprFields(), k, v)
+			if err != nil {
+				return nil, errors.New(core.StorageInvalidFilterErr, err)
+			}
+			exprs = append(exprs, *e)
+		}
+	}
+
+	return exprs, nil
 }
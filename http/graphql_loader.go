@@ -0,0 +1,89 @@
+package http
+
+import (
+	"context"
+
+	"github.com/graph-gophers/dataloader/v7"
+	"github.com/kudarap/dotagiftx/core"
+)
+
+type graphqlLoadersKey struct{}
+
+// graphqlLoaders batches related record lookups of a single graphql request,
+// e.g. sellers of every market on a page are fetched in one call.
+type graphqlLoaders struct {
+	users       *dataloader.Loader[string, *core.User]
+	items       *dataloader.Loader[string, *core.Item]
+	deliveries  *dataloader.Loader[string, *core.Delivery]
+	inventories *dataloader.Loader[string, *core.Inventory]
+}
+
+func newGraphQLLoaders(
+	us core.UserService,
+	its core.ItemService,
+	ds core.DeliveryService,
+	ivs core.InventoryService,
+) *graphqlLoaders {
+	return &graphqlLoaders{
+		users: dataloader.NewBatchedLoader(batchByKey(func(ids []string) ([]core.User, error) {
+			return us.Users(core.FindOpts{IDs: ids})
+		}, func(u core.User) string { return u.ID })),
+
+		items: dataloader.NewBatchedLoader(batchByKey(func(ids []string) ([]core.Item, error) {
+			res, _, err := its.Items(core.FindOpts{IDs: ids})
+			return res, err
+		}, func(i core.Item) string { return i.ID })),
+
+		deliveries: dataloader.NewBatchedLoader(batchByKey(func(marketIDs []string) ([]core.Delivery, error) {
+			res, _, err := ds.Deliveries(core.FindOpts{FilterExprs: []core.FilterExpr{marketIDsExpr(marketIDs)}})
+			return res, err
+		}, func(d core.Delivery) string { return d.MarketID })),
+
+		inventories: dataloader.NewBatchedLoader(batchByKey(func(marketIDs []string) ([]core.Inventory, error) {
+			res, _, err := ivs.Inventories(core.FindOpts{FilterExprs: []core.FilterExpr{marketIDsExpr(marketIDs)}})
+			return res, err
+		}, func(i core.Inventory) string { return i.MarketID })),
+	}
+}
+
+func graphqlLoadersToContext(ctx context.Context, l *graphqlLoaders) context.Context {
+	return context.WithValue(ctx, graphqlLoadersKey{}, l)
+}
+
+func graphqlLoadersFromContext(ctx context.Context) *graphqlLoaders {
+	l, _ := ctx.Value(graphqlLoadersKey{}).(*graphqlLoaders)
+	return l
+}
+
+// batchByKey returns batch function that finds records of all keys at once
+// and maps them back to its keys, missing records resolves to nil.
+func batchByKey[V any](find func(keys []string) ([]V, error), key func(V) string) dataloader.BatchFunc[string, *V] {
+	return func(_ context.Context, keys []string) []*dataloader.Result[*V] {
+		results := make([]*dataloader.Result[*V], len(keys))
+		list, err := find(keys)
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result[*V]{Error: err}
+			}
+			return results
+		}
+
+		byKey := make(map[string]*V, len(list))
+		for i := range list {
+			byKey[key(list[i])] = &list[i]
+		}
+		for i, k := range keys {
+			results[i] = &dataloader.Result[*V]{Data: byKey[k]}
+		}
+		return results
+	}
+}
+
+func marketIDsExpr(ids []string) core.FilterExpr {
+	values := make([]interface{}, len(ids))
+	for i, id := range ids {
+		values[i] = id
+	}
+
+	return core.FilterExpr{Field: "market_id", Op: core.FilterOpIn, Type: core.FilterTypeString, Values: values}
+}
@@ -26,13 +26,15 @@ func newError(err error) interface{} {
 }
 
 type dataWithMeta struct {
-	Data        interface{} `json:"data"`
-	ResultCount int         `json:"result_count"`
-	TotalCount  int         `json:"total_count"`
+	Data        interface{}         `json:"data"`
+	ResultCount int                 `json:"result_count"`
+	TotalCount  int                 `json:"total_count"`
+	Facets      *core.CatalogFacets `json:"facets,omitempty"`
+	NextCursor  string              `json:"next_cursor,omitempty"`
 }
 
 func newDataWithMeta(data interface{}, md *core.FindMetadata) dataWithMeta {
-	return dataWithMeta{data, md.ResultCount, md.TotalCount}
+	return dataWithMeta{data, md.ResultCount, md.TotalCount, md.Facets, md.NextCursor}
 }
 
 func hasQueryField(url *url.URL, key string) bool {
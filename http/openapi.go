@@ -0,0 +1,613 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/kudarap/dotagiftx/core"
+)
+
+const (
+	openAPIVersion    = "3.0.3"
+	openAPISecurity   = "bearerAuth"
+	openAPIErrorName  = "Error"
+	openAPIJSONType   = "application/json"
+	openAPIUploadType = "multipart/form-data"
+)
+
+type (
+	// openAPIDoc represents OpenAPI 3 document.
+	openAPIDoc struct {
+		OpenAPI    string                                  `json:"openapi"`
+		Info       openAPIInfo                             `json:"info"`
+		Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+		Components openAPIComponents                       `json:"components"`
+	}
+
+	openAPIInfo struct {
+		Title       string `json:"title"`
+		Description string `json:"description,omitempty"`
+		Version     string `json:"version"`
+	}
+
+	openAPIComponents struct {
+		Schemas         map[string]*openAPISchema        `json:"schemas"`
+		SecuritySchemes map[string]openAPISecurityScheme `json:"securitySchemes"`
+	}
+
+	openAPISecurityScheme struct {
+		Type         string `json:"type"`
+		Scheme       string `json:"scheme"`
+		BearerFormat string `json:"bearerFormat,omitempty"`
+	}
+
+	openAPIOperation struct {
+		Summary     string                     `json:"summary,omitempty"`
+		Description string                     `json:"description,omitempty"`
+		Tags        []string                   `json:"tags,omitempty"`
+		Parameters  []openAPIParameter         `json:"parameters,omitempty"`
+		RequestBody *openAPIRequestBody        `json:"requestBody,omitempty"`
+		Responses   map[string]openAPIResponse `json:"responses"`
+		Security    []map[string][]string      `json:"security,omitempty"`
+	}
+
+	openAPIParameter struct {
+		Name        string         `json:"name"`
+		In          string         `json:"in"`
+		Description string         `json:"description,omitempty"`
+		Required    bool           `json:"required,omitempty"`
+		Schema      *openAPISchema `json:"schema"`
+	}
+
+	openAPIRequestBody struct {
+		Required bool                        `json:"required"`
+		Content  map[string]openAPIMediaType `json:"content"`
+	}
+
+	openAPIResponse struct {
+		Description string                      `json:"description"`
+		Content     map[string]openAPIMediaType `json:"content,omitempty"`
+	}
+
+	openAPIMediaType struct {
+		Schema *openAPISchema `json:"schema"`
+	}
+
+	openAPISchema struct {
+		Ref                  string                    `json:"$ref,omitempty"`
+		Type                 string                    `json:"type,omitempty"`
+		Format               string                    `json:"format,omitempty"`
+		Description          string                    `json:"description,omitempty"`
+		Enum                 []interface{}             `json:"enum,omitempty"`
+		Items                *openAPISchema            `json:"items,omitempty"`
+		Properties           map[string]*openAPISchema `json:"properties,omitempty"`
+		AdditionalProperties *openAPISchema            `json:"additionalProperties,omitempty"`
+		AllOf                []*openAPISchema          `json:"allOf,omitempty"`
+	}
+)
+
+// openAPIAuth represents route authorization requirement.
+type openAPIAuth int
+
+const (
+	openAPIAuthNone openAPIAuth = iota
+	openAPIAuthOptional
+	openAPIAuthRequired
+)
+
+var chiParamRe = regexp.MustCompile(`\{(\w+)(:[^}]*)?\}`)
+
+// newOpenAPIDoc returns OpenAPI document of every route on the router
+// described by apiRoutes. Routes behind authorizer requires access token and
+// behind authenticator accepts optional access token.
+func newOpenAPIDoc(r chi.Routes, version string, authorizer, authenticator func(http.Handler) http.Handler) (*openAPIDoc, error) {
+	doc := &openAPIDoc{
+		OpenAPI: openAPIVersion,
+		Info: openAPIInfo{
+			Title:       "DotagiftX API",
+			Description: "Market place for giftable Dota 2 items.",
+			Version:     version,
+		},
+		Paths: map[string]map[string]*openAPIOperation{},
+		Components: openAPIComponents{
+			Schemas: map[string]*openAPISchema{},
+			SecuritySchemes: map[string]openAPISecurityScheme{
+				openAPISecurity: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+	}
+	doc.Components.Schemas[openAPIErrorName] = openAPIErrorSchema()
+
+	authorizerPtr := reflect.ValueOf(authorizer).Pointer()
+	authenticatorPtr := reflect.ValueOf(authenticator).Pointer()
+	err := walkRoutes(r, func(method, route string, _ http.Handler, mws ...func(http.Handler) http.Handler) error {
+		path := openAPIPath(route)
+		ar, ok := apiRoutes[method+" "+path]
+		if !ok {
+			return fmt.Errorf("route %s %s is not described on apiRoutes", method, path)
+		}
+
+		auth := openAPIAuthNone
+		if ar.optionalAuth {
+			auth = openAPIAuthOptional
+		}
+		for _, mw := range mws {
+			switch reflect.ValueOf(mw).Pointer() {
+			case authorizerPtr:
+				auth = openAPIAuthRequired
+			case authenticatorPtr:
+				auth = openAPIAuthOptional
+			}
+		}
+
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = map[string]*openAPIOperation{}
+		}
+		op := doc.operation(path, ar, auth)
+		if scope, ok := accessTokenScopes[method+" "+path]; ok {
+			op.Description = strings.TrimSpace(fmt.Sprintf("Accepts personal access token with `%s` scope. %s", scope, op.Description))
+		}
+		if perm, ok := routePermissions[method+" "+path]; ok {
+			op.Description = strings.TrimSpace(fmt.Sprintf("Requires role with `%s` permission. %s", perm, op.Description))
+		}
+		doc.Paths[path][strings.ToLower(method)] = op
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// walkRoutes works like chi.Walk but also passes down middlewares of
+// inline groups to its mounted sub routers. Router set up with routeRecorder
+// reports every method route flat with its middlewares chained.
+func walkRoutes(r chi.Routes, fn chi.WalkFunc) error {
+	return walkSubRoutes(r, fn, "")
+}
+
+func walkSubRoutes(r chi.Routes, fn chi.WalkFunc, parentRoute string, parentMws ...func(http.Handler) http.Handler) error {
+	for _, route := range r.Routes() {
+		mws := append(append([]func(http.Handler) http.Handler{}, parentMws...), r.Middlewares()...)
+		if route.SubRoutes != nil {
+			if chain, ok := route.Handlers["*"].(*chi.ChainHandler); ok {
+				mws = append(mws, chain.Middlewares...)
+			}
+			if err := walkSubRoutes(route.SubRoutes, fn, parentRoute+route.Pattern, mws...); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for method, h := range route.Handlers {
+			if method == "*" {
+				continue
+			}
+
+			path := strings.Replace(parentRoute+route.Pattern, "/*/", "/", -1)
+			hmws := mws[:len(mws):len(mws)]
+			if chain, ok := h.(*chi.ChainHandler); ok {
+				h = chain.Endpoint
+				hmws = append(hmws, chain.Middlewares...)
+			}
+			if err := fn(method, path, h, hmws...); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// routeRecorder registers routes on chi router and records each method route
+// with its full pattern and middlewares. chi.Routes does not report method
+// routes registered by another group on a mounted path, e.g. private
+// POST /items on public /items sub router, so walkRoutes reads the recorded
+// routes instead.
+type routeRecorder struct {
+	chi.Router
+	prefix string
+	mws    chi.Middlewares
+	routes *[]chi.Route
+}
+
+func newRouteRecorder(r chi.Router) *routeRecorder {
+	return &routeRecorder{Router: r, routes: &[]chi.Route{}}
+}
+
+// Routes returns recorded method routes with middlewares chained on its handler.
+func (r *routeRecorder) Routes() []chi.Route {
+	return *r.routes
+}
+
+// Middlewares returns nothing since they are already chained on route handlers.
+func (r *routeRecorder) Middlewares() chi.Middlewares {
+	return nil
+}
+
+func (r *routeRecorder) Use(middlewares ...func(http.Handler) http.Handler) {
+	r.Router.Use(middlewares...)
+	r.mws = append(r.mws, middlewares...)
+}
+
+func (r *routeRecorder) With(middlewares ...func(http.Handler) http.Handler) chi.Router {
+	return &routeRecorder{
+		Router: r.Router.With(middlewares...),
+		prefix: r.prefix,
+		mws:    append(r.mws[:len(r.mws):len(r.mws)], middlewares...),
+		routes: r.routes,
+	}
+}
+
+func (r *routeRecorder) Group(fn func(r chi.Router)) chi.Router {
+	im := r.With()
+	if fn != nil {
+		fn(im)
+	}
+	return im
+}
+
+func (r *routeRecorder) Route(pattern string, fn func(r chi.Router)) chi.Router {
+	sub := &routeRecorder{
+		Router: chi.NewRouter(),
+		prefix: r.prefix + pattern,
+		mws:    r.mws[:len(r.mws):len(r.mws)],
+		routes: r.routes,
+	}
+	if fn != nil {
+		fn(sub)
+	}
+	r.Mount(pattern, sub.Router)
+	return sub
+}
+
+func (r *routeRecorder) Method(method, pattern string, h http.Handler) {
+	r.Router.Method(method, pattern, h)
+	*r.routes = append(*r.routes, chi.Route{
+		Pattern:  r.prefix + pattern,
+		Handlers: map[string]http.Handler{strings.ToUpper(method): r.mws.Handler(h)},
+	})
+}
+
+func (r *routeRecorder) MethodFunc(method, pattern string, h http.HandlerFunc) {
+	r.Method(method, pattern, h)
+}
+
+func (r *routeRecorder) Connect(pattern string, h http.HandlerFunc) {
+	r.Method(http.MethodConnect, pattern, h)
+}
+
+func (r *routeRecorder) Delete(pattern string, h http.HandlerFunc) {
+	r.Method(http.MethodDelete, pattern, h)
+}
+
+func (r *routeRecorder) Get(pattern string, h http.HandlerFunc) {
+	r.Method(http.MethodGet, pattern, h)
+}
+
+func (r *routeRecorder) Head(pattern string, h http.HandlerFunc) {
+	r.Method(http.MethodHead, pattern, h)
+}
+
+func (r *routeRecorder) Options(pattern string, h http.HandlerFunc) {
+	r.Method(http.MethodOptions, pattern, h)
+}
+
+func (r *routeRecorder) Patch(pattern string, h http.HandlerFunc) {
+	r.Method(http.MethodPatch, pattern, h)
+}
+
+func (r *routeRecorder) Post(pattern string, h http.HandlerFunc) {
+	r.Method(http.MethodPost, pattern, h)
+}
+
+func (r *routeRecorder) Put(pattern string, h http.HandlerFunc) {
+	r.Method(http.MethodPut, pattern, h)
+}
+
+func (r *routeRecorder) Trace(pattern string, h http.HandlerFunc) {
+	r.Method(http.MethodTrace, pattern, h)
+}
+
+// openAPIPath returns route pattern without trailing slash and regexp of
+// path parameters.
+func openAPIPath(route string) string {
+	if route != "/" {
+		route = strings.TrimSuffix(route, "/")
+	}
+
+	return chiParamRe.ReplaceAllString(route, "{$1}")
+}
+
+func (d *openAPIDoc) operation(path string, ar apiRoute, auth openAPIAuth) *openAPIOperation {
+	op := &openAPIOperation{
+		Summary:   ar.summary,
+		Tags:      []string{ar.tag},
+		Responses: map[string]openAPIResponse{},
+	}
+
+	// Path, list and filter query parameters.
+	for _, m := range chiParamRe.FindAllStringSubmatch(path, -1) {
+		op.Parameters = append(op.Parameters, openAPIParameter{
+			Name: m[1], In: "path", Required: true, Schema: &openAPISchema{Type: "string"},
+		})
+	}
+	if ar.list || ar.page {
+		op.Parameters = append(op.Parameters, openAPIListParameters(ar.sort)...)
+	}
+	if ar.filter != nil {
+		op.Parameters = append(op.Parameters, d.filterParameters(ar.filter)...)
+		if f, ok := ar.filter.(core.ExprFilterer); ok {
+			op.Description = openAPIFilterExprDescription(f.FilterExprFields())
+		}
+	}
+	op.Parameters = append(op.Parameters, ar.query...)
+
+	if ar.body != nil {
+		bodyType := openAPIJSONType
+		if ar.bodyType != "" {
+			bodyType = ar.bodyType
+		}
+		op.RequestBody = &openAPIRequestBody{
+			Required: true,
+			Content:  map[string]openAPIMediaType{bodyType: {d.schemaOf(reflect.TypeOf(ar.body))}},
+		}
+	}
+
+	// Responses of list endpoints are wrapped with data and its metadata.
+	res := openAPIResponse{Description: "OK"}
+	switch {
+	case ar.respType != "":
+		res.Content = map[string]openAPIMediaType{ar.respType: {&openAPISchema{Type: "string", Format: "binary"}}}
+	case ar.list:
+		envelope := d.schemaOf(reflect.TypeOf(dataWithMeta{}))
+		data := &openAPISchema{Type: "array", Items: d.schemaOf(reflect.TypeOf(ar.resp))}
+		res.Content = map[string]openAPIMediaType{openAPIJSONType: {&openAPISchema{AllOf: []*openAPISchema{
+			envelope,
+			{Type: "object", Properties: map[string]*openAPISchema{"data": data}},
+		}}}}
+	case ar.resp != nil:
+		res.Content = map[string]openAPIMediaType{openAPIJSONType: {d.schemaOf(reflect.TypeOf(ar.resp))}}
+	}
+	op.Responses["200"] = res
+
+	errRes := func(desc string) openAPIResponse {
+		return openAPIResponse{Description: desc, Content: map[string]openAPIMediaType{
+			openAPIJSONType: {&openAPISchema{Ref: "#/components/schemas/" + openAPIErrorName}},
+		}}
+	}
+	op.Responses["400"] = errRes("Handled error with error type")
+	op.Responses["429"] = errRes("Too many requests, quota is on RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset headers")
+	op.Responses["500"] = errRes("Fatal error")
+
+	switch auth {
+	case openAPIAuthRequired:
+		op.Security = []map[string][]string{{openAPISecurity: {}}}
+		op.Responses["401"] = errRes("Missing or invalid access token")
+		op.Responses["403"] = errRes("Not allowed to access the resource")
+	case openAPIAuthOptional:
+		op.Security = []map[string][]string{{}, {openAPISecurity: {}}}
+		op.Responses["401"] = errRes("Invalid access token")
+	}
+
+	return op
+}
+
+func openAPIListParameters(sortFields []string) []openAPIParameter {
+	sortSchema := &openAPISchema{Type: "string"}
+	for _, f := range sortFields {
+		sortSchema.Enum = append(sortSchema.Enum, f)
+		if !strings.Contains(f, ":") && !isSortAlias(f) {
+			sortSchema.Enum = append(sortSchema.Enum, f+sortDescSuffix)
+		}
+	}
+
+	return []openAPIParameter{
+		{Name: "q", In: "query", Description: "Keyword search", Schema: &openAPISchema{Type: "string"}},
+		{Name: "page", In: "query", Schema: &openAPISchema{Type: "integer"}},
+		{Name: "limit", In: "query", Description: fmt.Sprintf("Defaults to %d", defaultPageLimit), Schema: &openAPISchema{Type: "integer"}},
+		{Name: "sort", In: "query", Description: `Sort field with optional ":desc" suffix`, Schema: sortSchema},
+		{Name: "cursor", In: "query", Description: "Continues from next_cursor of previous page and its sort", Schema: &openAPISchema{Type: "string"}},
+	}
+}
+
+// isSortAlias checks sort value is a query flag and not a field.
+func isSortAlias(s string) bool {
+	switch s {
+	case "best", "recent", "lowest", "highest", queryFlagPopularItems, queryFlagRecentBidItems:
+		return true
+	}
+
+	return false
+}
+
+// filterParameters returns query parameters of filter resource scalar fields
+// decoded by findOptsFilter.
+func (d *openAPIDoc) filterParameters(filter interface{}) []openAPIParameter {
+	t := reflect.TypeOf(filter)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	var params []openAPIParameter
+	for _, f := range openAPIFields(t) {
+		ft := f.typ
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		switch ft.Kind() {
+		case reflect.Struct, reflect.Slice, reflect.Map, reflect.Interface:
+			continue
+		}
+
+		params = append(params, openAPIParameter{Name: f.name, In: "query", Schema: d.schemaOf(ft)})
+	}
+
+	return params
+}
+
+var openAPIFilterTypes = map[core.FilterType]string{
+	core.FilterTypeNumber: "number",
+	core.FilterTypeTime:   "date-time",
+	core.FilterTypeString: "string",
+}
+
+func openAPIFilterExprDescription(fields core.FilterExprFields) string {
+	names := make([]string, 0, len(fields))
+	for f, t := range fields {
+		names = append(names, fmt.Sprintf("%s (%s)", f, openAPIFilterTypes[t]))
+	}
+	sort.Strings(names)
+
+	return "Accepts filter expressions `field[op]=value` where op is gt, gte, lt, lte or in with comma separated " +
+		"values on fields: " + strings.Join(names, ", ") + "."
+}
+
+// openAPIErrorSchema returns error response schema with core error types
+// and its messages.
+func openAPIErrorSchema() *openAPISchema {
+	types := &openAPISchema{Type: "string"}
+	var desc strings.Builder
+	desc.WriteString("Error type of handled errors:\n\n| type | code | message |\n| --- | --- | --- |\n")
+	for _, t := range core.ErrorTypes() {
+		types.Enum = append(types.Enum, t.String())
+		fmt.Fprintf(&desc, "| %s | %d | %s |\n", t, t, t.Error())
+	}
+	types.Description = desc.String()
+
+	return &openAPISchema{
+		Type: "object",
+		Properties: map[string]*openAPISchema{
+			"error": {Type: "boolean"},
+			"type":  types,
+			"msg":   {Type: "string"},
+		},
+	}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemaOf returns schema of type and named structs are referenced from
+// components schemas.
+func (d *openAPIDoc) schemaOf(t reflect.Type) *openAPISchema {
+	if t == nil {
+		return &openAPISchema{}
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return &openAPISchema{Type: "string", Format: "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &openAPISchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &openAPISchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &openAPISchema{Type: "number"}
+	case reflect.String:
+		return &openAPISchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &openAPISchema{Type: "string", Format: "byte"}
+		}
+		return &openAPISchema{Type: "array", Items: d.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &openAPISchema{Type: "object", AdditionalProperties: d.schemaOf(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return d.objectSchema(t)
+		}
+		name := openAPISchemaName(t)
+		if _, ok := d.Components.Schemas[name]; !ok {
+			// Reserves the name first for self referencing types.
+			d.Components.Schemas[name] = &openAPISchema{}
+			*d.Components.Schemas[name] = *d.objectSchema(t)
+		}
+		return &openAPISchema{Ref: "#/components/schemas/" + name}
+	}
+
+	return &openAPISchema{}
+}
+
+func (d *openAPIDoc) objectSchema(t reflect.Type) *openAPISchema {
+	s := &openAPISchema{Type: "object", Properties: map[string]*openAPISchema{}}
+	for _, f := range openAPIFields(t) {
+		s.Properties[f.name] = d.schemaOf(f.typ)
+	}
+
+	return s
+}
+
+// openAPISchemaName returns exported style name of type, e.g. dataWithMeta
+// as DataWithMeta.
+func openAPISchemaName(t reflect.Type) string {
+	n := t.Name()
+	return strings.ToUpper(n[:1]) + n[1:]
+}
+
+type openAPIField struct {
+	name string
+	typ  reflect.Type
+}
+
+// openAPIFields returns json fields of struct including fields of embedded
+// structs.
+func openAPIFields(t reflect.Type) []openAPIField {
+	var fields []openAPIField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			et := f.Type
+			if et.Kind() == reflect.Ptr {
+				et = et.Elem()
+			}
+			if et.Kind() == reflect.Struct {
+				fields = append(fields, openAPIFields(et)...)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		fields = append(fields, openAPIField{name, f.Type})
+	}
+
+	return fields
+}
+
+// openAPIJSON returns document as json with sorted keys.
+func openAPIJSON(doc *openAPIDoc) (string, error) {
+	return jsoniter.ConfigCompatibleWithStandardLibrary.MarshalToString(doc)
+}
+
+func handleOpenAPI(doc func() (string, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s, err := doc()
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, s)
+	}
+}
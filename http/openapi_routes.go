@@ -0,0 +1,219 @@
+package http
+
+import (
+	"github.com/graphql-go/graphql"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/gokit/version"
+)
+
+// apiRoute describes route request and response on OpenAPI document.
+type apiRoute struct {
+	summary string
+	tag     string
+
+	// list responds with data and its metadata and accepts page, sort and
+	// cursor query, page accepts them without metadata.
+	list bool
+	page bool
+	sort []string
+	// filter resource decoded from query.
+	filter interface{}
+	query  []openAPIParameter
+
+	body     interface{}
+	bodyType string
+	resp     interface{}
+	// respType content type of non json response.
+	respType string
+
+	// optionalAuth route checks access token without authenticator.
+	optionalAuth bool
+}
+
+var (
+	marketSortFields  = []string{"price", "created_at", "updated_at", "user_rank_score", "best", "recent", "lowest", "highest"}
+	catalogSortFields = []string{"name", "view_count", "lowest_ask", "highest_bid", "recent_ask", "recent_bid", "sale_count",
+		"created_at", "updated_at", queryFlagRecentItems, queryFlagPopularItems, queryFlagRecentBidItems}
+	itemSortFields   = []string{"name", "hero", "view_count", "created_at"}
+	reportSortFields = []string{"created_at"}
+
+	currencyParam = openAPIParameter{
+		Name: currencyQueryField, In: "query", Description: "Converts prices from base currency, e.g. EUR",
+		Schema: &openAPISchema{Type: "string"},
+	}
+	uploadForm = struct {
+		File []byte `json:"file"`
+	}{}
+)
+
+// apiRoutes describes every route by method and path, newOpenAPIDoc fails
+// on routes that are not described here.
+var apiRoutes = map[string]apiRoute{
+	"GET /":             {summary: "API version info", tag: "info", resp: version.Version{}},
+	"GET /openapi.json": {summary: "OpenAPI document", tag: "info", resp: map[string]interface{}{}},
+
+	// Auth.
+	"GET /auth/steam":   {summary: "Steam login and callback", tag: "auth", resp: authResp{}},
+	"POST /auth/renew":  {summary: "Renew access token", tag: "auth", body: refreshTokenForm{}, resp: authResp{}},
+	"POST /auth/revoke": {summary: "Revoke refresh token", tag: "auth", body: refreshTokenForm{}, resp: httpMsg{}},
+
+	// Images.
+	"GET /images/{w}x{h}/{id}": {summary: "Image thumbnail", tag: "images", respType: "image/*"},
+	"GET /images/{id}":         {summary: "Image", tag: "images", respType: "image/*"},
+	"POST /images": {
+		summary: "Upload image", tag: "images", body: uploadForm, bodyType: openAPIUploadType, resp: imageUploadResp{},
+	},
+
+	// Items.
+	"GET /items": {
+		summary: "Item search", tag: "items", list: true, sort: itemSortFields, filter: core.Item{}, resp: core.Item{},
+	},
+	"GET /items/{id}": {summary: "Item details by id or slug", tag: "items", resp: core.Item{}},
+	"POST /items":     {summary: "Create item", tag: "items", body: core.Item{}, resp: core.Item{}},
+	"POST /items_import": {
+		summary: "Import items from yaml file", tag: "items", body: uploadForm, bodyType: openAPIUploadType,
+		query: []openAPIParameter{{Name: "key", In: "query", Required: true, Schema: &openAPISchema{Type: "string"}}},
+		resp:  core.ItemImportResult{},
+	},
+
+	// Markets.
+	"GET /markets": {
+		summary: "Market search", tag: "markets", list: true, sort: marketSortFields, filter: core.Market{},
+		query: []openAPIParameter{currencyParam}, resp: core.Market{}, optionalAuth: true,
+	},
+	"GET /markets/{id}": {
+		summary: "Market details", tag: "markets", query: []openAPIParameter{currencyParam}, resp: core.Market{},
+		optionalAuth: true,
+	},
+	"GET /markets/{id}/history": {
+		summary: "Market status history for owner and staff users", tag: "markets", resp: []core.MarketHistory{},
+	},
+	"GET /my/markets": {
+		summary: "User market list", tag: "markets", list: true, sort: marketSortFields, filter: core.Market{},
+		query: []openAPIParameter{currencyParam}, resp: core.Market{},
+	},
+	"POST /my/markets": {summary: "Create user market", tag: "markets", body: core.Market{}, resp: core.Market{}},
+	"GET /my/markets/{id}": {
+		summary: "User market details", tag: "markets", query: []openAPIParameter{currencyParam}, resp: core.Market{},
+	},
+	"PATCH /my/markets/{id}": {summary: "Update user market", tag: "markets", body: core.Market{}, resp: core.Market{}},
+
+	// Catalogs.
+	"GET /catalogs": {
+		summary: "Catalog search with facets on keyword search", tag: "catalogs", list: true, sort: catalogSortFields,
+		filter: core.Catalog{}, query: []openAPIParameter{currencyParam}, resp: core.Catalog{},
+	},
+	"GET /catalogs_trend": {
+		summary: "Trending catalogs", tag: "catalogs", list: true, filter: core.Catalog{},
+		query: []openAPIParameter{currencyParam}, resp: core.Catalog{},
+	},
+	"GET /catalogs/{slug}": {
+		summary: "Catalog details and its asks", tag: "catalogs", page: true, sort: marketSortFields,
+		filter: core.Market{}, query: []openAPIParameter{currencyParam}, resp: core.Catalog{},
+	},
+	"GET /catalogs/{slug}/history": {
+		summary: "Catalog price candles", tag: "catalogs", resp: core.PriceHistory{},
+		query: []openAPIParameter{
+			{Name: "interval", In: "query", Schema: &openAPISchema{Type: "string", Enum: []interface{}{"1h", "1d"}}},
+			{Name: "limit", In: "query", Schema: &openAPISchema{Type: "integer"}},
+		},
+	},
+
+	// Users.
+	"GET /users/{id}":  {summary: "User public profile", tag: "users", resp: core.User{}},
+	"GET /vanity/{id}": {summary: "User profile by steam vanity id", tag: "users", resp: vanityUserResp{}},
+	"GET /blacklists": {
+		summary: "Banned and suspended users", tag: "users", page: true, filter: core.Item{}, resp: []core.User{},
+	},
+	"GET /my/profile": {summary: "User profile", tag: "users", resp: core.User{}},
+	"POST /my/process_subscription": {
+		summary: "Process user subscription", tag: "users", body: subscriptionForm{}, resp: core.User{},
+	},
+
+	// Stats.
+	"GET /stats/market_summary": {
+		summary: "Market status count", tag: "stats", filter: core.Market{}, resp: marketSummaryResp{},
+	},
+	"GET /stats/top_origins":  {summary: "Top origins", tag: "stats", resp: []string{}},
+	"GET /stats/top_heroes":   {summary: "Top heroes", tag: "stats", resp: []string{}},
+	"GET /stats/top_keywords": {summary: "Top search keywords", tag: "stats", resp: []core.SearchKeywordScore{}},
+	"GET /graph/market_sales": {
+		summary: "Market sales graph", tag: "stats", filter: core.Market{}, resp: []core.MarketSalesGraph{},
+	},
+
+	// Reports.
+	"GET /reports": {
+		summary: "Report list", tag: "reports", list: true, sort: reportSortFields, filter: core.Report{},
+		resp: core.Report{},
+	},
+	"GET /reports/{id}": {summary: "Report details", tag: "reports", resp: core.Report{}},
+	"POST /reports":     {summary: "Create user report", tag: "reports", body: core.Report{}, resp: core.Report{}},
+
+	// Others.
+	"GET /exchange_rates": {summary: "Exchange rates against base currency", tag: "currency", resp: []core.ExchangeRate{}},
+	"PUT /exchange_rates": {
+		summary: "Save exchange rates", tag: "currency", body: []core.ExchangeRate{}, resp: httpMsg{},
+	},
+	"GET /synonyms":         {summary: "Search synonym dictionary", tag: "synonyms", resp: []core.Synonym{}},
+	"POST /synonyms":        {summary: "Create search synonym", tag: "synonyms", body: core.Synonym{}, resp: core.Synonym{}},
+	"PUT /synonyms/{id}":    {summary: "Update search synonym", tag: "synonyms", body: core.Synonym{}, resp: core.Synonym{}},
+	"DELETE /synonyms/{id}": {summary: "Remove search synonym", tag: "synonyms", resp: httpMsg{}},
+	"GET /t":                {summary: "Tracking pixel", tag: "tracker", respType: "image/gif"},
+	"GET /sitemap.xml":      {summary: "Sitemap", tag: "sitemap", respType: "application/xml"},
+	"GET /graphql": {
+		summary: "GraphQL query", tag: "graphql", resp: graphql.Result{},
+		query: []openAPIParameter{
+			{Name: "query", In: "query", Required: true, Schema: &openAPISchema{Type: "string"}},
+		},
+	},
+	"POST /graphql": {summary: "GraphQL query", tag: "graphql", body: graphqlRequest{}, resp: graphql.Result{}},
+
+	// Market matches and offers.
+	"GET /my/matches":                  {summary: "User market matches", tag: "matches", resp: []core.MarketMatch{}},
+	"POST /my/matches/{id}/confirm":    {summary: "Confirm market match", tag: "matches", resp: core.MarketMatch{}},
+	"POST /my/matches/{id}/decline":    {summary: "Decline market match", tag: "matches", resp: core.MarketMatch{}},
+	"GET /my/offers":                   {summary: "User offers", tag: "offers", resp: []core.Offer{}},
+	"POST /my/offers":                  {summary: "Make an offer", tag: "offers", body: core.Offer{}, resp: core.Offer{}},
+	"POST /my/offers/{id}/accept":      {summary: "Accept offer", tag: "offers", resp: core.Offer{}},
+	"POST /my/offers/{id}/reject":      {summary: "Reject offer", tag: "offers", resp: core.Offer{}},
+	"POST /my/offers/{id}/counter":     {summary: "Counter offer", tag: "offers", body: offerCounterForm{}, resp: core.Offer{}},
+	"GET /my/webhooks":                 {summary: "User webhooks", tag: "webhooks", resp: []core.Webhook{}},
+	"POST /my/webhooks":                {summary: "Register webhook", tag: "webhooks", body: core.Webhook{}, resp: core.Webhook{}},
+	"GET /my/webhooks/{id}":            {summary: "Webhook details", tag: "webhooks", resp: core.Webhook{}},
+	"PATCH /my/webhooks/{id}":          {summary: "Update webhook", tag: "webhooks", body: core.Webhook{}, resp: core.Webhook{}},
+	"DELETE /my/webhooks/{id}":         {summary: "Remove webhook", tag: "webhooks", resp: httpMsg{}},
+	"GET /my/webhooks/{id}/deliveries": {summary: "Webhook delivery logs", tag: "webhooks", resp: []core.WebhookDelivery{}},
+	"GET /my/notifications": {
+		summary: "User notification settings", tag: "notifications", resp: core.NotificationSetting{},
+	},
+	"PUT /my/notifications": {
+		summary: "Save user notification settings, sends confirmation code to unconfirmed email", tag: "notifications",
+		body: core.NotificationSetting{}, resp: core.NotificationSetting{},
+	},
+	"POST /my/notifications/confirm-email": {
+		summary: "Confirm notification email address with code sent to it", tag: "notifications",
+		body: core.NotificationEmailConfirm{}, resp: core.NotificationSetting{},
+	},
+	"GET /my/tokens":            {summary: "User personal access tokens", tag: "tokens", resp: []core.AccessToken{}},
+	"POST /my/tokens":           {summary: "Create personal access token, token is only shown once", tag: "tokens", body: core.AccessToken{}, resp: core.AccessToken{}},
+	"DELETE /my/tokens/{id}":    {summary: "Revoke personal access token", tag: "tokens", resp: core.AccessToken{}},
+	"GET /my/watchlist":         {summary: "User watched items", tag: "watchlist", resp: []core.Watchlist{}},
+	"POST /my/watchlist":        {summary: "Watch item", tag: "watchlist", body: core.Watchlist{}, resp: core.Watchlist{}},
+	"GET /my/watchlist/alerts":  {summary: "Triggered price alerts", tag: "watchlist", resp: []core.WatchlistAlert{}},
+	"PATCH /my/watchlist/{id}":  {summary: "Update watchlist thresholds", tag: "watchlist", body: core.Watchlist{}, resp: core.Watchlist{}},
+	"DELETE /my/watchlist/{id}": {summary: "Remove watched item", tag: "watchlist", resp: httpMsg{}},
+
+	// Hammer.
+	"POST /hammer/ban":     {summary: "Ban user", tag: "hammer", body: core.HammerParams{}, resp: core.User{}},
+	"POST /hammer/suspend": {summary: "Suspend user", tag: "hammer", body: core.HammerParams{}, resp: core.User{}},
+	"POST /hammer/lift":    {summary: "Lift user ban or suspension", tag: "hammer", body: hammerLiftForm{}, resp: httpMsg{}},
+	"GET /hammer/catalog_index": {
+		summary: "Catalog re-index queue metrics", tag: "hammer", resp: core.CatalogIndexStats{},
+	},
+
+	// Roles.
+	"GET /roles":         {summary: "Staff users and their roles", tag: "roles", resp: []core.User{}},
+	"GET /roles/audits":  {summary: "Role grant and revoke logs", tag: "roles", page: true, filter: core.RoleAudit{}, resp: []core.RoleAudit{}},
+	"POST /roles/grant":  {summary: "Grant role to user", tag: "roles", body: core.RoleParams{}, resp: core.User{}},
+	"POST /roles/revoke": {summary: "Revoke role from user", tag: "roles", body: core.RoleParams{}, resp: core.User{}},
+}
@@ -0,0 +1,94 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kudarap/dotagiftx/gokit/version"
+	"github.com/kudarap/dotagiftx/service"
+)
+
+func newTestServer() *Server {
+	// Some handlers take service method on setup.
+	its := service.NewItem(nil, nil, nil)
+	s := NewServer("", nil, nil, nil, nil, its, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		nil, nil, nil, nil, nil, &version.Version{Tag: "v0.0.0"}, nil)
+	s.setup()
+	return s
+}
+
+func TestOpenAPIDocCoversRoutes(t *testing.T) {
+	s := newTestServer()
+	doc, err := newOpenAPIDoc(s.router, s.version.Tag, s.authorizer, s.authenticator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	routes := map[string]bool{}
+	_ = walkRoutes(s.router, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		routes[method+" "+openAPIPath(route)] = true
+		return nil
+	})
+	for k := range apiRoutes {
+		if !routes[k] {
+			t.Errorf("apiRoutes %q is not registered on router", k)
+		}
+	}
+	for k := range accessTokenScopes {
+		if !routes[k] {
+			t.Errorf("accessTokenScopes %q is not registered on router", k)
+		}
+	}
+	for k := range routePermissions {
+		if !routes[k] {
+			t.Errorf("routePermissions %q is not registered on router", k)
+		}
+	}
+
+	tests := []struct {
+		method, path string
+		security     int
+	}{
+		{"get", "/items", 0},
+		{"get", "/markets", 2},
+		{"get", "/markets/{id}/history", 1},
+		{"get", "/graphql", 2},
+		{"post", "/my/markets", 1},
+		{"get", "/my/profile", 1},
+		{"post", "/items", 1},
+		{"get", "/synonyms", 0},
+		{"delete", "/synonyms/{id}", 1},
+		{"post", "/hammer/ban", 1},
+		{"post", "/roles/grant", 1},
+	}
+	for _, tt := range tests {
+		op := doc.Paths[tt.path][tt.method]
+		if op == nil {
+			t.Errorf("%s %s operation not found", tt.method, tt.path)
+			continue
+		}
+		if got := len(op.Security); got != tt.security {
+			t.Errorf("%s %s security = %d, want %d", tt.method, tt.path, got, tt.security)
+		}
+	}
+}
+
+func TestOpenAPIHandler(t *testing.T) {
+	s := newTestServer()
+	body, err := s.openAPI()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var doc map[string]interface{}
+	if err = json.UnmarshalFromString(body, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc["openapi"] != openAPIVersion {
+		t.Errorf("openapi = %v, want %s", doc["openapi"], openAPIVersion)
+	}
+	if !strings.Contains(body, `"DataWithMeta"`) || !strings.Contains(body, `"Error"`) {
+		t.Error("missing DataWithMeta or Error component schema")
+	}
+}
@@ -0,0 +1,154 @@
+package http
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/gokit/http/jwt"
+)
+
+// RateLimitConfig represents requests allowed per client on route groups in
+// requests/window format, e.g. 60/1m, and leave it empty to disable.
+type RateLimitConfig struct {
+	// Public routes.
+	Public core.RateLimit
+	// Private routes that requires access token.
+	Private core.RateLimit
+	// Tracker view tracking pixel.
+	Tracker core.RateLimit
+	// Vanity profiles that resolves Steam vanity urls.
+	Vanity core.RateLimit
+	// TrustedProxies number of reverse proxies in front of the server that
+	// appends its peer address on X-Forwarded-For, zero limits by connection
+	// address since forwarded headers can be set by anyone.
+	TrustedProxies int
+}
+
+const (
+	headerRateLimitLimit     = "RateLimit-Limit"
+	headerRateLimitRemaining = "RateLimit-Remaining"
+	headerRateLimitReset     = "RateLimit-Reset"
+	headerRetryAfter         = "Retry-After"
+	headerForwardedFor       = "X-Forwarded-For"
+)
+
+// rateLimiter throttles requests of route group by authenticated user or by
+// client IP, requests are allowed when limiter is unavailable.
+func (s *Server) rateLimiter(group string, rl core.RateLimit) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if s.limiter == nil || rl.IsZero() {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			res, err := s.limiter.Allow(group+":"+rateLimitClient(r), rl)
+			if err != nil {
+				s.logger.Errorf("could not check %s rate limit: %s", group, err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			reset := strconv.Itoa(int(math.Ceil(res.Reset.Seconds())))
+			w.Header().Set(headerRateLimitLimit, strconv.Itoa(res.Limit))
+			w.Header().Set(headerRateLimitRemaining, strconv.Itoa(res.Remaining))
+			w.Header().Set(headerRateLimitReset, reset)
+			if !res.Allowed {
+				w.Header().Set(headerRetryAfter, reset)
+				respondError(w, core.RateLimitErrExceeded)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// rateLimitIPKey context key of client IP used for rate limiting.
+type rateLimitIPKey struct{}
+
+// realIP resolves client IP from the trusted proxy hop for rate limiting,
+// addresses before it are supplied by the client and ignored. Without trusted
+// proxies the limiter uses connection address while request remote address
+// used on logs and tracking still reads forwarded headers.
+func (s *Server) realIP(next http.Handler) http.Handler {
+	fallback := middleware.RealIP(next)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := forwardedClientIP(r.Header, s.RateLimit.TrustedProxies)
+		if ip != "" {
+			r.RemoteAddr = ip
+		} else {
+			ip = remoteHost(r.RemoteAddr)
+		}
+		r = r.WithContext(context.WithValue(r.Context(), rateLimitIPKey{}, ip))
+
+		if s.RateLimit.TrustedProxies <= 0 {
+			fallback.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// forwardedClientIP returns address appended by the outermost of the trusted
+// proxies, each proxy appends its peer address so the client is at the number
+// of proxies from the end.
+func forwardedClientIP(h http.Header, proxies int) string {
+	if proxies <= 0 {
+		return ""
+	}
+
+	var chain []string
+	for _, v := range h.Values(headerForwardedFor) {
+		for _, addr := range strings.Split(v, ",") {
+			chain = append(chain, strings.TrimSpace(addr))
+		}
+	}
+	if len(chain) == 0 {
+		return ""
+	}
+
+	i := len(chain) - proxies
+	if i < 0 {
+		i = 0
+	}
+	ip := net.ParseIP(chain[i])
+	if ip == nil {
+		return ""
+	}
+
+	return ip.String()
+}
+
+// rateLimitClient returns user id of valid access token or client IP that
+// was already resolved by realIP middleware.
+func rateLimitClient(r *http.Request) string {
+	if au := core.AuthFromContext(r.Context()); au != nil {
+		return "user:" + au.UserID
+	}
+	if c, err := jwt.ParseFromHeader(r.Header); err == nil && c.UserID != "" {
+		return "user:" + c.UserID
+	}
+
+	ip, ok := r.Context().Value(rateLimitIPKey{}).(string)
+	if !ok {
+		ip = remoteHost(r.RemoteAddr)
+	}
+
+	return "ip:" + ip
+}
+
+// remoteHost returns host of remote address without port.
+func remoteHost(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+
+	return host
+}
@@ -0,0 +1,69 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestForwardedClientIP(t *testing.T) {
+	tests := []struct {
+		name    string
+		xff     []string
+		proxies int
+		want    string
+	}{
+		{"no trusted proxy", []string{"1.1.1.1"}, 0, ""},
+		{"no header", nil, 1, ""},
+		{"single proxy", []string{"1.1.1.1"}, 1, "1.1.1.1"},
+		{"spoofed by client", []string{"9.9.9.9, 1.1.1.1"}, 1, "1.1.1.1"},
+		{"two proxies", []string{"9.9.9.9, 1.1.1.1, 10.0.0.2"}, 2, "1.1.1.1"},
+		{"multiple headers", []string{"9.9.9.9", "1.1.1.1"}, 1, "1.1.1.1"},
+		{"fewer hops", []string{"1.1.1.1"}, 2, "1.1.1.1"},
+		{"invalid address", []string{"1.1.1.1, unknown"}, 1, ""},
+	}
+	for _, tc := range tests {
+		h := http.Header{}
+		for _, v := range tc.xff {
+			h.Add(headerForwardedFor, v)
+		}
+		if got := forwardedClientIP(h, tc.proxies); got != tc.want {
+			t.Errorf("%s: forwardedClientIP() = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestRealIP(t *testing.T) {
+	tests := []struct {
+		name           string
+		proxies        int
+		xff            string
+		wantRemoteAddr string
+		wantClient     string
+	}{
+		{"no trusted proxy", 0, "9.9.9.9, 1.1.1.1", "9.9.9.9", "ip:10.0.0.1"},
+		{"no trusted proxy without header", 0, "", "10.0.0.1:1234", "ip:10.0.0.1"},
+		{"trusted proxy", 1, "9.9.9.9, 1.1.1.1", "1.1.1.1", "ip:1.1.1.1"},
+		{"trusted proxy without header", 1, "", "10.0.0.1:1234", "ip:10.0.0.1"},
+	}
+	for _, tc := range tests {
+		s := &Server{RateLimit: RateLimitConfig{TrustedProxies: tc.proxies}}
+		var gotRemoteAddr, gotClient string
+		h := s.realIP(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotRemoteAddr, gotClient = r.RemoteAddr, rateLimitClient(r)
+		}))
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = "10.0.0.1:1234"
+		if tc.xff != "" {
+			r.Header.Set(headerForwardedFor, tc.xff)
+		}
+		h.ServeHTTP(httptest.NewRecorder(), r)
+		if gotRemoteAddr != tc.wantRemoteAddr {
+			t.Errorf("%s: remote addr = %q, want %q", tc.name, gotRemoteAddr, tc.wantRemoteAddr)
+		}
+		if gotClient != tc.wantClient {
+			t.Errorf("%s: rate limit client = %q, want %q", tc.name, gotClient, tc.wantClient)
+		}
+	}
+}
@@ -46,6 +46,8 @@ func respondError(w http.ResponseWriter, err error) {
 			status = http.StatusUnauthorized
 		} else if cErr.IsEqual(core.AuthErrForbidden) {
 			status = http.StatusForbidden
+		} else if cErr.IsEqual(core.RateLimitErrExceeded) {
+			status = http.StatusTooManyRequests
 		}
 
 		body = httpMsg{true, cErr.Type.String(), err.Error()}
@@ -4,7 +4,9 @@ import "github.com/go-chi/chi"
 
 func (s *Server) publicRouter(r chi.Router) {
 	r.Group(func(r chi.Router) {
+		r.Use(s.rateLimiter("public", s.RateLimit.Public))
 		r.Get("/", handleInfo(s.version))
+		r.Get("/openapi.json", handleOpenAPI(s.openAPI))
 		r.Route("/auth", func(r chi.Router) {
 			r.Get("/steam", handleAuthSteam(s.authSvc))
 			r.Post("/renew", handleAuthRenew(s.authSvc))
@@ -19,14 +21,18 @@ func (s *Server) publicRouter(r chi.Router) {
 			r.Get("/{id}", handleItemDetail(s.itemSvc, s.cache, s.logger))
 		})
 		r.Route("/markets", func(r chi.Router) {
-			r.Get("/", handleMarketList(s.marketSvc, s.trackSvc, s.cache, s.logger))
-			r.Get("/{id}", handleMarketDetail(s.marketSvc, s.cache, s.logger))
+			r.Get("/", handleMarketList(s.marketSvc, s.trackSvc, s.rateSvc, s.cache, s.logger))
+			r.Get("/{id}", handleMarketDetail(s.marketSvc, s.rateSvc, s.cache, s.logger))
+			r.With(s.authorizer).Get("/{id}/history", handleMarketHistory(s.marketSvc))
 		})
-		r.Get("/catalogs_trend", handleMarketCatalogTrendList(s.marketSvc, s.cache, s.logger))
-		r.Get("/catalogs", handleMarketCatalogList(s.marketSvc, s.trackSvc, s.cache, s.logger))
-		r.Get("/catalogs/{slug}", handleMarketCatalogDetail(s.marketSvc, s.cache, s.logger))
+		r.Get("/catalogs_trend", handleMarketCatalogTrendList(s.marketSvc, s.rateSvc, s.cache, s.logger))
+		r.Get("/catalogs", handleMarketCatalogList(s.marketSvc, s.trackSvc, s.rateSvc, s.cache, s.logger))
+		r.Get("/catalogs/{slug}", handleMarketCatalogDetail(s.marketSvc, s.rateSvc, s.cache, s.logger))
+		r.Get("/catalogs/{slug}/history", handleCatalogPriceHistory(s.priceSvc, s.cache))
+		r.Get("/exchange_rates", handleExchangeRates(s.rateSvc))
+		r.Get("/synonyms", handleSynonymList(s.synonymSvc))
 		r.Get("/users/{id}", handlePublicProfile(s.userSvc, s.cache))
-		r.Get("/t", handleTracker(s.trackSvc, s.logger))
+		r.With(s.rateLimiter("tracker", s.RateLimit.Tracker)).Get("/t", handleTracker(s.trackSvc, s.logger))
 		r.Get("/sitemap.xml", handleSitemap(s.itemSvc, s.userSvc, s.cache))
 		r.Get("/stats/market_summary", handleStatsMarketSummary(s.statsSvc, s.cache))
 		r.Get("/stats/top_origins", handleStatsTopOrigins(s.itemSvc, s.cache))
@@ -37,23 +43,65 @@ func (s *Server) publicRouter(r chi.Router) {
 			r.Get("/", handleReportList(s.reportSvc))
 			r.Get("/{id}", handleReportDetail(s.reportSvc))
 		})
-		r.Get("/vanity/{id}", handleVanityProfile(s.userSvc, s.steam, s.cache))
+		r.With(s.rateLimiter("vanity", s.RateLimit.Vanity)).Get("/vanity/{id}", handleVanityProfile(s.userSvc, s.steam, s.cache))
 		r.Get("/blacklists", handleBlacklisted(s.userSvc, s.cache))
+		r.Route("/graphql", func(r chi.Router) {
+			r.Use(s.authenticator)
+			h := handleGraphQL(s.graphqlSchema(), s.graphqlLoaders)
+			r.Get("/", h)
+			r.Post("/", h)
+		})
 	})
 }
 
 func (s *Server) privateRouter(r chi.Router) {
 	r.Group(func(r chi.Router) {
 		r.Use(s.authorizer)
+		r.Use(s.rateLimiter("private", s.RateLimit.Private))
 		r.Route("/my", func(r chi.Router) {
 			r.Get("/profile", handleProfile(s.userSvc, s.cache))
 			r.Post("/process_subscription", handleProcSubscription(s.userSvc, s.cache))
 			r.Route("/markets", func(r chi.Router) {
-				r.Get("/", handleMarketList(s.marketSvc, s.trackSvc, s.cache, s.logger))
+				r.Get("/", handleMarketList(s.marketSvc, s.trackSvc, s.rateSvc, s.cache, s.logger))
 				r.Post("/", handleMarketCreate(s.marketSvc, s.cache))
-				r.Get("/{id}", handleMarketDetail(s.marketSvc, s.cache, s.logger))
+				r.Get("/{id}", handleMarketDetail(s.marketSvc, s.rateSvc, s.cache, s.logger))
 				r.Patch("/{id}", handleMarketUpdate(s.marketSvc, s.cache))
 			})
+			r.Route("/matches", func(r chi.Router) {
+				r.Get("/", handleMarketMatchList(s.matchSvc))
+				r.Post("/{id}/confirm", handleMarketMatchConfirm(s.matchSvc, s.cache))
+				r.Post("/{id}/decline", handleMarketMatchDecline(s.matchSvc, s.cache))
+			})
+			r.Route("/offers", func(r chi.Router) {
+				r.Get("/", handleOfferList(s.offerSvc))
+				r.Post("/", handleOfferCreate(s.offerSvc))
+				r.Post("/{id}/accept", handleOfferAccept(s.offerSvc, s.cache))
+				r.Post("/{id}/reject", handleOfferReject(s.offerSvc))
+				r.Post("/{id}/counter", handleOfferCounter(s.offerSvc))
+			})
+			r.Route("/webhooks", func(r chi.Router) {
+				r.Get("/", handleWebhookList(s.webhookSvc))
+				r.Post("/", handleWebhookCreate(s.webhookSvc))
+				r.Get("/{id}", handleWebhookDetail(s.webhookSvc))
+				r.Patch("/{id}", handleWebhookUpdate(s.webhookSvc))
+				r.Delete("/{id}", handleWebhookDelete(s.webhookSvc))
+				r.Get("/{id}/deliveries", handleWebhookDeliveries(s.webhookSvc))
+			})
+			r.Get("/notifications", handleNotificationSetting(s.notifySvc))
+			r.Put("/notifications", handleNotificationSettingUpdate(s.notifySvc))
+			r.Post("/notifications/confirm-email", handleNotificationEmailConfirm(s.notifySvc))
+			r.Route("/tokens", func(r chi.Router) {
+				r.Get("/", handleAccessTokenList(s.tokenSvc))
+				r.Post("/", handleAccessTokenCreate(s.tokenSvc))
+				r.Delete("/{id}", handleAccessTokenRevoke(s.tokenSvc))
+			})
+			r.Route("/watchlist", func(r chi.Router) {
+				r.Get("/", handleWatchlist(s.watchSvc))
+				r.Post("/", handleWatchlistCreate(s.watchSvc))
+				r.Get("/alerts", handleWatchlistAlerts(s.watchSvc))
+				r.Patch("/{id}", handleWatchlistUpdate(s.watchSvc))
+				r.Delete("/{id}", handleWatchlistDelete(s.watchSvc))
+			})
 		})
 		r.Post("/items", handleItemCreate(s.itemSvc, s.cache))
 		r.Post("/items_import", handleItemImport(s.itemSvc, s.cache))
@@ -62,5 +110,16 @@ func (s *Server) privateRouter(r chi.Router) {
 		r.Post("/hammer/ban", handleHammerBan(s.hammerSvc, s.cache))
 		r.Post("/hammer/suspend", handleHammerSuspend(s.hammerSvc, s.cache))
 		r.Post("/hammer/lift", handleHammerLift(s.hammerSvc, s.cache))
+		r.Get("/hammer/catalog_index", handleHammerCatalogIndexStats(s.indexSvc))
+		r.Put("/exchange_rates", handleExchangeRatesUpdate(s.rateSvc, s.cache))
+		r.Post("/synonyms", handleSynonymCreate(s.synonymSvc, s.cache))
+		r.Put("/synonyms/{id}", handleSynonymUpdate(s.synonymSvc, s.cache))
+		r.Delete("/synonyms/{id}", handleSynonymDelete(s.synonymSvc, s.cache))
+		r.Route("/roles", func(r chi.Router) {
+			r.Get("/", handleRoleStaff(s.roleSvc))
+			r.Get("/audits", handleRoleAudits(s.roleSvc))
+			r.Post("/grant", handleRoleGrant(s.roleSvc))
+			r.Post("/revoke", handleRoleRevoke(s.roleSvc))
+		})
 	})
 }
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"time"
 
 	"github.com/go-chi/chi"
@@ -27,6 +28,7 @@ func NewServer(
 	sigKey string,
 	us core.UserService,
 	au core.AuthService,
+	ats core.AccessTokenService,
 	is core.ImageService,
 	its core.ItemService,
 	ms core.MarketService,
@@ -34,57 +36,105 @@ func NewServer(
 	ss core.StatsService,
 	rs core.ReportService,
 	hs core.HammerService,
+	rls core.RoleService,
+	ws core.WebhookService,
+	ns core.NotificationService,
+	wls core.WatchlistService,
+	xs core.MarketMatchService,
+	os core.OfferService,
+	cr core.CurrencyService,
+	ps core.PriceHistoryService,
+	cis core.CatalogIndexService,
+	syn core.SynonymService,
+	ds core.DeliveryService,
+	ivs core.InventoryService,
 	sc core.SteamClient,
 	c core.Cache,
+	rl core.RateLimiter,
 	v *version.Version,
 	l *logrus.Logger,
 ) *Server {
 	jwt.SigKey = sigKey
 	return &Server{
-		userSvc:   us,
-		authSvc:   au,
-		imageSvc:  is,
-		itemSvc:   its,
-		marketSvc: ms,
-		trackSvc:  ts,
-		statsSvc:  ss,
-		reportSvc: rs,
-		hammerSvc: hs,
-		steam:     sc,
-		cache:     c,
-		logger:    l,
-		version:   v,
+		userSvc:      us,
+		authSvc:      au,
+		tokenSvc:     ats,
+		imageSvc:     is,
+		itemSvc:      its,
+		marketSvc:    ms,
+		trackSvc:     ts,
+		statsSvc:     ss,
+		reportSvc:    rs,
+		hammerSvc:    hs,
+		roleSvc:      rls,
+		webhookSvc:   ws,
+		notifySvc:    ns,
+		watchSvc:     wls,
+		matchSvc:     xs,
+		offerSvc:     os,
+		rateSvc:      cr,
+		priceSvc:     ps,
+		indexSvc:     cis,
+		synonymSvc:   syn,
+		deliverySvc:  ds,
+		inventorySvc: ivs,
+		steam:        sc,
+		cache:        c,
+		limiter:      rl,
+		logger:       l,
+		version:      v,
 	}
 }
 
 // Server represents http Server.
 type Server struct {
 	// Server settings.
-	Addr    string
-	handler http.Handler
+	Addr      string
+	RateLimit RateLimitConfig
+	handler   http.Handler
+	router    chi.Routes
+	// OpenAPI document generated from router on first request.
+	openAPIOnce sync.Once
+	openAPIDoc  string
+	openAPIErr  error
 	// Service resources.
-	userSvc   core.UserService
-	authSvc   core.AuthService
-	imageSvc  core.ImageService
-	itemSvc   core.ItemService
-	marketSvc core.MarketService
-	trackSvc  core.TrackService
-	statsSvc  core.StatsService
-	reportSvc core.ReportService
-	hammerSvc core.HammerService
-	steam     core.SteamClient
+	userSvc      core.UserService
+	authSvc      core.AuthService
+	tokenSvc     core.AccessTokenService
+	imageSvc     core.ImageService
+	itemSvc      core.ItemService
+	marketSvc    core.MarketService
+	trackSvc     core.TrackService
+	statsSvc     core.StatsService
+	reportSvc    core.ReportService
+	hammerSvc    core.HammerService
+	roleSvc      core.RoleService
+	webhookSvc   core.WebhookService
+	notifySvc    core.NotificationService
+	watchSvc     core.WatchlistService
+	matchSvc     core.MarketMatchService
+	offerSvc     core.OfferService
+	rateSvc      core.CurrencyService
+	priceSvc     core.PriceHistoryService
+	indexSvc     core.CatalogIndexService
+	synonymSvc   core.SynonymService
+	deliverySvc  core.DeliveryService
+	inventorySvc core.InventoryService
+	steam        core.SteamClient
 
 	cache   core.Cache
+	limiter core.RateLimiter
 	logger  *logrus.Logger
 	version *version.Version
 }
 
 func (s *Server) setup() {
-	r := chi.NewRouter()
+	// Routes are recorded for OpenAPI document.
+	r := newRouteRecorder(chi.NewRouter())
 
 	// A good base middleware stack
 	r.Use(middleware.RequestID)
-	r.Use(middleware.RealIP)
+	r.Use(s.realIP)
 	r.Use(NewStructuredLogger(s.logger))
 	r.Use(gokitMw.CORS)
 	r.Use(middleware.Recoverer)
@@ -101,9 +151,29 @@ func (s *Server) setup() {
 		s.Addr = defaultAddr
 	}
 
+	s.router = r
 	s.handler = r
 }
 
+// openAPI returns OpenAPI document of registered routes.
+func (s *Server) openAPI() (string, error) {
+	s.openAPIOnce.Do(func() {
+		var v string
+		if s.version != nil {
+			v = s.version.Tag
+		}
+
+		doc, err := newOpenAPIDoc(s.router, v, s.authorizer, s.authenticator)
+		if err != nil {
+			s.openAPIErr = err
+			return
+		}
+		s.openAPIDoc, s.openAPIErr = openAPIJSON(doc)
+	})
+
+	return s.openAPIDoc, s.openAPIErr
+}
+
 func (s *Server) Run() error {
 	s.setup()
 
@@ -0,0 +1,52 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/kudarap/dotagiftx/core"
+)
+
+func handleAccessTokenList(svc core.AccessTokenService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		list, err := svc.AccessTokens(r.Context())
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+		if list == nil {
+			list = []core.AccessToken{}
+		}
+
+		respondOK(w, list)
+	}
+}
+
+func handleAccessTokenCreate(svc core.AccessTokenService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		t := new(core.AccessToken)
+		if err := parseForm(r, t); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		if err := svc.Create(r.Context(), t); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, t)
+	}
+}
+
+func handleAccessTokenRevoke(svc core.AccessTokenService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		t, err := svc.Revoke(r.Context(), chi.URLParam(r, "id"))
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, t)
+	}
+}
@@ -18,6 +18,10 @@ type authResp struct {
 	ExpiresAt    time.Time `json:"expires_at,omitempty"`
 }
 
+type refreshTokenForm struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
 func handleAuthSteam(svc core.AuthService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Handle steam auth.
@@ -45,9 +49,7 @@ func handleAuthSteam(svc core.AuthService) http.HandlerFunc {
 
 func handleAuthRenew(svc core.AuthService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		form := new(struct {
-			RefreshToken string `json:"refresh_token"`
-		})
+		form := new(refreshTokenForm)
 		if err := parseForm(r, form); err != nil {
 			respondError(w, err)
 			return
@@ -72,9 +74,7 @@ func handleAuthRenew(svc core.AuthService) http.HandlerFunc {
 
 func handleAuthRevoke(svc core.AuthService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		form := new(struct {
-			RefreshToken string `json:"refresh_token"`
-		})
+		form := new(refreshTokenForm)
 		if err := parseForm(r, form); err != nil {
 			respondError(w, err)
 			return
@@ -85,11 +85,7 @@ func handleAuthRevoke(svc core.AuthService) http.HandlerFunc {
 			return
 		}
 
-		respondOK(w, struct {
-			Msg string `json:"msg"`
-		}{
-			"refresh token successfully revoked",
-		})
+		respondOK(w, newMsg("refresh token successfully revoked"))
 	}
 }
 
@@ -105,13 +101,11 @@ func newAuth(au *core.Auth) (*authResp, error) {
 	return a, nil
 }
 
-const noLevel = ""
-
 func refreshJWT(au *core.Auth) (*authResp, error) {
 	a := &authResp{}
 	a.ExpiresAt = time.Now().Add(defaultTokenExpiration)
 
-	t, err := jwt.New(au.UserID, noLevel, a.ExpiresAt)
+	t, err := jwt.New(au.UserID, core.RolesToLevel(au.Roles), a.ExpiresAt)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package http
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/go-chi/chi"
@@ -18,6 +19,7 @@ const (
 func handleMarketCatalogList(
 	svc core.MarketService,
 	trackSvc core.TrackService,
+	rateSvc core.CurrencyService,
 	cache core.Cache,
 	logger *logrus.Logger,
 ) http.HandlerFunc {
@@ -73,6 +75,14 @@ func handleMarketCatalogList(
 		if list == nil {
 			list = []core.Catalog{}
 		}
+		if err = convertCatalogs(rateSvc, queryCurrency(r), list); err != nil {
+			respondError(w, err)
+			return
+		}
+		if err = convertCatalogFacets(rateSvc, queryCurrency(r), md.Facets); err != nil {
+			respondError(w, err)
+			return
+		}
 
 		// Save result to cache.
 		data := newDataWithMeta(list, md)
@@ -86,7 +96,12 @@ func handleMarketCatalogList(
 	}
 }
 
-func handleMarketCatalogDetail(svc core.MarketService, cache core.Cache, logger *logrus.Logger) http.HandlerFunc {
+func handleMarketCatalogDetail(
+	svc core.MarketService,
+	rateSvc core.CurrencyService,
+	cache core.Cache,
+	logger *logrus.Logger,
+) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Check for cache hit and render them.
 		cacheKey, noCache := core.CacheKeyFromRequestWithPrefix(r, marketCacheKeyPrefix)
@@ -111,6 +126,12 @@ func handleMarketCatalogDetail(svc core.MarketService, cache core.Cache, logger
 			respondError(w, err)
 			return
 		}
+		cc := []core.Catalog{*c}
+		if err = convertCatalogs(rateSvc, queryCurrency(r), cc); err != nil {
+			respondError(w, err)
+			return
+		}
+		c = &cc[0]
 
 		go func() {
 			if err := cache.Set(cacheKey, c, marketCacheExpr); err != nil {
@@ -137,14 +158,19 @@ func rehydrateCatalogTrend(cacheKey string, svc core.MarketService, cache core.C
 
 	logger.Infoln("REHYDRATING...")
 	l, _, _ := svc.TrendingCatalog(core.FindOpts{})
-	d := newDataWithMeta(l, &core.FindMetadata{len(l), 10})
+	d := newDataWithMeta(l, &core.FindMetadata{ResultCount: len(l), TotalCount: 10})
 	if err := cache.Set(cacheKey, d, catalogTrendCacheExpr); err != nil {
 		logger.Errorf("could not save cache on catalog trend list: %s", err)
 	}
 	logger.Infoln("REHYDRATED", d.ResultCount)
 }
 
-func handleMarketCatalogTrendList(svc core.MarketService, cache core.Cache, logger *logrus.Logger) http.HandlerFunc {
+func handleMarketCatalogTrendList(
+	svc core.MarketService,
+	rateSvc core.CurrencyService,
+	cache core.Cache,
+	logger *logrus.Logger,
+) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var noCache bool
 		opts, err := findOptsFromURL(r.URL, &core.Catalog{})
@@ -152,12 +178,16 @@ func handleMarketCatalogTrendList(svc core.MarketService, cache core.Cache, logg
 			respondError(w, err)
 			return
 		}
+		currency := queryCurrency(r)
 
 		// Check for cache hit and render them.
 		cacheKey, noCache := core.CacheKeyFromRequest(r)
 		if !noCache {
-			// HOTFIXED! rehydrate before cache expiration.
-			go rehydrateCatalogTrend(cacheKey, svc, cache, logger)
+			// HOTFIXED! rehydrate before cache expiration, converted prices
+			// are left to expire instead.
+			if currency == "" {
+				go rehydrateCatalogTrend(cacheKey, svc, cache, logger)
+			}
 
 			if hit, _ := cache.Get(cacheKey); hit != "" {
 				respondOK(w, hit)
@@ -173,6 +203,10 @@ func handleMarketCatalogTrendList(svc core.MarketService, cache core.Cache, logg
 		if list == nil {
 			list = []core.Catalog{}
 		}
+		if err = convertCatalogs(rateSvc, currency, list); err != nil {
+			respondError(w, err)
+			return
+		}
 
 		// Save result to cache.
 		data := newDataWithMeta(list, md)
@@ -186,3 +220,29 @@ func handleMarketCatalogTrendList(svc core.MarketService, cache core.Cache, logg
 		respondOK(w, data)
 	}
 }
+
+const priceHistoryCacheExpr = time.Hour
+
+func handleCatalogPriceHistory(svc core.PriceHistoryService, cache core.Cache) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Check for cache hit and render them.
+		cacheKey, noCache := core.CacheKeyFromRequestWithPrefix(r, marketCacheKeyPrefix)
+		if !noCache {
+			if hit, _ := cache.Get(cacheKey); hit != "" {
+				respondOK(w, hit)
+				return
+			}
+		}
+
+		query := r.URL.Query()
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		ph, err := svc.PriceHistory(r.Context(), chi.URLParam(r, "slug"), query.Get("interval"), limit)
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+
+		go cache.Set(cacheKey, ph, priceHistoryCacheExpr)
+		respondOK(w, ph)
+	}
+}
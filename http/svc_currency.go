@@ -0,0 +1,101 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+// currencyQueryField query param of currency that prices are converted to.
+const currencyQueryField = "currency"
+
+func handleExchangeRates(svc core.CurrencyService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		list, err := svc.ExchangeRates(r.Context())
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+		if list == nil {
+			list = []core.ExchangeRate{}
+		}
+
+		respondOK(w, list)
+	}
+}
+
+func handleExchangeRatesUpdate(svc core.CurrencyService, cache core.Cache) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var rates []core.ExchangeRate
+		if err := parseForm(r, &rates); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		if err := svc.UpdateExchangeRates(r.Context(), rates); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		go cache.BulkDel(marketCacheKeyPrefix)
+
+		respondOK(w, newMsg("exchange rates updated"))
+	}
+}
+
+// queryCurrency returns requested currency from url query.
+func queryCurrency(r *http.Request) string {
+	return strings.ToUpper(strings.TrimSpace(r.URL.Query().Get(currencyQueryField)))
+}
+
+// convertMarkets converts market prices to requested currency.
+func convertMarkets(svc core.CurrencyService, currency string, list []core.Market) error {
+	if currency == "" {
+		return nil
+	}
+
+	rates, err := svc.Rates()
+	if err != nil {
+		return err
+	}
+	for i := range list {
+		if err = list[i].ConvertPrice(rates, currency); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// convertCatalogs converts catalog market summary prices to requested currency.
+func convertCatalogs(svc core.CurrencyService, currency string, list []core.Catalog) error {
+	if currency == "" {
+		return nil
+	}
+
+	rates, err := svc.Rates()
+	if err != nil {
+		return err
+	}
+	for i := range list {
+		if err = list[i].ConvertPrice(rates, currency); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func convertCatalogFacets(svc core.CurrencyService, currency string, f *core.CatalogFacets) error {
+	if currency == "" || f == nil {
+		return nil
+	}
+
+	rates, err := svc.Rates()
+	if err != nil {
+		return err
+	}
+
+	return f.ConvertPrice(rates, currency)
+}
@@ -0,0 +1,632 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/kudarap/dotagiftx/core"
+)
+
+const (
+	graphqlMaxLimit = 100
+	// graphqlMaxDepth limits nested selections since every nested list
+	// multiplies the resolved entries, e.g. markets of users of markets.
+	graphqlMaxDepth = 7
+)
+
+type graphqlRequest struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+func handleGraphQL(schema graphql.Schema, loaders func() *graphqlLoaders) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := graphqlRequest{Query: r.URL.Query().Get("query")}
+		if r.Method == http.MethodPost {
+			if err := parseForm(r, &req); err != nil {
+				respondError(w, err)
+				return
+			}
+		}
+
+		if depth := graphqlQueryDepth(req.Query); depth > graphqlMaxDepth {
+			respondOK(w, &graphql.Result{Errors: []gqlerrors.FormattedError{
+				gqlerrors.NewFormattedError(fmt.Sprintf("query depth %d exceeds max depth %d", depth, graphqlMaxDepth)),
+			}})
+			return
+		}
+
+		res := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  req.Query,
+			VariableValues: req.Variables,
+			OperationName:  req.OperationName,
+			Context:        graphqlLoadersToContext(r.Context(), loaders()),
+		})
+		respondOK(w, res)
+	}
+}
+
+// graphqlResolver resolves graphql schema through core services.
+type graphqlResolver struct {
+	userSvc   core.UserService
+	itemSvc   core.ItemService
+	marketSvc core.MarketService
+	statsSvc  core.StatsService
+	priceSvc  core.PriceHistoryService
+}
+
+func (s *Server) graphqlSchema() graphql.Schema {
+	gr := &graphqlResolver{s.userSvc, s.itemSvc, s.marketSvc, s.statsSvc, s.priceSvc}
+	schema, err := gr.schema()
+	if err != nil {
+		panic("could not create graphql schema: " + err.Error())
+	}
+
+	return schema
+}
+
+func (s *Server) graphqlLoaders() *graphqlLoaders {
+	return newGraphQLLoaders(s.userSvc, s.itemSvc, s.deliverySvc, s.inventorySvc)
+}
+
+func (gr *graphqlResolver) schema() (graphql.Schema, error) {
+	assetType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "SteamAsset",
+		Fields: graphql.Fields{
+			"asset_id":      &graphql.Field{Type: graphql.String},
+			"class_id":      &graphql.Field{Type: graphql.String},
+			"name":          &graphql.Field{Type: graphql.String},
+			"image":         &graphql.Field{Type: graphql.String},
+			"hero":          &graphql.Field{Type: graphql.String},
+			"qty":           &graphql.Field{Type: graphql.Int},
+			"gift_from":     &graphql.Field{Type: graphql.String},
+			"date_received": &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	deliveryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Delivery",
+		Fields: graphql.Fields{
+			"id":                 &graphql.Field{Type: graphql.String},
+			"market_id":          &graphql.Field{Type: graphql.String},
+			"status":             uintField(func(d *core.Delivery) uint { return uint(d.Status) }),
+			"buyer_confirmed":    &graphql.Field{Type: graphql.Boolean},
+			"buyer_confirmed_at": &graphql.Field{Type: graphql.DateTime},
+			"gift_opened":        &graphql.Field{Type: graphql.Boolean},
+			"retries":            &graphql.Field{Type: graphql.Int},
+			"steam_assets":       &graphql.Field{Type: graphql.NewList(assetType)},
+			"created_at":         &graphql.Field{Type: graphql.DateTime},
+			"updated_at":         &graphql.Field{Type: graphql.DateTime},
+		},
+	})
+
+	inventoryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Inventory",
+		Fields: graphql.Fields{
+			"id":           &graphql.Field{Type: graphql.String},
+			"market_id":    &graphql.Field{Type: graphql.String},
+			"status":       uintField(func(i *core.Inventory) uint { return uint(i.Status) }),
+			"bundle_count": &graphql.Field{Type: graphql.Int},
+			"retries":      &graphql.Field{Type: graphql.Int},
+			"steam_assets": &graphql.Field{Type: graphql.NewList(assetType)},
+			"created_at":   &graphql.Field{Type: graphql.DateTime},
+			"updated_at":   &graphql.Field{Type: graphql.DateTime},
+		},
+	})
+
+	itemType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Item",
+		Fields: graphql.Fields{
+			"id":         &graphql.Field{Type: graphql.String},
+			"slug":       &graphql.Field{Type: graphql.String},
+			"name":       &graphql.Field{Type: graphql.String},
+			"hero":       &graphql.Field{Type: graphql.String},
+			"image":      &graphql.Field{Type: graphql.String},
+			"origin":     &graphql.Field{Type: graphql.String},
+			"rarity":     &graphql.Field{Type: graphql.String},
+			"active":     &graphql.Field{Type: graphql.Boolean},
+			"view_count": &graphql.Field{Type: graphql.Int},
+			"created_at": &graphql.Field{Type: graphql.DateTime},
+			"updated_at": &graphql.Field{Type: graphql.DateTime},
+		},
+	})
+
+	// User and Market types references each other.
+	var userType, marketType *graphql.Object
+	userType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":         &graphql.Field{Type: graphql.String},
+				"steam_id":   &graphql.Field{Type: graphql.String},
+				"name":       &graphql.Field{Type: graphql.String},
+				"url":        &graphql.Field{Type: graphql.String},
+				"avatar":     &graphql.Field{Type: graphql.String},
+				"status":     uintField(func(u *core.User) uint { return uint(u.Status) }),
+				"donation":   &graphql.Field{Type: graphql.Float},
+				"rank_score": &graphql.Field{Type: graphql.Int},
+				"boons":      &graphql.Field{Type: graphql.NewList(graphql.String)},
+				"created_at": &graphql.Field{Type: graphql.DateTime},
+				"markets": &graphql.Field{
+					Type: graphql.NewList(marketType),
+					Args: marketListArgs(),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						u := p.Source.(*core.User)
+						// Redacted buyers has no id to scope its markets.
+						if u.ID == "" {
+							return []*core.Market{}, nil
+						}
+						opts := marketFindOpts(p.Args)
+						opts.Filter.(*core.Market).UserID = u.ID
+						return gr.markets(p.Context, opts)
+					},
+				},
+			}
+		}),
+	})
+
+	marketType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Market",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":               &graphql.Field{Type: graphql.String},
+				"user_id":          &graphql.Field{Type: graphql.String, Resolve: gr.marketUserID},
+				"item_id":          &graphql.Field{Type: graphql.String},
+				"type":             uintField(func(m *core.Market) uint { return uint(m.Type) }),
+				"status":           uintField(func(m *core.Market) uint { return uint(m.Status) }),
+				"price":            &graphql.Field{Type: graphql.Float},
+				"currency":         &graphql.Field{Type: graphql.String},
+				"list_price":       &graphql.Field{Type: graphql.Float},
+				"list_currency":    &graphql.Field{Type: graphql.String},
+				"notes":            &graphql.Field{Type: graphql.String},
+				"inventory_status": uintField(func(m *core.Market) uint { return uint(m.InventoryStatus) }),
+				"delivery_status":  uintField(func(m *core.Market) uint { return uint(m.DeliveryStatus) }),
+				"user_rank_score":  &graphql.Field{Type: graphql.Int},
+				"created_at":       &graphql.Field{Type: graphql.DateTime},
+				"updated_at":       &graphql.Field{Type: graphql.DateTime},
+				"user": &graphql.Field{
+					Type:    userType,
+					Resolve: gr.marketUser,
+				},
+				"item": &graphql.Field{
+					Type: itemType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						m := p.Source.(*core.Market)
+						if m.Item != nil {
+							return m.Item, nil
+						}
+						return thunk(graphqlLoadersFromContext(p.Context).items.Load(p.Context, m.ItemID)), nil
+					},
+				},
+				"delivery": &graphql.Field{
+					Type: deliveryType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						m := p.Source.(*core.Market)
+						return thunk(graphqlLoadersFromContext(p.Context).deliveries.Load(p.Context, m.ID)), nil
+					},
+				},
+				"inventory": &graphql.Field{
+					Type: inventoryType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						m := p.Source.(*core.Market)
+						return thunk(graphqlLoadersFromContext(p.Context).inventories.Load(p.Context, m.ID)), nil
+					},
+				},
+			}
+		}),
+	})
+
+	salesGraphType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "MarketSalesGraph",
+		Fields: graphql.Fields{
+			"date":  &graphql.Field{Type: graphql.DateTime},
+			"avg":   &graphql.Field{Type: graphql.Float},
+			"count": &graphql.Field{Type: graphql.Int},
+		},
+	})
+
+	candleType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "PriceCandle",
+		Fields: graphql.Fields{
+			"time":   &graphql.Field{Type: graphql.DateTime},
+			"open":   &graphql.Field{Type: graphql.Float},
+			"high":   &graphql.Field{Type: graphql.Float},
+			"low":    &graphql.Field{Type: graphql.Float},
+			"close":  &graphql.Field{Type: graphql.Float},
+			"volume": &graphql.Field{Type: graphql.Int},
+		},
+	})
+
+	priceHistoryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "PriceHistory",
+		Fields: graphql.Fields{
+			"interval": &graphql.Field{Type: graphql.String},
+			"asks":     &graphql.Field{Type: graphql.NewList(candleType)},
+			"bids":     &graphql.Field{Type: graphql.NewList(candleType)},
+			"sales":    &graphql.Field{Type: graphql.NewList(candleType)},
+		},
+	})
+
+	catalogType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Catalog",
+		Fields: graphql.Fields{
+			"id":             &graphql.Field{Type: graphql.String},
+			"slug":           &graphql.Field{Type: graphql.String},
+			"name":           &graphql.Field{Type: graphql.String},
+			"hero":           &graphql.Field{Type: graphql.String},
+			"image":          &graphql.Field{Type: graphql.String},
+			"origin":         &graphql.Field{Type: graphql.String},
+			"rarity":         &graphql.Field{Type: graphql.String},
+			"view_count":     &graphql.Field{Type: graphql.Int},
+			"quantity":       &graphql.Field{Type: graphql.Int},
+			"lowest_ask":     &graphql.Field{Type: graphql.Float},
+			"median_ask":     &graphql.Field{Type: graphql.Float},
+			"recent_ask":     &graphql.Field{Type: graphql.DateTime},
+			"highest_bid":    &graphql.Field{Type: graphql.Float},
+			"recent_bid":     &graphql.Field{Type: graphql.DateTime},
+			"bid_count":      &graphql.Field{Type: graphql.Int},
+			"reserved_count": &graphql.Field{Type: graphql.Int},
+			"sold_count":     &graphql.Field{Type: graphql.Int},
+			"sale_count":     &graphql.Field{Type: graphql.Int},
+			"avg_sale":       &graphql.Field{Type: graphql.Float},
+			"recent_sale":    &graphql.Field{Type: graphql.DateTime},
+			"created_at":     &graphql.Field{Type: graphql.DateTime},
+			"updated_at":     &graphql.Field{Type: graphql.DateTime},
+			"item": &graphql.Field{
+				Type: itemType,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					c := p.Source.(*core.Catalog)
+					return thunk(graphqlLoadersFromContext(p.Context).items.Load(p.Context, c.ID)), nil
+				},
+			},
+			"asks": &graphql.Field{
+				Type:    graphql.NewList(marketType),
+				Args:    pageArgs(),
+				Resolve: gr.catalogMarkets(core.MarketTypeAsk),
+			},
+			"bids": &graphql.Field{
+				Type:    graphql.NewList(marketType),
+				Args:    pageArgs(),
+				Resolve: gr.catalogMarkets(core.MarketTypeBid),
+			},
+			"sales_graph": &graphql.Field{
+				Type: graphql.NewList(salesGraphType),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					c := p.Source.(*core.Catalog)
+					return gr.statsSvc.GraphMarketSales(core.FindOpts{Filter: &core.Market{ItemID: c.ID}})
+				},
+			},
+			"price_history": &graphql.Field{
+				Type: priceHistoryType,
+				Args: graphql.FieldConfigArgument{
+					"interval": &graphql.ArgumentConfig{Type: graphql.String, DefaultValue: core.PriceIntervalDay},
+					"limit":    &graphql.ArgumentConfig{Type: graphql.Int},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					c := p.Source.(*core.Catalog)
+					interval, _ := p.Args["interval"].(string)
+					limit, _ := p.Args["limit"].(int)
+					return gr.priceSvc.PriceHistory(p.Context, c.Slug, interval, limit)
+				},
+			},
+		},
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"catalog": &graphql.Field{
+				Type: catalogType,
+				Args: graphql.FieldConfigArgument{
+					"slug": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					// Catalog asks are resolved on its own field and only needs
+					// the live asks count here.
+					return gr.marketSvc.CatalogDetails(p.Args["slug"].(string), core.FindOpts{
+						Filter:   &core.Market{Type: core.MarketTypeAsk, Status: core.MarketStatusLive},
+						Limit:    1,
+						WithMeta: true,
+					})
+				},
+			},
+			"catalogs": &graphql.Field{
+				Type: graphql.NewList(catalogType),
+				Args: searchArgs(),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					opts := pageFindOpts(p.Args)
+					opts.Filter = &core.Catalog{
+						Hero:   stringArg(p.Args, "hero"),
+						Origin: stringArg(p.Args, "origin"),
+						Rarity: stringArg(p.Args, "rarity"),
+					}
+					res, _, err := gr.marketSvc.Catalog(opts)
+					return toPointers(res), err
+				},
+			},
+			"item": &graphql.Field{
+				Type: itemType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return gr.itemSvc.Item(p.Args["id"].(string))
+				},
+			},
+			"items": &graphql.Field{
+				Type: graphql.NewList(itemType),
+				Args: searchArgs(),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					opts := pageFindOpts(p.Args)
+					opts.Filter = &core.Item{
+						Hero:   stringArg(p.Args, "hero"),
+						Origin: stringArg(p.Args, "origin"),
+						Rarity: stringArg(p.Args, "rarity"),
+					}
+					res, _, err := gr.itemSvc.Items(opts)
+					return toPointers(res), err
+				},
+			},
+			"market": &graphql.Field{
+				Type: marketType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return gr.marketSvc.Market(core.AuthToContext(p.Context, nil), p.Args["id"].(string))
+				},
+			},
+			"markets": &graphql.Field{
+				Type: graphql.NewList(marketType),
+				Args: marketListArgs(),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return gr.markets(p.Context, marketFindOpts(p.Args))
+				},
+			},
+			"user": &graphql.Field{
+				Type: userType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return gr.userSvc.User(p.Args["id"].(string))
+				},
+			},
+			"me": &graphql.Field{
+				Type: userType,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return gr.userSvc.UserFromContext(p.Context)
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+// graphqlQueryDepth returns the deepest field selection of the query
+// including fragments, introspection fields are not counted since they are
+// bounded by the schema. Invalid queries returns zero and left to be
+// reported by the executor.
+func graphqlQueryDepth(query string) int {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return 0
+	}
+
+	frags := map[string]*ast.FragmentDefinition{}
+	for _, d := range doc.Definitions {
+		if f, ok := d.(*ast.FragmentDefinition); ok {
+			frags[f.Name.Value] = f
+		}
+	}
+
+	// Fragment depths are memoized since spreads can be repeated, cycles
+	// are invalid and skipped.
+	memo := map[string]int{}
+	var depth func(ss *ast.SelectionSet) int
+	depth = func(ss *ast.SelectionSet) int {
+		if ss == nil {
+			return 0
+		}
+
+		max := 0
+		for _, sel := range ss.Selections {
+			var n int
+			switch v := sel.(type) {
+			case *ast.Field:
+				if strings.HasPrefix(v.Name.Value, "__") {
+					continue
+				}
+				n = 1 + depth(v.SelectionSet)
+			case *ast.InlineFragment:
+				n = depth(v.SelectionSet)
+			case *ast.FragmentSpread:
+				name := v.Name.Value
+				d, ok := memo[name]
+				if !ok {
+					memo[name] = 0
+					if f := frags[name]; f != nil {
+						d = depth(f.SelectionSet)
+					}
+					memo[name] = d
+				}
+				n = d
+			}
+			if n > max {
+				max = n
+			}
+		}
+		return max
+	}
+
+	max := 0
+	for _, d := range doc.Definitions {
+		if op, ok := d.(*ast.OperationDefinition); ok {
+			if n := depth(op.SelectionSet); n > max {
+				max = n
+			}
+		}
+	}
+	return max
+}
+
+// markets returns public market entries, auth context is left out since it
+// scopes the results to its owner.
+func (gr *graphqlResolver) markets(ctx context.Context, opts core.FindOpts) ([]*core.Market, error) {
+	res, _, err := gr.marketSvc.Markets(core.AuthToContext(ctx, nil), opts)
+	return toPointers(res), err
+}
+
+func (gr *graphqlResolver) catalogMarkets(t core.MarketType) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		c := p.Source.(*core.Catalog)
+		opts := pageFindOpts(p.Args)
+		opts.Filter = &core.Market{ItemID: c.ID, Type: t, Status: core.MarketStatusLive}
+		return gr.markets(p.Context, opts)
+	}
+}
+
+// marketUser resolves market owner and redacts buyer details from public
+// requests the same way as market listing.
+func (gr *graphqlResolver) marketUser(p graphql.ResolveParams) (interface{}, error) {
+	m := p.Source.(*core.Market)
+	redact := redactMarketUser(p.Context, m)
+	if m.User != nil {
+		if redact {
+			return redactUser(m.User), nil
+		}
+		return m.User, nil
+	}
+
+	load := graphqlLoadersFromContext(p.Context).users.Load(p.Context, m.UserID)
+	return func() (interface{}, error) {
+		u, err := load()
+		if err != nil || u == nil {
+			return nil, err
+		}
+		if redact {
+			return redactUser(u), nil
+		}
+		return u, nil
+	}, nil
+}
+
+// marketUserID resolves market owner id that is left out the same way as
+// market owner details.
+func (gr *graphqlResolver) marketUserID(p graphql.ResolveParams) (interface{}, error) {
+	m := p.Source.(*core.Market)
+	if redactMarketUser(p.Context, m) {
+		return "", nil
+	}
+	return m.UserID, nil
+}
+
+// redactMarketUser returns true when market owner should be left out, bid
+// buyers are hidden from public requests.
+func redactMarketUser(ctx context.Context, m *core.Market) bool {
+	return m.Type == core.MarketTypeBid && core.AuthFromContext(ctx) == nil
+}
+
+// thunk defers loader result so the rest of the fields gets batched.
+func thunk[V any](load func() (*V, error)) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		v, err := load()
+		if err != nil || v == nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+// uintField resolves typed status and type values as int.
+func uintField[V any](fn func(*V) uint) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.Int,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			v, ok := p.Source.(*V)
+			if !ok {
+				return nil, nil
+			}
+			return int(fn(v)), nil
+		},
+	}
+}
+
+func toPointers[V any](list []V) []*V {
+	res := make([]*V, len(list))
+	for i := range list {
+		res[i] = &list[i]
+	}
+
+	return res
+}
+
+func pageArgs() graphql.FieldConfigArgument {
+	return graphql.FieldConfigArgument{
+		"sort":  &graphql.ArgumentConfig{Type: graphql.String},
+		"page":  &graphql.ArgumentConfig{Type: graphql.Int},
+		"limit": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultPageLimit},
+	}
+}
+
+func searchArgs() graphql.FieldConfigArgument {
+	args := pageArgs()
+	args["q"] = &graphql.ArgumentConfig{Type: graphql.String}
+	args["hero"] = &graphql.ArgumentConfig{Type: graphql.String}
+	args["origin"] = &graphql.ArgumentConfig{Type: graphql.String}
+	args["rarity"] = &graphql.ArgumentConfig{Type: graphql.String}
+	return args
+}
+
+func marketListArgs() graphql.FieldConfigArgument {
+	args := pageArgs()
+	args["q"] = &graphql.ArgumentConfig{Type: graphql.String}
+	args["item_id"] = &graphql.ArgumentConfig{Type: graphql.String}
+	args["user_id"] = &graphql.ArgumentConfig{Type: graphql.String}
+	args["type"] = &graphql.ArgumentConfig{Type: graphql.Int}
+	args["status"] = &graphql.ArgumentConfig{Type: graphql.Int}
+	return args
+}
+
+// pageFindOpts returns find options from page arguments, sort uses the same
+// format as listing query e.g. "price:desc".
+func pageFindOpts(args map[string]interface{}) core.FindOpts {
+	opts := core.FindOpts{Keyword: stringArg(args, "q")}
+	opts.Sort, opts.Desc = parseSort(stringArg(args, "sort"))
+	opts.Page, _ = args["page"].(int)
+	opts.Limit, _ = args["limit"].(int)
+	if opts.Limit <= 0 {
+		opts.Limit = defaultPageLimit
+	} else if opts.Limit > graphqlMaxLimit {
+		opts.Limit = graphqlMaxLimit
+	}
+
+	return opts
+}
+
+func marketFindOpts(args map[string]interface{}) core.FindOpts {
+	opts := pageFindOpts(args)
+	t, _ := args["type"].(int)
+	s, _ := args["status"].(int)
+	opts.Filter = &core.Market{
+		ItemID: stringArg(args, "item_id"),
+		UserID: stringArg(args, "user_id"),
+		Type:   core.MarketType(t),
+		Status: core.MarketStatus(s),
+	}
+	return opts
+}
+
+func stringArg(args map[string]interface{}, name string) string {
+	s, _ := args[name].(string)
+	return s
+}
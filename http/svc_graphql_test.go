@@ -0,0 +1,78 @@
+package http
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/kudarap/dotagiftx/core"
+)
+
+func TestGraphqlQueryDepth(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  int
+	}{
+		{"flat", `{ markets { id price } }`, 2},
+		{"nested", `{ markets { user { markets { item { name } } } } }`, 5},
+		{"fragment", `
+			query { markets { ...m } }
+			fragment m on Market { user { markets { id } } }`, 4},
+		{"inline fragment", `{ me { ... on User { markets { id } } } }`, 3},
+		{"fragment cycle", `
+			query { markets { ...a } }
+			fragment a on Market { user { ...b } }
+			fragment b on User { markets { ...a } }`, 3},
+		{"introspection", `{ __schema { types { fields { type { ofType { ofType { name } } } } } } }`, 0},
+		{"invalid", `{ markets {`, 0},
+	}
+	for _, tc := range tests {
+		if got := graphqlQueryDepth(tc.query); got != tc.want {
+			t.Errorf("%s: graphqlQueryDepth() = %d, want %d", tc.name, got, tc.want)
+		}
+	}
+}
+
+type testMarketService struct {
+	core.MarketService
+	filters []core.Market
+}
+
+func (s *testMarketService) Markets(_ context.Context, opts core.FindOpts) ([]core.Market, *core.FindMetadata, error) {
+	f, _ := opts.Filter.(*core.Market)
+	s.filters = append(s.filters, *f)
+	return []core.Market{{
+		ID:     "m1",
+		UserID: "u1",
+		Type:   core.MarketTypeBid,
+		User:   &core.User{ID: "u1", Name: "buyer"},
+	}}, nil, nil
+}
+
+func TestGraphqlRedactsBidUser(t *testing.T) {
+	ms := &testMarketService{}
+	gr := &graphqlResolver{marketSvc: ms}
+	schema, err := gr.schema()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ markets { user_id user { id name markets { id } } } }`,
+		Context:       context.Background(),
+	})
+	if len(res.Errors) != 0 {
+		t.Fatal(res.Errors)
+	}
+
+	b, _ := json.Marshal(res.Data)
+	want := `{"markets":[{"user_id":"","user":{"id":"","name":"█████","markets":[]}}]}`
+	if string(b) != want {
+		t.Errorf("got %s, want %s", b, want)
+	}
+	if len(ms.filters) != 1 {
+		t.Errorf("redacted user markets should not be queried, got filters %+v", ms.filters)
+	}
+}
@@ -7,6 +7,11 @@ import (
 	"github.com/kudarap/dotagiftx/core"
 )
 
+type hammerLiftForm struct {
+	SteamID         string `json:"steam_id"`
+	RestoreListings bool   `json:"restore_listings"`
+}
+
 func handleHammerBan(svc core.HammerService, cache core.Cache) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var p core.HammerParams
@@ -47,10 +52,7 @@ func handleHammerSuspend(svc core.HammerService, cache core.Cache) http.HandlerF
 
 func handleHammerLift(svc core.HammerService, cache core.Cache) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		p := struct {
-			SteamID         string `json:"steam_id"`
-			RestoreListings bool   `json:"restore_listings"`
-		}{}
+		p := hammerLiftForm{}
 		if err := parseForm(r, &p); err != nil {
 			respondError(w, err)
 			return
@@ -71,3 +73,15 @@ func resetProfileListingCache(steamID string, cache core.Cache) {
 	cache.BulkDel(fmt.Sprintf("users/%s*", steamID))
 	cache.BulkDel(marketCacheKeyPrefix)
 }
+
+func handleHammerCatalogIndexStats(svc core.CatalogIndexService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s, err := svc.Stats(r.Context())
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, s)
+	}
+}
@@ -9,6 +9,10 @@ import (
 	"github.com/kudarap/dotagiftx/core"
 )
 
+type imageUploadResp struct {
+	FileID string `json:"file_id"`
+}
+
 func handleImageUpload(svc core.ImageService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Get uploaded file.
@@ -25,9 +29,7 @@ func handleImageUpload(svc core.ImageService) http.HandlerFunc {
 			return
 		}
 
-		respondOK(w, struct {
-			FileID string `json:"file_id"`
-		}{id})
+		respondOK(w, imageUploadResp{id})
 	}
 }
 
@@ -19,6 +19,7 @@ const (
 func handleMarketList(
 	svc core.MarketService,
 	trackSvc core.TrackService,
+	rateSvc core.CurrencyService,
 	cache core.Cache,
 	logger *logrus.Logger,
 ) http.HandlerFunc {
@@ -63,6 +64,10 @@ func handleMarketList(
 		if list == nil {
 			list = []core.Market{}
 		}
+		if err = convertMarkets(rateSvc, queryCurrency(r), list); err != nil {
+			respondError(w, err)
+			return
+		}
 
 		data := newDataWithMeta(list, md)
 		//go func(d dataWithMeta) {
@@ -99,7 +104,12 @@ func sortQueryModifier(r *http.Request) {
 	r.URL.RawQuery = query.Encode()
 }
 
-func handleMarketDetail(svc core.MarketService, cache core.Cache, logger *logrus.Logger) http.HandlerFunc {
+func handleMarketDetail(
+	svc core.MarketService,
+	rateSvc core.CurrencyService,
+	cache core.Cache,
+	logger *logrus.Logger,
+) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Redact buyer details flag from public requests.
 		shouldRedactUser := !isReqAuthorized(r)
@@ -123,6 +133,12 @@ func handleMarketDetail(svc core.MarketService, cache core.Cache, logger *logrus
 			respondError(w, err)
 			return
 		}
+		mm := []core.Market{*m}
+		if err = convertMarkets(rateSvc, queryCurrency(r), mm); err != nil {
+			respondError(w, err)
+			return
+		}
+		m = &mm[0]
 
 		//go func() {
 		if err := cache.Set(cacheKey, m, marketCacheExpr); err != nil {
@@ -185,6 +201,18 @@ func handleMarketUpdate(svc core.MarketService, cache core.Cache) http.HandlerFu
 	}
 }
 
+func handleMarketHistory(svc core.MarketService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := svc.History(r.Context(), chi.URLParam(r, "id"))
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, res)
+	}
+}
+
 func isReqAuthorized(r *http.Request) bool {
 	c, _ := jwt.ParseFromHeader(r.Header)
 	if c == nil {
@@ -199,20 +227,27 @@ const redactChar = "█"
 func redactBuyers(list []core.Market) []core.Market {
 	rl := make([]core.Market, len(list))
 	copy(rl, list)
-	for _, r := range rl {
-		if r.Type != core.MarketTypeBid {
+	for i, r := range rl {
+		if r.Type != core.MarketTypeBid || r.User == nil {
 			continue
 		}
 
-		r.User.ID = ""
-		r.User.Name = strings.Repeat(redactChar, len(r.User.Name))
-		r.User.SteamID = strings.Repeat(redactChar, len(r.User.SteamID))
-		r.User.URL = strings.Repeat(redactChar, len(r.User.URL))
+		rl[i].User = redactUser(r.User)
 	}
 
 	return rl
 }
 
+// redactUser returns a copy of user with its identity details masked.
+func redactUser(u *core.User) *core.User {
+	ru := *u
+	ru.ID = ""
+	ru.Name = strings.Repeat(redactChar, len(u.Name))
+	ru.SteamID = strings.Repeat(redactChar, len(u.SteamID))
+	ru.URL = strings.Repeat(redactChar, len(u.URL))
+	return &ru
+}
+
 func redactBuyersFromCache(hit string) interface{} {
 	d := struct {
 		Data        []core.Market `json:"data"`
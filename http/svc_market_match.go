@@ -0,0 +1,51 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/kudarap/dotagiftx/core"
+)
+
+func handleMarketMatchList(svc core.MarketMatchService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		list, err := svc.Matches(r.Context())
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+		if list == nil {
+			list = []core.MarketMatch{}
+		}
+
+		respondOK(w, list)
+	}
+}
+
+func handleMarketMatchConfirm(svc core.MarketMatchService, cache core.Cache) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		mm, err := svc.Confirm(r.Context(), chi.URLParam(r, "id"))
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+
+		go cache.BulkDel(marketCacheKeyPrefix)
+
+		respondOK(w, mm)
+	}
+}
+
+func handleMarketMatchDecline(svc core.MarketMatchService, cache core.Cache) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		mm, err := svc.Decline(r.Context(), chi.URLParam(r, "id"))
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+
+		go cache.BulkDel(marketCacheKeyPrefix)
+
+		respondOK(w, mm)
+	}
+}
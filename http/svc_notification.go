@@ -0,0 +1,54 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+func handleNotificationSetting(svc core.NotificationService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ns, err := svc.Setting(r.Context())
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, ns)
+	}
+}
+
+func handleNotificationSettingUpdate(svc core.NotificationService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ns := new(core.NotificationSetting)
+		if err := parseForm(r, ns); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		if err := svc.UpdateSetting(r.Context(), ns); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, ns)
+	}
+}
+
+func handleNotificationEmailConfirm(svc core.NotificationService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		form := new(core.NotificationEmailConfirm)
+		if err := parseForm(r, form); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		ns, err := svc.ConfirmEmail(r.Context(), form.Code)
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, ns)
+	}
+}
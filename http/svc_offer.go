@@ -0,0 +1,88 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/kudarap/dotagiftx/core"
+)
+
+func handleOfferList(svc core.OfferService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		list, err := svc.Offers(r.Context())
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+		if list == nil {
+			list = []core.Offer{}
+		}
+
+		respondOK(w, list)
+	}
+}
+
+func handleOfferCreate(svc core.OfferService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		o := new(core.Offer)
+		if err := parseForm(r, o); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		if err := svc.Create(r.Context(), o); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, o)
+	}
+}
+
+func handleOfferAccept(svc core.OfferService, cache core.Cache) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		o, err := svc.Accept(r.Context(), chi.URLParam(r, "id"))
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+
+		go cache.BulkDel(marketCacheKeyPrefix)
+
+		respondOK(w, o)
+	}
+}
+
+func handleOfferReject(svc core.OfferService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		o, err := svc.Reject(r.Context(), chi.URLParam(r, "id"))
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, o)
+	}
+}
+
+type offerCounterForm struct {
+	Price float64 `json:"price"`
+}
+
+func handleOfferCounter(svc core.OfferService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		form := new(offerCounterForm)
+		if err := parseForm(r, form); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		o, err := svc.Counter(r.Context(), chi.URLParam(r, "id"), form.Price)
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, o)
+	}
+}
@@ -0,0 +1,79 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+func handleRoleStaff(svc core.RoleService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		list, err := svc.Staff(r.Context())
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+		if list == nil {
+			list = []core.User{}
+		}
+
+		respondOK(w, list)
+	}
+}
+
+func handleRoleAudits(svc core.RoleService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		opts, err := findOptsFromURL(r.URL, &core.RoleAudit{})
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+
+		list, err := svc.Audits(r.Context(), opts)
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+		if list == nil {
+			list = []core.RoleAudit{}
+		}
+
+		respondOK(w, list)
+	}
+}
+
+func handleRoleGrant(svc core.RoleService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var p core.RoleParams
+		if err := parseForm(r, &p); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		u, err := svc.Grant(r.Context(), p)
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, u)
+	}
+}
+
+func handleRoleRevoke(svc core.RoleService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var p core.RoleParams
+		if err := parseForm(r, &p); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		u, err := svc.Revoke(r.Context(), p)
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, u)
+	}
+}
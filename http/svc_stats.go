@@ -7,6 +7,12 @@ import (
 	"github.com/kudarap/dotagiftx/core"
 )
 
+// marketSummaryResp represents ask status counts with bid status counts.
+type marketSummaryResp struct {
+	*core.MarketStatusCount
+	Bids *core.MarketStatusCount `json:"bids"`
+}
+
 func handleStatsMarketSummary(svc core.StatsService, cache core.Cache) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Check for cache hit and render them.
@@ -56,10 +62,7 @@ func handleStatsMarketSummary(svc core.StatsService, cache core.Cache) http.Hand
 			}
 		}
 
-		res := struct {
-			*core.MarketStatusCount
-			Bids *core.MarketStatusCount `json:"bids"`
-		}{asks, bids}
+		res := marketSummaryResp{asks, bids}
 
 		go cache.Set(cacheKey, res, time.Hour)
 		respondOK(w, res)
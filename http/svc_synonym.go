@@ -0,0 +1,75 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/kudarap/dotagiftx/core"
+)
+
+func handleSynonymList(svc core.SynonymService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		list, err := svc.Synonyms(r.Context())
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+		if list == nil {
+			list = []core.Synonym{}
+		}
+
+		respondOK(w, list)
+	}
+}
+
+func handleSynonymCreate(svc core.SynonymService, cache core.Cache) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		syn := new(core.Synonym)
+		if err := parseForm(r, syn); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		if err := svc.Create(r.Context(), syn); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		go cache.BulkDel(marketCacheKeyPrefix)
+
+		respondOK(w, syn)
+	}
+}
+
+func handleSynonymUpdate(svc core.SynonymService, cache core.Cache) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		syn := new(core.Synonym)
+		if err := parseForm(r, syn); err != nil {
+			respondError(w, err)
+			return
+		}
+		syn.ID = chi.URLParam(r, "id")
+
+		if err := svc.Update(r.Context(), syn); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		go cache.BulkDel(marketCacheKeyPrefix)
+
+		respondOK(w, syn)
+	}
+}
+
+func handleSynonymDelete(svc core.SynonymService, cache core.Cache) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := svc.Delete(r.Context(), chi.URLParam(r, "id")); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		go cache.BulkDel(marketCacheKeyPrefix)
+
+		respondOK(w, newMsg("synonym removed"))
+	}
+}
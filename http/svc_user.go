@@ -58,11 +58,13 @@ func handlePublicProfile(svc core.UserService, cache core.Cache) http.HandlerFun
 	}
 }
 
+type subscriptionForm struct {
+	SubscriptionID string `json:"subscription_id"`
+}
+
 func handleProcSubscription(svc core.UserService, cache core.Cache) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		form := struct {
-			SubscriptionID string `json:"subscription_id"`
-		}{}
+		form := subscriptionForm{}
 		if err := parseForm(r, &form); err != nil {
 			respondError(w, err)
 			return
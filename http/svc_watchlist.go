@@ -0,0 +1,84 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/kudarap/dotagiftx/core"
+)
+
+func handleWatchlist(svc core.WatchlistService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		list, err := svc.Watchlist(r.Context())
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+		if list == nil {
+			list = []core.Watchlist{}
+		}
+
+		respondOK(w, list)
+	}
+}
+
+func handleWatchlistCreate(svc core.WatchlistService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		wl := new(core.Watchlist)
+		if err := parseForm(r, wl); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		if err := svc.Create(r.Context(), wl); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, wl)
+	}
+}
+
+func handleWatchlistUpdate(svc core.WatchlistService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		wl := new(core.Watchlist)
+		if err := parseForm(r, wl); err != nil {
+			respondError(w, err)
+			return
+		}
+		wl.ID = chi.URLParam(r, "id")
+
+		if err := svc.Update(r.Context(), wl); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, wl)
+	}
+}
+
+func handleWatchlistDelete(svc core.WatchlistService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := svc.Delete(r.Context(), chi.URLParam(r, "id")); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, newMsg("watchlist item removed"))
+	}
+}
+
+func handleWatchlistAlerts(svc core.WatchlistService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		list, err := svc.Alerts(r.Context())
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+		if list == nil {
+			list = []core.WatchlistAlert{}
+		}
+
+		respondOK(w, list)
+	}
+}
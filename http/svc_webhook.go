@@ -0,0 +1,96 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/kudarap/dotagiftx/core"
+)
+
+func handleWebhookList(svc core.WebhookService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		list, err := svc.Webhooks(r.Context())
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+		if list == nil {
+			list = []core.Webhook{}
+		}
+
+		respondOK(w, list)
+	}
+}
+
+func handleWebhookDetail(svc core.WebhookService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		wh, err := svc.Webhook(r.Context(), chi.URLParam(r, "id"))
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, wh)
+	}
+}
+
+func handleWebhookCreate(svc core.WebhookService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		wh := new(core.Webhook)
+		if err := parseForm(r, wh); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		if err := svc.Create(r.Context(), wh); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, wh)
+	}
+}
+
+func handleWebhookUpdate(svc core.WebhookService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		wh := new(core.Webhook)
+		if err := parseForm(r, wh); err != nil {
+			respondError(w, err)
+			return
+		}
+		wh.ID = chi.URLParam(r, "id")
+
+		if err := svc.Update(r.Context(), wh); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, wh)
+	}
+}
+
+func handleWebhookDelete(svc core.WebhookService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := svc.Delete(r.Context(), chi.URLParam(r, "id")); err != nil {
+			respondError(w, err)
+			return
+		}
+
+		respondOK(w, newMsg("webhook deleted"))
+	}
+}
+
+func handleWebhookDeliveries(svc core.WebhookService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		list, err := svc.Deliveries(r.Context(), chi.URLParam(r, "id"))
+		if err != nil {
+			respondError(w, err)
+			return
+		}
+		if list == nil {
+			list = []core.WebhookDelivery{}
+		}
+
+		respondOK(w, list)
+	}
+}
@@ -0,0 +1,139 @@
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/gokit/http/safeclient"
+	"github.com/kudarap/dotagiftx/gokit/log"
+)
+
+const (
+	webhookJobInterval  = time.Second * 30
+	webhookBatchLimit   = 50
+	webhookRequestLimit = time.Second * 10
+)
+
+// Delivery errors visible to webhook owner, raw request errors are only logged
+// since they could reveal internal network details.
+var (
+	errWebhookBlocked  = errors.New("webhook url resolves to a non-public address")
+	errWebhookTimeout  = errors.New("webhook request timed out")
+	errWebhookRequest  = errors.New("could not connect to webhook url")
+	errWebhookInternal = errors.New("could not prepare webhook request")
+)
+
+// DeliverWebhook represents a job that sends pending webhook deliveries
+// and reschedules failed ones with backoff.
+type DeliverWebhook struct {
+	webhookStg  core.WebhookStorage
+	deliveryStg core.WebhookDeliveryStorage
+	client      *http.Client
+	logger      log.Logger
+	// job settings
+	name     string
+	interval time.Duration
+}
+
+func NewDeliverWebhook(ws core.WebhookStorage, ds core.WebhookDeliveryStorage, lg log.Logger) *DeliverWebhook {
+	return &DeliverWebhook{
+		ws, ds, safeclient.New(webhookRequestLimit), lg,
+		"deliver_webhook", webhookJobInterval}
+}
+
+func (dw *DeliverWebhook) String() string { return dw.name }
+
+func (dw *DeliverWebhook) Interval() time.Duration { return dw.interval }
+
+func (dw *DeliverWebhook) Run(ctx context.Context) error {
+	for {
+		res, err := dw.deliveryStg.ToDeliver(webhookBatchLimit)
+		if err != nil {
+			return err
+		}
+
+		for _, d := range res {
+			dw.deliver(ctx, &d)
+			// Stops the batch since un-updated delivery will be picked up again.
+			if err = dw.deliveryStg.Update(&d); err != nil {
+				dw.logger.Errorf("could not update webhook delivery %s: %s", d.ID, err)
+				return err
+			}
+		}
+
+		// Is there more?
+		if len(res) < webhookBatchLimit {
+			return nil
+		}
+	}
+}
+
+// deliver sends delivery payload to webhook URL and records its result.
+func (dw *DeliverWebhook) deliver(ctx context.Context, d *core.WebhookDelivery) {
+	now := time.Now()
+	wh, err := dw.webhookStg.Get(d.WebhookID)
+	if err == core.WebhookErrNotFound || (err == nil && wh.IsDisabled()) {
+		// Removed or disabled webhooks will not receive any retries.
+		d.Status = core.WebhookDeliveryStatusFailed
+		d.Error = "webhook is removed or disabled"
+		return
+	}
+	if err != nil {
+		d.Failed(0, errWebhookInternal, now)
+		dw.logger.Errorf("could not get webhook %s: %s", d.WebhookID, err)
+		return
+	}
+
+	if err = safeclient.CheckURL(wh.URL); err != nil {
+		// Webhooks saved before https was required will not receive any retries.
+		d.Status = core.WebhookDeliveryStatusFailed
+		d.Error = core.WebhookErrInvalidURL.Error()
+		return
+	}
+
+	body := []byte(d.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.URL, bytes.NewReader(body))
+	if err != nil {
+		d.Failed(0, errWebhookInternal, now)
+		dw.logger.Warnf("could not create webhook %s request: %s", d.ID, err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(core.WebhookHeaderEvent, d.Event)
+	req.Header.Set(core.WebhookHeaderDelivery, d.ID)
+	req.Header.Set(core.WebhookHeaderTimestamp, fmt.Sprint(now.Unix()))
+	req.Header.Set(core.WebhookHeaderSignature, wh.Sign(now, body))
+
+	resp, err := dw.client.Do(req)
+	if err != nil {
+		d.Failed(0, requestError(err), now)
+		dw.logger.Warnf("could not deliver webhook %s: %s", d.ID, err)
+		return
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		d.Failed(resp.StatusCode, fmt.Errorf("unexpected response status %s", resp.Status), now)
+		return
+	}
+
+	d.Delivered(resp.StatusCode)
+}
+
+// requestError returns a generic error of request failure.
+func requestError(err error) error {
+	if safeclient.IsBlocked(err) {
+		return errWebhookBlocked
+	}
+	if os.IsTimeout(err) {
+		return errWebhookTimeout
+	}
+
+	return errWebhookRequest
+}
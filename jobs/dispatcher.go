@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/events"
 	"github.com/kudarap/dotagiftx/gokit/log"
 	"github.com/kudarap/dotagiftx/worker"
 	"github.com/sirupsen/logrus"
@@ -17,8 +18,13 @@ type Dispatcher struct {
 	inventorySvc core.InventoryService
 	deliveryStg  core.DeliveryStorage
 	marketStg    core.MarketStorage
-	catalogStg   core.CatalogStorage
+	historyStg   core.MarketHistoryStorage
+	indexSvc     core.CatalogIndexService
+	webhookStg   core.WebhookStorage
+	whDeliverStg core.WebhookDeliveryStorage
 	cache        core.Cache
+	events       events.Publisher
+	notifySvc    core.NotificationService
 	logSvc       *logrus.Logger
 }
 
@@ -28,8 +34,13 @@ func NewDispatcher(worker *worker.Worker,
 	inventorySvc core.InventoryService,
 	deliveryStg core.DeliveryStorage,
 	marketStg core.MarketStorage,
-	catalogStg core.CatalogStorage,
+	historyStg core.MarketHistoryStorage,
+	indexSvc core.CatalogIndexService,
+	webhookStg core.WebhookStorage,
+	whDeliverStg core.WebhookDeliveryStorage,
 	cache core.Cache,
+	ev events.Publisher,
+	notifySvc core.NotificationService,
 	logSvc *logrus.Logger,
 ) *Dispatcher {
 	return &Dispatcher{
@@ -38,8 +49,13 @@ func NewDispatcher(worker *worker.Worker,
 		inventorySvc,
 		deliveryStg,
 		marketStg,
-		catalogStg,
+		historyStg,
+		indexSvc,
+		webhookStg,
+		whDeliverStg,
 		cache,
+		ev,
+		notifySvc,
 		logSvc,
 	}
 }
@@ -59,12 +75,14 @@ func (d *Dispatcher) RegisterJobs() {
 	d.worker.AddJob(NewVerifyDelivery(
 		d.deliverySvc,
 		d.marketStg,
+		d.notifySvc,
 		log.WithPrefix(d.logSvc, "job_verify_delivery"),
 	))
 	d.worker.AddJob(NewGiftWrappedUpdate(
 		d.deliverySvc,
 		d.deliveryStg,
 		d.marketStg,
+		d.notifySvc,
 		log.WithPrefix(d.logSvc, "job_giftwrapped_update"),
 	))
 	d.worker.AddJob(NewRevalidateDelivery(
@@ -74,12 +92,45 @@ func (d *Dispatcher) RegisterJobs() {
 	))
 	d.worker.AddJob(NewExpiringMarket(
 		d.marketStg,
-		d.catalogStg,
-		d.cache,
+		d.historyStg,
+		d.indexSvc,
+		d.events,
+		d.notifySvc,
 		log.WithPrefix(d.logSvc, "job_expiring_market"),
 	))
 	d.worker.AddJob(NewSweepMarket(
-		d.marketStg, log.WithPrefix(d.logSvc, "job_sweep_market"),
+		d.marketStg, d.historyStg, log.WithPrefix(d.logSvc, "job_sweep_market"),
+	))
+	d.worker.AddJob(NewIndexCatalog(
+		d.indexSvc, d.cache, log.WithPrefix(d.logSvc, "job_index_catalog"),
+	))
+}
+
+// RegisterWebhookJobs add webhook delivery job, this should only be
+// registered on a single process to avoid sending duplicate requests.
+func (d *Dispatcher) RegisterWebhookJobs() {
+	d.worker.AddJob(NewDeliverWebhook(
+		d.webhookStg,
+		d.whDeliverStg,
+		log.WithPrefix(d.logSvc, "job_deliver_webhook"),
+	))
+}
+
+// RegisterMatchJobs add market match expiration job, this should only be
+// registered when matching engine is enabled.
+func (d *Dispatcher) RegisterMatchJobs(matchSvc core.MarketMatchService) {
+	d.worker.AddJob(NewExpiringMatch(
+		matchSvc,
+		log.WithPrefix(d.logSvc, "job_expiring_match"),
+	))
+}
+
+// RegisterOfferJobs add offer expiration job, this should only be registered
+// on a single process to avoid sending duplicate notifications.
+func (d *Dispatcher) RegisterOfferJobs(offerSvc core.OfferService) {
+	d.worker.AddJob(NewExpiringOffer(
+		offerSvc,
+		log.WithPrefix(d.logSvc, "job_expiring_offer"),
 	))
 }
 
@@ -89,7 +140,7 @@ func (d *Dispatcher) RegisterJobs() {
 // Customized existing VerifyDelivery job to make it run once job.
 func (d *Dispatcher) VerifyDelivery(marketID string) {
 	ctxLog := log.WithPrefix(d.logSvc, "dispatch_verify_inventory")
-	job := NewVerifyDelivery(d.deliverySvc, d.marketStg, ctxLog)
+	job := NewVerifyDelivery(d.deliverySvc, d.marketStg, d.notifySvc, ctxLog)
 	job.name = fmt.Sprintf("%s_%s", job.name, marketID)
 	job.interval = 0 // makes the job run-once.
 	job.filter = core.Market{ID: marketID}
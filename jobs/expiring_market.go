@@ -2,9 +2,11 @@ package jobs
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/events"
 	"github.com/kudarap/dotagiftx/gokit/log"
 )
 
@@ -13,19 +15,30 @@ const dayHours = time.Hour * 24
 // ExpiringMarket represents setting expiration of a market entry job.
 type ExpiringMarket struct {
 	marketStg  core.MarketStorage
-	catalogStg core.CatalogStorage
-	cache      core.Cache
+	historyStg core.MarketHistoryStorage
+	indexSvc   core.CatalogIndexService
+	events     events.Publisher
+	notifySvc  core.NotificationService
 	logger     log.Logger
 	// job settings
 	name     string
 	interval time.Duration
 }
 
-func NewExpiringMarket(ms core.MarketStorage, cs core.CatalogStorage, cc core.Cache, lg log.Logger) *ExpiringMarket {
+func NewExpiringMarket(
+	ms core.MarketStorage,
+	hs core.MarketHistoryStorage,
+	cis core.CatalogIndexService,
+	ev events.Publisher,
+	ns core.NotificationService,
+	lg log.Logger,
+) *ExpiringMarket {
 	return &ExpiringMarket{
 		marketStg:  ms,
-		catalogStg: cs,
-		cache:      cc,
+		historyStg: hs,
+		indexSvc:   cis,
+		events:     ev,
+		notifySvc:  ns,
 		logger:     lg,
 		name:       "expiring_market",
 		interval:   defaultJobInterval,
@@ -37,58 +50,91 @@ func (em *ExpiringMarket) String() string { return em.name }
 func (em *ExpiringMarket) Interval() time.Duration { return em.interval }
 
 func (em *ExpiringMarket) Run(ctx context.Context) error {
-	var itemIDs []string
+	var expired []core.Market
 	now := time.Now()
 
+	// Live entries of both market types should be allowed to expire.
+	for _, t := range []core.MarketType{core.MarketTypeBid, core.MarketTypeAsk} {
+		m := core.Market{Type: t, Status: core.MarketStatusLive}
+		if err := m.CheckStatusTransition(core.MarketStatusExpired); err != nil {
+			em.logger.Errorf("could not expire market type %d: %s", t, err)
+			return err
+		}
+	}
+
 	// Process expiring bids.
 	bidExpr := now.Add(-dayHours * core.MarketBidExpirationDays)
 	em.logger.Println("updating expiring bids", bidExpr)
-	ids, err := em.marketStg.UpdateExpiring(core.MarketTypeBid, core.BoonRefresherShard, bidExpr)
+	res, err := em.marketStg.UpdateExpiring(core.MarketTypeBid, core.BoonRefresherShard, bidExpr)
 	if err != nil {
 		em.logger.Errorf("could not update expiring bids: %s", err)
 		return err
 	}
-	itemIDs = append(itemIDs, ids...)
+	expired = append(expired, res...)
 	em.logger.Println("updating expiring bids finished!")
 
 	// Process expiring asks.
 	askExpr := now.Add(-dayHours * core.MarketAskExpirationDays)
 	em.logger.Println("updating expiring asks", askExpr)
-	ids, err = em.marketStg.UpdateExpiring(core.MarketTypeAsk, core.BoonRefresherOrb, askExpr)
+	res, err = em.marketStg.UpdateExpiring(core.MarketTypeAsk, core.BoonRefresherOrb, askExpr)
 	if err != nil {
 		em.logger.Errorf("could not update expiring asks: %s", err)
 		return err
 	}
-	itemIDs = append(itemIDs, ids...)
+	expired = append(expired, res...)
 	em.logger.Println("updating expiring asks finished!")
 
-	// Re-index affected items.
-	em.logger.Println("indexing affected expire items...", len(itemIDs))
-	itemIndexed := map[string]struct{}{}
-	for _, id := range itemIDs {
-		if _, hit := itemIndexed[id]; hit {
-			continue
-		}
-		itemIndexed[id] = struct{}{}
-
-		if _, err = em.catalogStg.Index(id); err != nil {
-			em.logger.Errorf("could not index expired item: %s", err)
-			continue
+	// Record status transitions of expired markets.
+	em.logger.Println("recording expired market history...", len(expired))
+	var hh []core.MarketHistory
+	for _, m := range expired {
+		hh = append(hh, core.NewMarketHistory(m, core.MarketStatusLive, "", core.MarketHistorySourceExpiring))
+	}
+	if err = em.historyStg.Create(hh...); err != nil {
+		em.logger.Errorf("could not record expired market history: %s", err)
+	}
+	for _, m := range expired {
+		e := events.MarketStatusChanged{Market: m, PrevStatus: core.MarketStatusLive}
+		if err = em.events.Publish(ctx, e); err != nil {
+			em.logger.Errorf("could not publish expired market %s: %s", m.ID, err)
 		}
 	}
-	em.logger.Println("affected items indexed!", len(itemIndexed))
 
-	// Invalidate market caches.
-	em.logger.Println("invalidating market cache...")
-	if err = em.cache.BulkDel("catalogs_trend"); err != nil {
-		em.logger.Errorf("could not perform bulk delete on catalog trend cache: %s", err)
-		return err
+	// Notify owners of expired markets.
+	em.notifyOwners(ctx, expired)
+
+	// Queue affected items for re-indexing, duplicates are indexed once.
+	var itemIDs []string
+	for _, m := range expired {
+		itemIDs = append(itemIDs, m.ItemID)
 	}
-	// svc_market market is the prefixed used for caching market related data.
-	if err = em.cache.BulkDel("svc_market"); err != nil {
-		em.logger.Errorf("could not perform bulk delete on market cache: %s", err)
-		return err
+	em.logger.Println("queueing affected expire items...", len(itemIDs))
+	if err = em.indexSvc.Queue(itemIDs...); err != nil {
+		em.logger.Errorf("could not queue expired items index: %s", err)
 	}
-	em.logger.Println("market cache invalidated!")
+	em.logger.Println("affected items queued!")
+
+	// Market caches are invalidated by index catalog job after queued items
+	// are indexed.
+
 	return nil
 }
+
+// notifyOwners sends single notification per user of their expired markets.
+func (em *ExpiringMarket) notifyOwners(ctx context.Context, expired []core.Market) {
+	counts := map[string]int{}
+	for _, m := range expired {
+		counts[m.UserID]++
+	}
+
+	for userID, n := range counts {
+		err := em.notifySvc.Notify(ctx, userID, core.Notification{
+			Event:   core.NotificationEventMarketExpired,
+			Subject: "Your listings have expired",
+			Message: fmt.Sprintf("%d of your listings expired and are no longer visible on the market.", n),
+		})
+		if err != nil {
+			em.logger.Errorf("could not notify expired markets of user %s: %s", userID, err)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/gokit/log"
+)
+
+const expiringMatchInterval = time.Minute * 10
+
+// ExpiringMatch represents a job that releases entries of market matches
+// that were not confirmed in time.
+type ExpiringMatch struct {
+	matchSvc core.MarketMatchService
+	logger   log.Logger
+	// job settings
+	name     string
+	interval time.Duration
+}
+
+func NewExpiringMatch(ms core.MarketMatchService, lg log.Logger) *ExpiringMatch {
+	return &ExpiringMatch{ms, lg, "expiring_match", expiringMatchInterval}
+}
+
+func (em *ExpiringMatch) String() string { return em.name }
+
+func (em *ExpiringMatch) Interval() time.Duration { return em.interval }
+
+func (em *ExpiringMatch) Run(ctx context.Context) error {
+	if err := em.matchSvc.Expire(ctx); err != nil {
+		em.logger.Errorf("could not expire market matches: %s", err)
+		return err
+	}
+
+	return nil
+}
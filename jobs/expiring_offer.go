@@ -0,0 +1,38 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/gokit/log"
+)
+
+const expiringOfferInterval = time.Minute * 10
+
+// ExpiringOffer represents a job that expires pending offers that were not
+// responded in time.
+type ExpiringOffer struct {
+	offerSvc core.OfferService
+	logger   log.Logger
+	// job settings
+	name     string
+	interval time.Duration
+}
+
+func NewExpiringOffer(os core.OfferService, lg log.Logger) *ExpiringOffer {
+	return &ExpiringOffer{os, lg, "expiring_offer", expiringOfferInterval}
+}
+
+func (eo *ExpiringOffer) String() string { return eo.name }
+
+func (eo *ExpiringOffer) Interval() time.Duration { return eo.interval }
+
+func (eo *ExpiringOffer) Run(ctx context.Context) error {
+	if err := eo.offerSvc.Expire(ctx); err != nil {
+		eo.logger.Errorf("could not expire offers: %s", err)
+		return err
+	}
+
+	return nil
+}
@@ -17,6 +17,7 @@ type GiftWrappedUpdate struct {
 	deliverySvc core.DeliveryService
 	deliveryStg core.DeliveryStorage
 	marketStg   core.MarketStorage
+	notifySvc   core.NotificationService
 	logger      log.Logger
 	// job settings
 	name     string
@@ -24,14 +25,20 @@ type GiftWrappedUpdate struct {
 	filter   core.Delivery
 }
 
-func NewGiftWrappedUpdate(ds core.DeliveryService, dstg core.DeliveryStorage, ms core.MarketStorage, lg log.Logger) *GiftWrappedUpdate {
+func NewGiftWrappedUpdate(
+	ds core.DeliveryService,
+	dstg core.DeliveryStorage,
+	ms core.MarketStorage,
+	ns core.NotificationService,
+	lg log.Logger,
+) *GiftWrappedUpdate {
 	falsePtr := false
 	f := core.Delivery{
 		GiftOpened: &falsePtr,
 		Status:     core.DeliveryStatusSenderVerified,
 	}
 	return &GiftWrappedUpdate{
-		ds, dstg, ms, lg,
+		ds, dstg, ms, ns, lg,
 		"giftwrapped_update", time.Hour, f}
 }
 
@@ -83,13 +90,20 @@ func (vd *GiftWrappedUpdate) Run(ctx context.Context) error {
 			}
 			vd.logger.Println("batch", opts.Page, mkt.User.Name, mkt.PartnerSteamID, mkt.Item.Name, status)
 
-			err = vd.deliverySvc.Set(ctx, &core.Delivery{
+			del := &core.Delivery{
 				MarketID: mkt.ID,
 				Status:   status,
 				Assets:   assets,
-			})
-			if err != nil {
+			}
+			if err = vd.deliverySvc.Set(ctx, del); err != nil {
 				vd.logger.Errorln(mkt.User.SteamID, mkt.Item.Name, status, err)
+				continue
+			}
+
+			// Notify seller when status changed or the buyer opened the gift.
+			opened := del.IsGiftOpened().GiftOpened
+			if status != dd.Status || (opened != nil && *opened) {
+				notifyDeliveryStatus(ctx, vd.notifySvc, vd.logger, *mkt, status)
 			}
 
 			//rest(5)
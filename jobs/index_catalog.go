@@ -0,0 +1,54 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/gokit/log"
+)
+
+const indexCatalogInterval = time.Second * 5
+
+// IndexCatalog represents a job that drains queued catalog re-index requests
+// and invalidates market caches once catalogs were re-indexed.
+type IndexCatalog struct {
+	indexSvc core.CatalogIndexService
+	cache    core.Cache
+	logger   log.Logger
+	// job settings
+	name     string
+	interval time.Duration
+}
+
+func NewIndexCatalog(cis core.CatalogIndexService, cc core.Cache, lg log.Logger) *IndexCatalog {
+	return &IndexCatalog{cis, cc, lg, "index_catalog", indexCatalogInterval}
+}
+
+func (ic *IndexCatalog) String() string { return ic.name }
+
+func (ic *IndexCatalog) Interval() time.Duration { return ic.interval }
+
+func (ic *IndexCatalog) Run(ctx context.Context) error {
+	res, err := ic.indexSvc.Drain(ctx)
+	if err != nil {
+		ic.logger.Errorf("could not drain catalog index queue: %s", err)
+	}
+	// Caches are invalidated after indexing so they won't be filled with
+	// stale catalogs in between.
+	if res != nil && res.Indexed != 0 {
+		ic.invalidateCache()
+	}
+
+	return err
+}
+
+func (ic *IndexCatalog) invalidateCache() {
+	if err := ic.cache.BulkDel("catalogs_trend"); err != nil {
+		ic.logger.Errorf("could not perform bulk delete on catalog trend cache: %s", err)
+	}
+	// svc_market market is the prefixed used for caching market related data.
+	if err := ic.cache.BulkDel("svc_market"); err != nil {
+		ic.logger.Errorf("could not perform bulk delete on market cache: %s", err)
+	}
+}
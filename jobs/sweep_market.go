@@ -2,6 +2,7 @@ package jobs
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/kudarap/dotagiftx/core"
@@ -10,19 +11,21 @@ import (
 
 // SweepMarket represents setting expiration of a market entry job.
 type SweepMarket struct {
-	marketStg core.MarketStorage
-	logger    log.Logger
+	marketStg  core.MarketStorage
+	historyStg core.MarketHistoryStorage
+	logger     log.Logger
 	// job settings
 	name     string
 	interval time.Duration
 }
 
-func NewSweepMarket(ms core.MarketStorage, lg log.Logger) *SweepMarket {
+func NewSweepMarket(ms core.MarketStorage, hs core.MarketHistoryStorage, lg log.Logger) *SweepMarket {
 	return &SweepMarket{
-		marketStg: ms,
-		logger:    lg,
-		name:      "clean_market",
-		interval:  defaultJobInterval,
+		marketStg:  ms,
+		historyStg: hs,
+		logger:     lg,
+		name:       "clean_market",
+		interval:   defaultJobInterval,
 	}
 }
 
@@ -34,23 +37,49 @@ func (cm *SweepMarket) Run(ctx context.Context) error {
 	const limitPerBatch = 1000
 	now := time.Now()
 
+	// Only entries with final status are safe to sweep.
+	for _, ms := range []core.MarketStatus{core.MarketStatusExpired, core.MarketStatusRemoved} {
+		if !core.IsMarketStatusFinal(ms) {
+			err := fmt.Errorf("market status %s is not final", ms)
+			cm.logger.Errorf("could not sweep market: %s", err)
+			return err
+		}
+	}
+
 	// Clean up expiring markets.
 	t := now.Add(-dayHours * core.MarketSweepExpiredDays)
 	cm.logger.Println("sweeping old expired market", t)
-	if err := cm.marketStg.BulkDeleteByStatus(core.MarketStatusExpired, t, limitPerBatch); err != nil {
+	res, err := cm.marketStg.BulkDeleteByStatus(core.MarketStatusExpired, t, limitPerBatch)
+	if err != nil {
 		cm.logger.Errorf("could not clean expired market: %s", err)
 		return err
 	}
+	cm.recordHistory(res)
 	cm.logger.Println("sweeping old expired market finished!")
 
 	// Clean up removed markets.
 	t = now.Add(-dayHours * core.MarketSweepRemovedDays)
 	cm.logger.Println("sweeping old removed market", t)
-	if err := cm.marketStg.BulkDeleteByStatus(core.MarketStatusRemoved, t, limitPerBatch); err != nil {
+	res, err = cm.marketStg.BulkDeleteByStatus(core.MarketStatusRemoved, t, limitPerBatch)
+	if err != nil {
 		cm.logger.Errorf("could not clean removed market: %s", err)
 		return err
 	}
+	cm.recordHistory(res)
 	cm.logger.Println("sweeping old removed market finished!")
 
 	return nil
 }
+
+// recordHistory records deletion of swept markets.
+func (cm *SweepMarket) recordHistory(swept []core.Market) {
+	var hh []core.MarketHistory
+	for _, m := range swept {
+		prev := m.Status
+		m.Status = 0 // marks market as deleted.
+		hh = append(hh, core.NewMarketHistory(m, prev, "", core.MarketHistorySourceSweepMarket))
+	}
+	if err := cm.historyStg.Create(hh...); err != nil {
+		cm.logger.Errorf("could not record swept market history: %s", err)
+	}
+}
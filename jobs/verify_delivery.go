@@ -15,6 +15,7 @@ import (
 type VerifyDelivery struct {
 	deliverySvc core.DeliveryService
 	marketStg   core.MarketStorage
+	notifySvc   core.NotificationService
 	logger      log.Logger
 	// job settings
 	name     string
@@ -22,10 +23,15 @@ type VerifyDelivery struct {
 	filter   core.Market
 }
 
-func NewVerifyDelivery(ds core.DeliveryService, ms core.MarketStorage, lg log.Logger) *VerifyDelivery {
+func NewVerifyDelivery(
+	ds core.DeliveryService,
+	ms core.MarketStorage,
+	ns core.NotificationService,
+	lg log.Logger,
+) *VerifyDelivery {
 	f := core.Market{Type: core.MarketTypeAsk, Status: core.MarketStatusSold}
 	return &VerifyDelivery{
-		ds, ms, lg,
+		ds, ms, ns, lg,
 		"verify_delivery", defaultJobInterval, f}
 }
 
@@ -78,6 +84,8 @@ func (vd *VerifyDelivery) Run(ctx context.Context) error {
 			})
 			if err != nil {
 				vd.logger.Errorln(mkt.User.SteamID, mkt.Item.Name, status, err)
+			} else if status != mkt.DeliveryStatus {
+				notifyDeliveryStatus(ctx, vd.notifySvc, vd.logger, mkt, status)
 			}
 
 			//rest(5)
@@ -91,3 +99,15 @@ func (vd *VerifyDelivery) Run(ctx context.Context) error {
 		//opts.Page++
 	}
 }
+
+// notifyDeliveryStatus sends delivery status change notification to the seller.
+func notifyDeliveryStatus(ctx context.Context, ns core.NotificationService, lg log.Logger, mkt core.Market, status core.DeliveryStatus) {
+	err := ns.Notify(ctx, mkt.UserID, core.Notification{
+		Event:   core.NotificationEventDeliveryStatus,
+		Subject: fmt.Sprintf("Delivery of %s: %s", mkt.Item.Name, status),
+		Message: fmt.Sprintf("Delivery status of your %s sold to %s is now %s.", mkt.Item.Name, mkt.PartnerSteamID, status),
+	})
+	if err != nil {
+		lg.Errorf("could not notify delivery status of market %s: %s", mkt.ID, err)
+	}
+}
@@ -0,0 +1,326 @@
+package memstore
+
+import (
+	"sort"
+	"time"
+
+	"github.com/fatih/structs"
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const (
+	tableCatalog     = "catalog"
+	catalogFieldSlug = "slug"
+)
+
+// NewCatalog creates new instance of catalog data store.
+func NewCatalog(c *Client) core.CatalogStorage {
+	return &catalogStorage{c, itemSearchFields}
+}
+
+type catalogStorage struct {
+	db            *Client
+	keywordFields []string
+}
+
+func (s *catalogStorage) Trending() ([]core.Catalog, error) {
+	// Date coverage for last 7 days.
+	const last7Days = -time.Hour * 24 * 7
+	endTime := time.Now()
+	startTime := endTime.Add(last7Days)
+	inCoverage := func(d document) bool {
+		t, ok := timeField(d, "created_at")
+		return ok && !t.Before(startTime) && !t.After(endTime)
+	}
+
+	views := map[string]int{}
+	for _, t := range filterDocs(s.db.all(tableTrack), inCoverage) {
+		if stringField(t, trackFieldType) == core.TrackTypeView {
+			views[stringField(t, trackFieldItemID)]++
+		}
+	}
+
+	markets := filterDocs(s.db.all(tableMarket), inCoverage)
+
+	var res []core.Catalog
+	scores := map[string]float64{}
+	for itemID, viewCount := range views {
+		cat := &core.Catalog{}
+		if err := s.db.get(tableCatalog, itemID, cat); err != nil {
+			continue
+		}
+
+		var entryScore, reserveScore, soldScore, bidScore float64
+		for _, m := range markets {
+			if stringField(m, marketFieldItemID) != itemID {
+				continue
+			}
+
+			if core.MarketType(numberField(m, marketFieldType)) == core.MarketTypeBid {
+				bidScore++
+				continue
+			}
+
+			entryScore++
+			switch core.MarketStatus(numberField(m, marketFieldStatus)) {
+			case core.MarketStatusReserved:
+				reserveScore++
+			case core.MarketStatusSold:
+				soldScore++
+			}
+		}
+
+		// Score rate evaluation.
+		score := float64(viewCount)*core.TrendScoreRateView +
+			entryScore*core.TrendScoreRateMarketEntry +
+			reserveScore*core.TrendScoreRateReserved +
+			soldScore*core.TrendScoreRateSold +
+			bidScore*core.TrendScoreRateBid
+		scores[itemID] = score
+		cat.ViewCount = int(score)
+		res = append(res, *cat)
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return scores[res[i].ID] > scores[res[j].ID]
+	})
+	if len(res) > 10 {
+		res = res[:10]
+	}
+
+	return res, nil
+}
+
+func (s *catalogStorage) Find(o core.FindOpts) ([]core.Catalog, error) {
+	var res []core.Catalog
+	o.KeywordFields = s.keywordFields
+	if err := s.db.list(tableCatalog, newFindOptsQuery(o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *catalogStorage) Count(o core.FindOpts) (num int, err error) {
+	o = core.FindOpts{
+		KeywordFields: s.keywordFields,
+		Keyword:       o.Keyword,
+		IDs:           o.IDs,
+		Filter:        o.Filter,
+		FilterExprs:   o.FilterExprs,
+	}
+	return s.db.count(tableCatalog, newFindOptsQuery(o)), nil
+}
+
+func (s *catalogStorage) Facets(o core.FindOpts) (*core.CatalogFacets, error) {
+	o.KeywordFields = s.keywordFields
+	var res []core.Catalog
+	q := newFindOptsQuery(core.CatalogFacetFindOpts(o))
+	if err := s.db.list(tableCatalog, q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return core.NewCatalogFacets(res), nil
+}
+
+func (s *catalogStorage) Get(id string) (*core.Catalog, error) {
+	row, _ := s.getBySlug(id)
+	if row != nil {
+		return row, nil
+	}
+
+	row = &core.Catalog{}
+	if err := s.db.get(tableCatalog, id, row); err != nil {
+		if err == errEmptyResult {
+			return nil, core.CatalogErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *catalogStorage) getBySlug(slug string) (*core.Catalog, error) {
+	var res []core.Catalog
+	if err := s.db.list(tableCatalog, byField(catalogFieldSlug, slug), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+	if len(res) == 0 {
+		return nil, core.CatalogErrNotFound
+	}
+
+	return &res[0], nil
+}
+
+func (s *catalogStorage) Index(itemID string) (*core.Catalog, error) {
+	cat := &core.Catalog{}
+
+	// Get item details by item ID.
+	if err := s.db.get(tableItem, itemID, cat); err != nil {
+		return nil, errors.New(core.CatalogErrIndexing, err)
+	}
+
+	var markets []core.Market
+	if err := s.db.list(tableMarket, byField(marketFieldItemID, itemID), &markets); err != nil {
+		return nil, errors.New(core.CatalogErrIndexing, err)
+	}
+
+	var offers, bids, sales []core.Market
+	for _, m := range markets {
+		switch {
+		case m.Type == core.MarketTypeBid && m.Status == core.MarketStatusLive:
+			bids = append(bids, m)
+		case m.Type != core.MarketTypeAsk:
+		case m.Status == core.MarketStatusLive && m.InventoryStatus == core.InventoryStatusVerified:
+			offers = append(offers, m)
+		case m.Status == core.MarketStatusReserved:
+			cat.ReservedCount++
+			sales = append(sales, m)
+		case m.Status == core.MarketStatusSold:
+			sales = append(sales, m)
+		}
+	}
+
+	// Get market offers summary from LIVE status.
+	cat.Quantity = len(offers)
+	if cat.Quantity != 0 {
+		cat.LowestAsk = minPrice(offers)
+		cat.MedianAsk = medianPrice(offers)
+		cat.RecentAsk = recentCreatedAt(offers)
+	}
+
+	// Get market buy orders summary.
+	cat.BidCount = len(bids)
+	if cat.BidCount != 0 {
+		cat.HighestBid = maxPrice(bids)
+		cat.RecentBid = recentCreatedAt(bids)
+	}
+
+	// Get market sales stats which calculated from RESERVED and SOLD statuses.
+	cat.SaleCount = len(sales)
+	if cat.SaleCount != 0 {
+		cat.AvgSale = avgPrice(sales)
+		cat.RecentSale = recentCreatedAt(sales)
+	}
+	cat.SoldCount = cat.SaleCount - cat.ReservedCount
+
+	// Check for exiting entry for update or create.
+	var err error
+	if cur, _ := s.Get(itemID); cur == nil {
+		err = s.create(cat)
+	} else {
+		err = s.update(cat)
+	}
+	if err != nil {
+		return nil, errors.New(core.CatalogErrIndexing, err)
+	}
+
+	return cat, nil
+}
+
+func (s *catalogStorage) create(in *core.Catalog) error {
+	// Fixes missing item in catalog that does not have views yet.
+	in.ViewCount = 1
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	// Convert catalog into map to insert zero value fields.
+	m := catalogToMap(in)
+
+	if _, err := s.db.insert(tableCatalog, m); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return nil
+}
+
+func (s *catalogStorage) update(in *core.Catalog) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	// Convert catalog into map to insert zero value fields.
+	m := catalogToMap(in)
+
+	if err = s.db.update(tableCatalog, in.ID, m); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func catalogToMap(cat *core.Catalog) map[string]interface{} {
+	s := structs.New(cat)
+	s.TagName = "json"
+	return s.Map()
+}
+
+func minPrice(markets []core.Market) float64 {
+	min := markets[0].Price
+	for _, m := range markets[1:] {
+		if m.Price < min {
+			min = m.Price
+		}
+	}
+
+	return min
+}
+
+func maxPrice(markets []core.Market) float64 {
+	max := markets[0].Price
+	for _, m := range markets[1:] {
+		if m.Price > max {
+			max = m.Price
+		}
+	}
+
+	return max
+}
+
+func avgPrice(markets []core.Market) float64 {
+	var sum float64
+	for _, m := range markets {
+		sum += m.Price
+	}
+
+	return sum / float64(len(markets))
+}
+
+func medianPrice(markets []core.Market) float64 {
+	prices := make([]float64, len(markets))
+	for i, m := range markets {
+		prices[i] = m.Price
+	}
+	sort.Float64s(prices)
+
+	n := len(prices)
+	if n%2 == 0 {
+		return (prices[n/2-1] + prices[n/2]) / 2
+	}
+
+	return prices[n/2]
+}
+
+func recentCreatedAt(markets []core.Market) *time.Time {
+	var recent *time.Time
+	for _, m := range markets {
+		if m.CreatedAt == nil {
+			continue
+		}
+		if recent == nil || m.CreatedAt.After(*recent) {
+			t := *m.CreatedAt
+			recent = &t
+		}
+	}
+
+	return recent
+}
@@ -0,0 +1,62 @@
+package memstore
+
+import (
+	"testing"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+func TestCatalogStorage_Index(t *testing.T) {
+	c := New()
+	item := &core.Item{Name: "Gothic Whisper", Hero: "Phantom Assassin", Slug: "gothic-whisper-phantom-assassin"}
+	if err := NewItem(c).Create(item); err != nil {
+		t.Fatalf("could not create item: %s", err)
+	}
+
+	markets := NewMarket(c)
+	for _, m := range []core.Market{
+		{ItemID: item.ID, Type: core.MarketTypeAsk, Status: core.MarketStatusLive, InventoryStatus: core.InventoryStatusVerified, Price: 4},
+		{ItemID: item.ID, Type: core.MarketTypeAsk, Status: core.MarketStatusLive, InventoryStatus: core.InventoryStatusVerified, Price: 2},
+		{ItemID: item.ID, Type: core.MarketTypeAsk, Status: core.MarketStatusLive, Price: 1},
+		{ItemID: item.ID, Type: core.MarketTypeAsk, Status: core.MarketStatusReserved, Price: 6},
+		{ItemID: item.ID, Type: core.MarketTypeAsk, Status: core.MarketStatusSold, Price: 8},
+		{ItemID: item.ID, Type: core.MarketTypeBid, Status: core.MarketStatusLive, Price: 1.5},
+	} {
+		m := m
+		if err := markets.Create(&m); err != nil {
+			t.Fatalf("could not create market: %s", err)
+		}
+	}
+
+	catalogs := NewCatalog(c)
+	if _, err := catalogs.Index(item.ID); err != nil {
+		t.Fatalf("Index() error = %v", err)
+	}
+	got, err := catalogs.Get(item.Slug)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	want := core.Catalog{
+		ID:            item.ID,
+		Quantity:      2,
+		LowestAsk:     2,
+		MedianAsk:     3,
+		BidCount:      1,
+		HighestBid:    1.5,
+		SaleCount:     2,
+		AvgSale:       7,
+		ReservedCount: 1,
+		SoldCount:     1,
+		ViewCount:     1,
+	}
+	if got.ID != want.ID || got.Quantity != want.Quantity || got.LowestAsk != want.LowestAsk ||
+		got.MedianAsk != want.MedianAsk || got.BidCount != want.BidCount || got.HighestBid != want.HighestBid ||
+		got.SaleCount != want.SaleCount || got.AvgSale != want.AvgSale || got.ReservedCount != want.ReservedCount ||
+		got.SoldCount != want.SoldCount || got.ViewCount != want.ViewCount {
+		t.Errorf("Index() = %+v, want %+v", got, want)
+	}
+	if got.RecentAsk == nil || got.RecentBid == nil || got.RecentSale == nil {
+		t.Errorf("Index() should set recent dates, got %+v", got)
+	}
+}
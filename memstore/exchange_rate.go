@@ -0,0 +1,72 @@
+package memstore
+
+import (
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const tableExchangeRate = "exchange_rate"
+
+// NewExchangeRate creates new instance of exchange rate data store.
+func NewExchangeRate(c *Client) core.ExchangeRateStorage {
+	return &exchangeRateStorage{c}
+}
+
+type exchangeRateStorage struct {
+	db *Client
+}
+
+func (s *exchangeRateStorage) Find(o core.FindOpts) ([]core.ExchangeRate, error) {
+	var res []core.ExchangeRate
+	if err := s.db.list(tableExchangeRate, newFindOptsQuery(o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *exchangeRateStorage) Get(id string) (*core.ExchangeRate, error) {
+	row := &core.ExchangeRate{}
+	if err := s.db.get(tableExchangeRate, id, row); err != nil {
+		if err == errEmptyResult {
+			return nil, core.CurrencyErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *exchangeRateStorage) Create(in *core.ExchangeRate) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(tableExchangeRate, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *exchangeRateStorage) Update(in *core.ExchangeRate) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	if err = s.db.update(tableExchangeRate, in.ID, in); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,390 @@
+package memstore
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+// errEmptyResult is returned when a document does not exist.
+var errEmptyResult = errors.New("memstore: the result does not contain any more rows")
+
+type findOpts core.FindOpts
+
+func newFindOptsQuery(o core.FindOpts) func([]document) []document {
+	return baseFindOptsQuery(o, nil)
+}
+
+func baseFindOptsQuery(o core.FindOpts, hookFn func([]document) []document) func([]document) []document {
+	return func(docs []document) []document {
+		return findOpts(o).parseOpts(docs, hookFn)
+	}
+}
+
+func (o findOpts) parseOpts(docs []document, hookFn func([]document) []document) []document {
+	if hookFn != nil {
+		docs = hookFn(docs)
+	}
+
+	if strings.TrimSpace(o.Keyword) != "" {
+		docs = filterDocs(docs, o.parseKeyword())
+	}
+
+	if o.IDs != nil {
+		docs = filterDocs(docs, o.parseIDs())
+	}
+
+	if o.Filter != nil {
+		docs = filterDocs(docs, o.parseFilter())
+	}
+
+	if len(o.FilterExprs) != 0 {
+		docs = filterDocs(docs, o.parseFilterExprs())
+	}
+
+	if o.UserID != "" {
+		docs = filterDocs(docs, o.setUserScope())
+	}
+
+	if o.Sort != "" {
+		if o.Cursor != nil {
+			docs = filterDocs(docs, o.parseCursor())
+		}
+		o.sort(docs)
+	}
+
+	if o.Limit != 0 {
+		docs = o.slice(docs)
+	}
+
+	if o.Fields != nil {
+		docs = o.pluck(docs)
+	}
+
+	return docs
+}
+
+func (o findOpts) parseKeyword() func(document) bool {
+	if len(o.KeywordFields) == 0 {
+		return func(document) bool { return true }
+	}
+
+	var patterns []*regexp.Regexp
+	for _, ww := range strings.Split(normalizeKeyword(o.Keyword), " ") {
+		p, err := regexp.Compile(fmt.Sprintf("(?i)%s", ww))
+		if err != nil {
+			p = regexp.MustCompile(fmt.Sprintf("(?i)%s", regexp.QuoteMeta(ww)))
+		}
+		patterns = append(patterns, p)
+	}
+
+	return func(d document) bool {
+		// Concatenate values of search fields to create a fake index.
+		var fields []string
+		for _, ff := range o.KeywordFields {
+			fields = append(fields, fmt.Sprint(d[ff]))
+		}
+		searchText := strings.Join(fields, " ")
+
+		// Matches that contains the keywords non case sensitive.
+		for _, p := range patterns {
+			if !p.MatchString(searchText) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+func (o findOpts) parseIDs() func(document) bool {
+	ids := map[string]struct{}{}
+	for _, id := range o.IDs {
+		ids[id] = struct{}{}
+	}
+
+	return func(d document) bool {
+		id, _ := d["id"].(string)
+		_, ok := ids[id]
+		return ok
+	}
+}
+
+// normalizeKeyword handles special case for the word "Collector's" with apostrophe.
+func normalizeKeyword(keyword string) string {
+	s := strings.ToLower(keyword)
+
+	// Special case for the word "Collector's" with apostrophe.
+	if strings.Contains(s, "collectors") {
+		s = strings.ReplaceAll(s, "collectors", "collector's")
+	}
+
+	return s
+}
+
+func (o findOpts) parseFilter() func(document) bool {
+	filter, err := newDocument(o.Filter)
+	if err != nil {
+		return func(document) bool { return false }
+	}
+
+	return func(d document) bool {
+		return matchDocument(d, filter)
+	}
+}
+
+// parseFilterExprs matches documents that satisfies all filter expressions,
+// documents without the field does not match.
+func (o findOpts) parseFilterExprs() func(document) bool {
+	// Values are encoded the same way as stored documents.
+	values := make([][]interface{}, len(o.FilterExprs))
+	for i, e := range o.FilterExprs {
+		_ = decode(e.Values, &values[i])
+	}
+
+	return func(d document) bool {
+		for i, e := range o.FilterExprs {
+			dv, ok := d[e.Field]
+			if !ok || dv == nil || !matchFilterExpr(e.Op, dv, values[i]) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+func matchFilterExpr(op core.FilterOp, dv interface{}, values []interface{}) bool {
+	if len(values) == 0 {
+		return false
+	}
+
+	c := compareValues(dv, values[0])
+	switch op {
+	case core.FilterOpGt:
+		return c > 0
+	case core.FilterOpGte:
+		return c >= 0
+	case core.FilterOpLt:
+		return c < 0
+	case core.FilterOpLte:
+		return c <= 0
+	case core.FilterOpIn:
+		for _, v := range values {
+			if compareValues(dv, v) == 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (o findOpts) setUserScope() func(document) bool {
+	return func(d document) bool {
+		return d["user_id"] == o.UserID
+	}
+}
+
+// sort orders documents by sort field and id for the same values.
+func (o findOpts) sort(docs []document) {
+	sort.SliceStable(docs, func(i, j int) bool {
+		c := compareValues(docs[i][o.Sort], docs[j][o.Sort])
+		if c == 0 {
+			c = compareValues(docs[i]["id"], docs[j]["id"])
+		}
+		if o.Desc {
+			return c > 0
+		}
+
+		return c < 0
+	})
+}
+
+// parseCursor matches documents that comes after the cursor document.
+func (o findOpts) parseCursor() func(document) bool {
+	// Sort value is encoded the same way as stored documents.
+	var v interface{}
+	_ = decode(o.Cursor.SortValue(), &v)
+
+	return func(d document) bool {
+		c := compareValues(d[o.Sort], v)
+		if c == 0 {
+			c = compareValues(d["id"], o.Cursor.ID)
+		}
+		if o.Desc {
+			return c < 0
+		}
+
+		return c > 0
+	}
+}
+
+func (o findOpts) slice(docs []document) []document {
+	if o.Page < 1 || o.Cursor != nil {
+		o.Page = 1
+	}
+	o.Page--
+
+	start := o.Page * o.Limit
+	end := start + o.Limit
+	if start > len(docs) {
+		return []document{}
+	}
+	if end > len(docs) {
+		end = len(docs)
+	}
+
+	return docs[start:end]
+}
+
+func (o findOpts) pluck(docs []document) []document {
+	res := make([]document, len(docs))
+	for i, d := range docs {
+		res[i] = document{}
+		for _, ff := range o.Fields {
+			if v, ok := d[ff]; ok {
+				res[i][ff] = v
+			}
+		}
+	}
+
+	return res
+}
+
+func filterDocs(docs []document, fn func(document) bool) []document {
+	var res []document
+	for _, d := range docs {
+		if fn(d) {
+			res = append(res, d)
+		}
+	}
+
+	return res
+}
+
+// matchDocument checks document contains all filter values, nested objects
+// are matched partially.
+func matchDocument(d, filter document) bool {
+	for k, fv := range filter {
+		dv, ok := d[k]
+		if !ok {
+			return false
+		}
+
+		fm, ok := fv.(map[string]interface{})
+		if !ok {
+			if compareValues(dv, fv) != 0 {
+				return false
+			}
+			continue
+		}
+
+		dm, ok := dv.(map[string]interface{})
+		if !ok || !matchDocument(dm, fm) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// compareValues compares document values, null and missing values are
+// always lesser than any other values.
+func compareValues(a, b interface{}) int {
+	if a == nil || b == nil {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		default:
+			return 1
+		}
+	}
+
+	switch av := a.(type) {
+	case float64:
+		if bv, ok := b.(float64); ok {
+			return compareFloat(av, bv)
+		}
+	case string:
+		bv, ok := b.(string)
+		if !ok {
+			break
+		}
+		// Time values are stored as RFC3339 strings.
+		at, aerr := time.Parse(time.RFC3339Nano, av)
+		bt, berr := time.Parse(time.RFC3339Nano, bv)
+		if aerr == nil && berr == nil {
+			return compareTime(at, bt)
+		}
+		return strings.Compare(av, bv)
+	case bool:
+		if bv, ok := b.(bool); ok {
+			if av == bv {
+				return 0
+			}
+			if !av {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+func compareTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+
+	return 0
+}
+
+// timeField returns time value of a document field.
+func timeField(d document, field string) (time.Time, bool) {
+	s, ok := d[field].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
+
+// numberField returns numeric value of a document field.
+func numberField(d document, field string) float64 {
+	n, _ := d[field].(float64)
+	return n
+}
+
+// stringField returns string value of a document field.
+func stringField(d document, field string) string {
+	s, _ := d[field].(string)
+	return s
+}
@@ -0,0 +1,102 @@
+package memstore
+
+import (
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const (
+	tableInventory         = "inventory"
+	inventoryFieldMarketID = "market_id"
+)
+
+var inventorySearchFields = []string{"id", "market_id"}
+
+// NewInventory creates new instance of inventory data store.
+func NewInventory(c *Client) core.InventoryStorage {
+	return &inventoryStorage{c, inventorySearchFields}
+}
+
+type inventoryStorage struct {
+	db            *Client
+	keywordFields []string
+}
+
+func (s *inventoryStorage) Find(o core.FindOpts) ([]core.Inventory, error) {
+	var res []core.Inventory
+	o.KeywordFields = s.keywordFields
+	if err := s.db.list(tableInventory, newFindOptsQuery(o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *inventoryStorage) Count(o core.FindOpts) (num int, err error) {
+	o = core.FindOpts{
+		Keyword:       o.Keyword,
+		KeywordFields: s.keywordFields,
+		Filter:        o.Filter,
+		UserID:        o.UserID,
+	}
+	return s.db.count(tableInventory, newFindOptsQuery(o)), nil
+}
+
+func (s *inventoryStorage) Get(id string) (*core.Inventory, error) {
+	row := &core.Inventory{}
+	if err := s.db.get(tableInventory, id, row); err != nil {
+		if err == errEmptyResult {
+			return nil, core.InventoryErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *inventoryStorage) GetByMarketID(marketID string) (*core.Inventory, error) {
+	var res []core.Inventory
+	if err := s.db.list(tableInventory, byField(inventoryFieldMarketID, marketID), &res); err != nil {
+		return nil, err
+	}
+
+	if len(res) == 0 {
+		return nil, core.InventoryErrNotFound
+	}
+
+	return &res[0], nil
+}
+
+func (s *inventoryStorage) Create(in *core.Inventory) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(tableInventory, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *inventoryStorage) Update(in *core.Inventory) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	if err = s.db.update(tableInventory, in.ID, in); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err := mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
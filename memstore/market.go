@@ -0,0 +1,366 @@
+package memstore
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const (
+	tableMarket                = "market"
+	marketFieldItemID          = "item_id"
+	marketFieldUserID          = "user_id"
+	marketFieldType            = "type"
+	marketFieldStatus          = "status"
+	marketFieldInventoryStatus = "inventory_status"
+	marketFieldDeliveryStatus  = "delivery_status"
+	marketFieldPrice           = "price"
+	marketFieldResell          = "resell"
+	marketFieldCreatedAt       = "created_at"
+	marketFieldUpdatedAt       = "updated_at"
+	// Hidden field for searching item details.
+	marketItemSearchTags = "search_text"
+)
+
+// NewMarket creates new instance of market data store.
+func NewMarket(c *Client) core.MarketStorage {
+	return &marketStorage{c, []string{marketItemSearchTags}}
+}
+
+type marketStorage struct {
+	db            *Client
+	keywordFields []string
+}
+
+func (s *marketStorage) Find(o core.FindOpts) ([]core.Market, error) {
+	var res []core.Market
+	o.KeywordFields = s.keywordFields
+	if err := s.db.list(tableMarket, newFindOptsQuery(o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	for i, rr := range res {
+		res[i].User = s.includeUser(rr.UserID)
+	}
+
+	return res, nil
+}
+
+// PendingInventoryStatus returns market entries that is pending for checking
+// inventory status or needs re-processing of re-process error status.
+func (s *marketStorage) PendingInventoryStatus(o core.FindOpts) ([]core.Market, error) {
+	q := baseFindOptsQuery(o, func(docs []document) []document {
+		docs = filterDocs(docs, func(d document) bool {
+			st, ok := d[marketFieldInventoryStatus]
+			return !ok || st == float64(core.InventoryStatusError)
+		})
+		docs = filterDocs(docs, func(d document) bool {
+			st := core.MarketStatus(numberField(d, marketFieldStatus))
+			return (st == core.MarketStatusLive || st == core.MarketStatusReserved) &&
+				core.MarketType(numberField(d, marketFieldType)) == core.MarketTypeAsk
+		})
+		return s.includeRelatedFields(docs)
+	})
+
+	var res []core.Market
+	if err := s.db.list(tableMarket, q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+// PendingDeliveryStatus returns market entries that is pending for checking
+// delivery status or needs re-processing of re-process error status.
+func (s *marketStorage) PendingDeliveryStatus(o core.FindOpts) ([]core.Market, error) {
+	q := baseFindOptsQuery(o, func(docs []document) []document {
+		docs = filterDocs(docs, func(d document) bool {
+			st, ok := d[marketFieldDeliveryStatus]
+			return !ok || st == float64(core.DeliveryStatusError)
+		})
+		return s.includeRelatedFields(docs)
+	})
+
+	var res []core.Market
+	if err := s.db.list(tableMarket, q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *marketStorage) RevalidateDeliveryStatus(o core.FindOpts) ([]core.Market, error) {
+	now := time.Now()
+	q := baseFindOptsQuery(o, func(docs []document) []document {
+		docs = filterDocs(docs, func(d document) bool {
+			if core.MarketStatus(numberField(d, marketFieldStatus)) != core.MarketStatusSold {
+				return false
+			}
+
+			t, ok := timeField(d, marketFieldUpdatedAt)
+			if !ok || t.Year() != now.Year() || t.Month() != now.Month() || t.Day() != now.Day() {
+				return false
+			}
+
+			ds := core.DeliveryStatus(numberField(d, marketFieldDeliveryStatus))
+			return ds == core.DeliveryStatusNoHit || ds == core.DeliveryStatusPrivate
+		})
+		return s.includeRelatedFields(docs)
+	})
+
+	var res []core.Market
+	if err := s.db.list(tableMarket, q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *marketStorage) Count(o core.FindOpts) (num int, err error) {
+	o = core.FindOpts{
+		Keyword:       o.Keyword,
+		IDs:           o.IDs,
+		KeywordFields: s.keywordFields,
+		Filter:        o.Filter,
+		FilterExprs:   o.FilterExprs,
+		UserID:        o.UserID,
+	}
+	return s.db.count(tableMarket, newFindOptsQuery(o)), nil
+}
+
+// includeRelatedFields injects user details base on market foreign keys.
+func (s *marketStorage) includeRelatedFields(docs []document) []document {
+	return s.db.joinUser(docs, marketFieldUserID)
+}
+
+func (s *marketStorage) Get(id string) (*core.Market, error) {
+	row := &core.Market{}
+	if err := s.db.get(tableMarket, id, row); err != nil {
+		if err == errEmptyResult {
+			return nil, core.MarketErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	row.User = s.includeUser(row.UserID)
+	return row, nil
+}
+
+func (s *marketStorage) includeUser(userID string) *core.User {
+	var user core.User
+	_ = s.db.get(tableUser, userID, &user)
+	return &user
+}
+
+func (s *marketStorage) Index(id string) (*core.Market, error) {
+	mkt, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var item core.Item
+	_ = s.db.get(tableItem, mkt.ItemID, &item)
+	mkt.Item = &item
+
+	var invs []core.Inventory
+	_ = s.db.list(tableInventory, byField(inventoryFieldMarketID, mkt.ID), &invs)
+	if len(invs) != 0 {
+		mkt.Inventory = &invs[0]
+	}
+
+	var dels []core.Delivery
+	_ = s.db.list(tableDelivery, byField(deliveryFieldMarketID, mkt.ID), &dels)
+	if len(dels) != 0 {
+		mkt.Delivery = &dels[0]
+	}
+
+	mkt.SearchText = mkt.Notes
+	if mkt.Item != nil {
+		mkt.SearchText += strings.Join([]string{
+			"",
+			mkt.Item.Name,
+			mkt.Item.Hero,
+			mkt.Item.Origin,
+			mkt.Item.Rarity,
+		}, " ")
+	}
+
+	if err = s.BaseUpdate(mkt); err != nil {
+		return nil, err
+	}
+
+	return mkt, nil
+}
+
+func (s *marketStorage) Create(in *core.Market) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	in.User = nil
+	in.Item = nil
+	id, err := s.db.insert(tableMarket, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *marketStorage) Update(in *core.Market) error {
+	in.UpdatedAt = now()
+	return s.BaseUpdate(in)
+}
+
+func (s *marketStorage) UpdateUserScore(userID string, rankScore int) error {
+	if userID == "" {
+		return fmt.Errorf("user id is required to update user score")
+	}
+
+	// get all user live market
+	var markets []core.Market
+	q := newFindOptsQuery(core.FindOpts{
+		Filter: core.Market{UserID: userID, Status: core.MarketStatusLive},
+	})
+	if err := s.db.list(tableMarket, q, &markets); err != nil {
+		return err
+	}
+
+	// set new user rank score
+	for _, mm := range markets {
+		mm.UserRankScore = rankScore
+		if err := s.BaseUpdate(&mm); err != nil {
+			return fmt.Errorf("could not update market user rank: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *marketStorage) BaseUpdate(in *core.Market) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.User = nil
+	if err = s.db.update(tableMarket, in.ID, in); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func (s *marketStorage) UpdateIfStatus(in *core.Market, status core.MarketStatus) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.User = nil
+	in.UpdatedAt = now()
+	ok, err := s.db.updateIf(tableMarket, in.ID, in, func(d document) bool {
+		return core.MarketStatus(numberField(d, marketFieldStatus)) == status
+	})
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	if !ok {
+		return core.MarketErrStatusChanged
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func (s *marketStorage) UpdateExpiring(t core.MarketType, b core.UserBoon, cutOff time.Time) ([]core.Market, error) {
+	// Collects exempted users ids.
+	var users []core.User
+	if err := s.db.list(tableUser, nil, &users); err != nil {
+		return nil, fmt.Errorf("could not get users: %s", err)
+	}
+	exemptedUserIDs := map[string]bool{}
+	for _, u := range users {
+		if u.HasBoon(b) {
+			exemptedUserIDs[u.ID] = true
+		}
+	}
+
+	// Sets expired entry state base on cutOff time.
+	now := time.Now()
+	var markets []core.Market
+	q := baseFindOptsQuery(core.FindOpts{
+		Filter: core.Market{Status: core.MarketStatusLive, Type: t},
+	}, func(docs []document) []document {
+		return filterDocs(docs, func(d document) bool {
+			c, ok := timeField(d, marketFieldCreatedAt)
+			return ok && c.Before(cutOff) && !exemptedUserIDs[stringField(d, marketFieldUserID)]
+		})
+	})
+	if err := s.db.list(tableMarket, q, &markets); err != nil {
+		return nil, fmt.Errorf("could not get expiring markets: %s", err)
+	}
+
+	// Collect and return affected markets.
+	for i, mm := range markets {
+		err := s.db.update(tableMarket, mm.ID, core.Market{Status: core.MarketStatusExpired, UpdatedAt: &now})
+		if err != nil {
+			return nil, fmt.Errorf("could not update expiring markets: %s", err)
+		}
+
+		markets[i].Status = core.MarketStatusExpired
+		markets[i].UpdatedAt = &now
+	}
+
+	return markets, nil
+}
+
+func (s *marketStorage) BulkDeleteByStatus(ms core.MarketStatus, cutOff time.Time, limit int) ([]core.Market, error) {
+	if ms != core.MarketStatusRemoved && ms != core.MarketStatusExpired {
+		return nil, fmt.Errorf("market status %s not allowed to bulk delete", ms)
+	}
+
+	var markets []core.Market
+	q := baseFindOptsQuery(core.FindOpts{Filter: core.Market{Status: ms}}, func(docs []document) []document {
+		docs = filterDocs(docs, func(d document) bool {
+			c, ok := timeField(d, marketFieldCreatedAt)
+			return ok && c.Before(cutOff)
+		})
+		if len(docs) > limit {
+			docs = docs[:limit]
+		}
+		return docs
+	})
+	if err := s.db.list(tableMarket, q, &markets); err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, mm := range markets {
+		ids = append(ids, mm.ID)
+	}
+	s.db.delete(tableMarket, ids...)
+	return markets, nil
+}
+
+// byField returns a query that filters documents by field value.
+func byField(field string, value interface{}) func([]document) []document {
+	return func(docs []document) []document {
+		return filterDocs(docs, func(d document) bool {
+			return compareValues(d[field], value) == 0
+		})
+	}
+}
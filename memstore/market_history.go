@@ -0,0 +1,45 @@
+package memstore
+
+import (
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const (
+	tableMarketHistory          = "market_history"
+	marketHistoryFieldMarketID  = "market_id"
+	marketHistoryFieldCreatedAt = "created_at"
+)
+
+// NewMarketHistory creates new instance of market history data store.
+func NewMarketHistory(c *Client) core.MarketHistoryStorage {
+	return &marketHistoryStorage{c}
+}
+
+type marketHistoryStorage struct {
+	db *Client
+}
+
+func (s *marketHistoryStorage) Find(marketID string) ([]core.MarketHistory, error) {
+	var res []core.MarketHistory
+	o := core.FindOpts{Sort: marketHistoryFieldCreatedAt}
+	q := baseFindOptsQuery(o, byField(marketHistoryFieldMarketID, marketID))
+	if err := s.db.list(tableMarketHistory, q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *marketHistoryStorage) Create(in ...core.MarketHistory) error {
+	t := now()
+	for _, h := range in {
+		h.ID = ""
+		h.CreatedAt = t
+		if _, err := s.db.insert(tableMarketHistory, h); err != nil {
+			return errors.New(core.StorageUncaughtErr, err)
+		}
+	}
+
+	return nil
+}
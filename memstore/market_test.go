@@ -0,0 +1,197 @@
+package memstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+func newTestMarketStorage(t *testing.T) core.MarketStorage {
+	c := New()
+	users := NewUser(c)
+	for _, u := range []core.User{
+		{ID: "u1", SteamID: "7656119001", Name: "Alpha"},
+		{ID: "u2", SteamID: "7656119002", Name: "Bravo", Boons: []string{string(core.BoonRefresherShard)}},
+	} {
+		u := u
+		if err := users.Create(&u); err != nil {
+			t.Fatalf("could not create user: %s", err)
+		}
+	}
+
+	markets := NewMarket(c)
+	for _, m := range []core.Market{
+		{UserID: "u1", ItemID: "i1", Type: core.MarketTypeAsk, Status: core.MarketStatusLive, Price: 3, SearchText: "Gothic Whisper Phantom Assassin"},
+		{UserID: "u1", ItemID: "i2", Type: core.MarketTypeAsk, Status: core.MarketStatusSold, Price: 1, SearchText: "Dark Artistry Invoker"},
+		{UserID: "u2", ItemID: "i1", Type: core.MarketTypeAsk, Status: core.MarketStatusLive, Price: 2, SearchText: "Gothic Whisper Phantom Assassin"},
+		{UserID: "u2", ItemID: "i3", Type: core.MarketTypeBid, Status: core.MarketStatusLive, Price: 5, SearchText: "Collector's Cache"},
+	} {
+		m := m
+		if err := markets.Create(&m); err != nil {
+			t.Fatalf("could not create market: %s", err)
+		}
+	}
+
+	return markets
+}
+
+func TestMarketStorage_Find(t *testing.T) {
+	s := newTestMarketStorage(t)
+	tests := []struct {
+		name   string
+		opts   core.FindOpts
+		prices []float64
+	}{
+		{"all", core.FindOpts{}, []float64{3, 1, 2, 5}},
+		{"keyword", core.FindOpts{Keyword: "gothic"}, []float64{3, 2}},
+		{"keyword collectors", core.FindOpts{Keyword: "collectors cache"}, []float64{5}},
+		{"filter", core.FindOpts{Filter: core.Market{Status: core.MarketStatusLive, Type: core.MarketTypeAsk}}, []float64{3, 2}},
+		{"user scope", core.FindOpts{UserID: "u2"}, []float64{2, 5}},
+		{"sort", core.FindOpts{Sort: "price"}, []float64{1, 2, 3, 5}},
+		{"sort desc", core.FindOpts{Sort: "price", Desc: true}, []float64{5, 3, 2, 1}},
+		{"page", core.FindOpts{Sort: "price", Page: 2, Limit: 3}, []float64{5}},
+		{"page out of range", core.FindOpts{Page: 3, Limit: 3}, nil},
+		{"price range", core.FindOpts{Sort: "price", FilterExprs: []core.FilterExpr{
+			{Field: "price", Op: core.FilterOpGte, Type: core.FilterTypeNumber, Values: []interface{}{2.0}},
+			{Field: "price", Op: core.FilterOpLt, Type: core.FilterTypeNumber, Values: []interface{}{5.0}},
+		}}, []float64{2, 3}},
+		{"status list", core.FindOpts{Sort: "price", FilterExprs: []core.FilterExpr{
+			{Field: "status", Op: core.FilterOpIn, Type: core.FilterTypeNumber, Values: []interface{}{float64(core.MarketStatusSold), 500.0}},
+		}}, []float64{1}},
+		{"created range", core.FindOpts{FilterExprs: []core.FilterExpr{
+			{Field: "created_at", Op: core.FilterOpGte, Type: core.FilterTypeTime, Values: []interface{}{time.Now().Add(-time.Hour)}},
+		}}, []float64{3, 1, 2, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Find(tt.opts)
+			if err != nil {
+				t.Fatalf("Find() error = %v", err)
+			}
+			if len(got) != len(tt.prices) {
+				t.Fatalf("Find() got %d results, want %d", len(got), len(tt.prices))
+			}
+			for i, mm := range got {
+				if mm.Price != tt.prices[i] {
+					t.Errorf("Find()[%d].Price = %v, want %v", i, mm.Price, tt.prices[i])
+				}
+				if mm.User == nil || mm.User.ID != mm.UserID {
+					t.Errorf("Find()[%d].User = %v, want user %s", i, mm.User, mm.UserID)
+				}
+			}
+		})
+	}
+}
+
+func TestMarketStorage_FindCursor(t *testing.T) {
+	s := newTestMarketStorage(t)
+	tests := []struct {
+		name string
+		sort string
+		desc bool
+	}{
+		{"price", "price", false},
+		{"price desc", "price", true},
+		{"created_at desc", "created_at", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			all, err := s.Find(core.FindOpts{Sort: tt.sort, Desc: tt.desc})
+			if err != nil {
+				t.Fatalf("Find() error = %v", err)
+			}
+
+			opts := core.FindOpts{Sort: tt.sort, Desc: tt.desc, Limit: 3}
+			var got []core.Market
+			for {
+				res, err := s.Find(opts)
+				if err != nil {
+					t.Fatalf("Find() error = %v", err)
+				}
+				got = append(got, res...)
+
+				next := opts.NextCursor(len(res), res[len(res)-1])
+				if next == "" {
+					break
+				}
+				if opts.Cursor, err = core.DecodeCursor(next); err != nil {
+					t.Fatalf("DecodeCursor() error = %v", err)
+				}
+			}
+
+			if len(got) != len(all) {
+				t.Fatalf("Find() with cursor got %d results, want %d", len(got), len(all))
+			}
+			for i := range all {
+				if got[i].ID != all[i].ID {
+					t.Errorf("Find() with cursor [%d] = %s, want %s", i, got[i].ID, all[i].ID)
+				}
+			}
+		})
+	}
+}
+
+func TestMarketStorage_Update(t *testing.T) {
+	s := newTestMarketStorage(t)
+	res, _ := s.Find(core.FindOpts{Sort: "price", Limit: 1})
+	cur := res[0]
+
+	in := &core.Market{ID: cur.ID, Status: core.MarketStatusRemoved}
+	if err := s.Update(in); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	got, err := s.Get(cur.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got.Status != core.MarketStatusRemoved {
+		t.Errorf("Update() status = %v, want %v", got.Status, core.MarketStatusRemoved)
+	}
+	if got.Price != cur.Price || got.ItemID != cur.ItemID {
+		t.Errorf("Update() should keep untouched fields, got %+v", got)
+	}
+	if in.Price != cur.Price {
+		t.Errorf("Update() should merge current values into input, got price %v", in.Price)
+	}
+
+	if _, err = s.Get("unknown"); err != core.MarketErrNotFound {
+		t.Errorf("Get() error = %v, want %v", err, core.MarketErrNotFound)
+	}
+}
+
+func TestMarketStorage_UpdateIfStatus(t *testing.T) {
+	s := newTestMarketStorage(t)
+	res, _ := s.Find(core.FindOpts{Filter: core.Market{ItemID: "i3"}})
+	cur := res[0]
+
+	in := &core.Market{ID: cur.ID, Status: core.MarketStatusReservePending}
+	if err := s.UpdateIfStatus(in, core.MarketStatusLive); err != nil {
+		t.Fatalf("UpdateIfStatus() error = %v", err)
+	}
+	in = &core.Market{ID: cur.ID, Status: core.MarketStatusReservePending}
+	if err := s.UpdateIfStatus(in, core.MarketStatusLive); err != core.MarketErrStatusChanged {
+		t.Errorf("UpdateIfStatus() error = %v, want %v", err, core.MarketErrStatusChanged)
+	}
+
+	got, _ := s.Get(cur.ID)
+	if got.Status != core.MarketStatusReservePending || got.Price != cur.Price {
+		t.Errorf("UpdateIfStatus() got %+v", got)
+	}
+}
+
+func TestMarketStorage_UpdateExpiring(t *testing.T) {
+	s := newTestMarketStorage(t)
+	res, err := s.UpdateExpiring(core.MarketTypeAsk, core.BoonRefresherShard, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("UpdateExpiring() error = %v", err)
+	}
+	if len(res) != 1 || res[0].ItemID != "i1" || res[0].Status != core.MarketStatusExpired {
+		t.Errorf("UpdateExpiring() = %v, want 1 expired market of i1", res)
+	}
+
+	n, _ := s.Count(core.FindOpts{Filter: core.Market{Status: core.MarketStatusExpired}})
+	if n != 1 {
+		t.Errorf("UpdateExpiring() expired %d markets, want 1", n)
+	}
+}
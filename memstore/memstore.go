@@ -0,0 +1,336 @@
+package memstore
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+const tagName = "db"
+
+// json encodes records using the same db struct tag as the rethink storage so
+// documents keep the exact shape they would have in the database.
+var json = jsoniter.Config{TagKey: tagName}.Froze()
+
+// document represents a stored record.
+type document map[string]interface{}
+
+// table holds documents and keeps track of its insertion order.
+type table struct {
+	ids  []string
+	docs map[string]document
+}
+
+// Client represents in-memory database client.
+type Client struct {
+	mu     sync.RWMutex
+	tables map[string]*table
+}
+
+// New create new in-memory database instance.
+func New() *Client {
+	return &Client{tables: map[string]*table{}}
+}
+
+// Close drops all tables.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	c.tables = map[string]*table{}
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *Client) table(name string) *table {
+	t, ok := c.tables[name]
+	if !ok {
+		t = &table{docs: map[string]document{}}
+		c.tables[name] = t
+	}
+
+	return t
+}
+
+// all returns copies of documents from a table in insertion order.
+func (c *Client) all(tableName string) []document {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	t, ok := c.tables[tableName]
+	if !ok {
+		return nil
+	}
+
+	res := make([]document, len(t.ids))
+	for i, id := range t.ids {
+		res[i] = t.docs[id].clone()
+	}
+
+	return res
+}
+
+// list decodes documents from a table after passing them on query function.
+func (c *Client) list(tableName string, q func([]document) []document, out interface{}) error {
+	docs := c.all(tableName)
+	if q != nil {
+		docs = q(docs)
+	}
+	if docs == nil {
+		docs = []document{}
+	}
+
+	return decode(docs, out)
+}
+
+func (c *Client) count(tableName string, q func([]document) []document) int {
+	docs := c.all(tableName)
+	if q != nil {
+		docs = q(docs)
+	}
+
+	return len(docs)
+}
+
+// get decodes document by id into out and returns errEmptyResult when it
+// does not exist.
+func (c *Client) get(tableName, id string, out interface{}) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	// Plain lookup since table creation would write on read lock.
+	t, ok := c.tables[tableName]
+	if !ok {
+		return errEmptyResult
+	}
+	doc, ok := t.docs[id]
+	if !ok {
+		return errEmptyResult
+	}
+
+	return decode(doc, out)
+}
+
+// insert persists a new document and generates its id when not provided.
+func (c *Client) insert(tableName string, in interface{}) (id string, err error) {
+	doc, err := newDocument(in)
+	if err != nil {
+		return "", err
+	}
+
+	id, _ = doc["id"].(string)
+	if id == "" {
+		id = generateID()
+		doc["id"] = id
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := c.table(tableName)
+	if _, ok := t.docs[id]; ok {
+		return "", fmt.Errorf("duplicate primary key id %s on %s table", id, tableName)
+	}
+	t.ids = append(t.ids, id)
+	t.docs[id] = doc
+	return id, nil
+}
+
+// upsert inserts the document or merges non-empty fields of the input
+// returned by fn from the stored document with the same id while holding
+// the lock.
+func (c *Client) upsert(tableName string, in interface{}, fn func(document) interface{}) error {
+	doc, err := newDocument(in)
+	if err != nil {
+		return err
+	}
+	id, _ := doc["id"].(string)
+	if id == "" {
+		return fmt.Errorf("upsert on %s table requires id", tableName)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := c.table(tableName)
+	cur, ok := t.docs[id]
+	if !ok {
+		t.ids = append(t.ids, id)
+		t.docs[id] = doc
+		return nil
+	}
+
+	up, err := newDocument(fn(cur))
+	if err != nil {
+		return err
+	}
+	delete(up, "id")
+	cur.merge(up)
+	return nil
+}
+
+// update merges non-empty fields of the input into the stored document.
+func (c *Client) update(tableName, id string, in interface{}) error {
+	_, err := c.updateIf(tableName, id, in, nil)
+	return err
+}
+
+// updateIf merges non-empty fields of the input into the stored document
+// when it passes the condition, nil condition always passes.
+func (c *Client) updateIf(tableName, id string, in interface{}, cond func(document) bool) (updated bool, err error) {
+	return c.updateFunc(tableName, id, func(cur document) (interface{}, bool) {
+		if cond != nil && !cond(cur) {
+			return nil, false
+		}
+		return in, true
+	})
+}
+
+// updateFunc merges non-empty fields of the input returned by fn from the
+// stored document into it while holding the lock, fn skips the update by
+// returning false.
+func (c *Client) updateFunc(tableName, id string, fn func(document) (interface{}, bool)) (updated bool, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cur, ok := c.table(tableName).docs[id]
+	if !ok {
+		return false, errEmptyResult
+	}
+	in, ok := fn(cur)
+	if !ok {
+		return false, nil
+	}
+	doc, err := newDocument(in)
+	if err != nil {
+		return false, err
+	}
+	delete(doc, "id")
+	cur.merge(doc)
+	return true, nil
+}
+
+func (c *Client) delete(tableName string, ids ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := c.table(tableName)
+	for _, id := range ids {
+		if _, ok := t.docs[id]; !ok {
+			continue
+		}
+
+		delete(t.docs, id)
+		for i, ii := range t.ids {
+			if ii == id {
+				t.ids = append(t.ids[:i], t.ids[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// newDocument converts a model into a document using its db struct tags.
+func newDocument(in interface{}) (document, error) {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := document{}
+	if err = json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	doc.pruneEmpty()
+	return doc, nil
+}
+
+func decode(in, out interface{}) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, out)
+}
+
+// merge updates document fields recursively like rethink update does.
+func (d document) merge(src document) {
+	for k, v := range src {
+		sm, ok := v.(map[string]interface{})
+		if !ok {
+			d[k] = v
+			continue
+		}
+
+		dm, ok := d[k].(map[string]interface{})
+		if !ok {
+			d[k] = v
+			continue
+		}
+		document(dm).merge(sm)
+	}
+}
+
+// pruneEmpty removes nested objects that only contains zero values since
+// struct fields are not covered by the omitempty tag option.
+func (d document) pruneEmpty() {
+	for k, v := range d {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		document(m).pruneEmpty()
+		if isEmptyDocument(m) {
+			delete(d, k)
+		}
+	}
+}
+
+func (d document) clone() document {
+	c := make(document, len(d))
+	for k, v := range d {
+		if m, ok := v.(map[string]interface{}); ok {
+			v = map[string]interface{}(document(m).clone())
+		}
+		c[k] = v
+	}
+
+	return c
+}
+
+func isEmptyDocument(m map[string]interface{}) bool {
+	for _, v := range m {
+		switch vv := v.(type) {
+		case nil:
+		case float64:
+			if vv != 0 {
+				return false
+			}
+		case string:
+			if vv != "" {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func generateID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	// Sets UUID version 4 and variant bits.
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func now() *time.Time {
+	t := time.Now()
+	return &t
+}
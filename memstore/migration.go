@@ -0,0 +1,54 @@
+package memstore
+
+import (
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const (
+	tableMigration     = "migration"
+	migrationFieldName = "name"
+)
+
+// NewMigration creates new instance of migration data store.
+func NewMigration(c *Client) core.MigrationStorage {
+	return &migrationStorage{c}
+}
+
+type migrationStorage struct {
+	db *Client
+}
+
+func (s *migrationStorage) Find() ([]core.Migration, error) {
+	var res []core.Migration
+	if err := s.db.list(tableMigration, nil, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *migrationStorage) Create(in *core.Migration) error {
+	in.ID = ""
+	in.AppliedAt = now()
+	id, err := s.db.insert(tableMigration, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *migrationStorage) Delete(name string) error {
+	var res []core.Migration
+	if err := s.db.list(tableMigration, byField(migrationFieldName, name), &res); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	for _, mm := range res {
+		s.db.delete(tableMigration, mm.ID)
+	}
+
+	return nil
+}
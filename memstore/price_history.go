@@ -0,0 +1,104 @@
+package memstore
+
+import (
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const tablePriceCandle = "price_candle"
+
+// NewPriceHistory creates new instance of price history data store.
+func NewPriceHistory(c *Client) core.PriceHistoryStorage {
+	return &priceHistoryStorage{c}
+}
+
+type priceHistoryStorage struct {
+	db *Client
+}
+
+func (s *priceHistoryStorage) Find(o core.FindOpts) ([]core.PriceCandle, error) {
+	var res []core.PriceCandle
+	if err := s.db.list(tablePriceCandle, newFindOptsQuery(o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *priceHistoryStorage) Get(id string) (*core.PriceCandle, error) {
+	row := &core.PriceCandle{}
+	if err := s.db.get(tablePriceCandle, id, row); err != nil {
+		if err == errEmptyResult {
+			return nil, core.PriceHistoryErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *priceHistoryStorage) Create(in *core.PriceCandle) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = in.Key
+	id, err := s.db.insert(tablePriceCandle, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *priceHistoryStorage) Update(in *core.PriceCandle) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	if err = s.db.update(tablePriceCandle, in.ID, in); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func (s *priceHistoryStorage) AddPrice(in *core.PriceCandle, price float64) error {
+	c := *in
+	c.ID = c.Key
+	c.Volume = 0
+	c.Add(price)
+	t := now()
+	c.CreatedAt = t
+	c.UpdatedAt = t
+
+	err := s.db.upsert(tablePriceCandle, c, func(cur document) interface{} {
+		high, low := numberField(cur, "high"), numberField(cur, "low")
+		if price > high {
+			high = price
+		}
+		if price < low {
+			low = price
+		}
+		return map[string]interface{}{
+			"high":       high,
+			"low":        low,
+			"close":      price,
+			"volume":     numberField(cur, "volume") + 1,
+			"updated_at": t,
+		}
+	})
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package memstore
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+func TestPriceHistoryStorage_AddPrice(t *testing.T) {
+	s := NewPriceHistory(New())
+	at := time.Date(2024, 1, 2, 15, 30, 0, 0, time.UTC)
+
+	var wg sync.WaitGroup
+	for _, price := range []float64{5, 9, 2, 7, 4, 6, 3, 8} {
+		wg.Add(1)
+		go func(price float64) {
+			defer wg.Done()
+			c := core.NewPriceCandle("item", core.PriceIntervalHour, core.PriceSideAsk, at)
+			if err := s.AddPrice(&c, price); err != nil {
+				t.Errorf("AddPrice() error = %v", err)
+			}
+		}(price)
+	}
+	wg.Wait()
+
+	key := core.PriceCandleKey("item", core.PriceIntervalHour, core.PriceSideAsk, at.Truncate(time.Hour))
+	res, err := s.Find(core.FindOpts{Filter: core.PriceCandle{Key: key}})
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if len(res) != 1 {
+		t.Fatalf("got %d candles, want 1", len(res))
+	}
+	if c := res[0]; c.Volume != 8 || c.High != 9 || c.Low != 2 || c.Open == 0 || c.Close == 0 {
+		t.Errorf("unexpected candle %+v", c)
+	}
+}
@@ -0,0 +1,75 @@
+package memstore
+
+import (
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const (
+	tableReport       = "report"
+	reportFieldUserID = "user_id"
+)
+
+var reportSearchFields = []string{"label", "text"}
+
+// NewReport creates new instance of report data store.
+func NewReport(c *Client) core.ReportStorage {
+	return &reportStorage{c, reportSearchFields}
+}
+
+type reportStorage struct {
+	db            *Client
+	keywordFields []string
+}
+
+func (s *reportStorage) Find(o core.FindOpts) ([]core.Report, error) {
+	var res []core.Report
+	o.KeywordFields = s.keywordFields
+	if err := s.db.list(tableReport, baseFindOptsQuery(o, s.includeRelatedFields), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *reportStorage) Count(o core.FindOpts) (num int, err error) {
+	o = core.FindOpts{
+		Keyword:       o.Keyword,
+		KeywordFields: s.keywordFields,
+		Filter:        o.Filter,
+		UserID:        o.UserID,
+	}
+	return s.db.count(tableReport, baseFindOptsQuery(o, s.includeRelatedFields)), nil
+}
+
+// includeRelatedFields injects user details base on report foreign keys.
+func (s *reportStorage) includeRelatedFields(docs []document) []document {
+	return s.db.joinUser(docs, reportFieldUserID)
+}
+
+func (s *reportStorage) Get(id string) (*core.Report, error) {
+	row := &core.Report{}
+	if err := s.db.get(tableReport, id, row); err != nil {
+		if err == errEmptyResult {
+			return nil, core.ReportErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *reportStorage) Create(in *core.Report) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(tableReport, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package memstore
+
+import (
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const tableRoleAudit = "role_audit"
+
+// NewRoleAudit creates new instance of role audit data store.
+func NewRoleAudit(c *Client) core.RoleAuditStorage {
+	return &roleAuditStorage{c}
+}
+
+type roleAuditStorage struct {
+	db *Client
+}
+
+func (s *roleAuditStorage) Find(o core.FindOpts) ([]core.RoleAudit, error) {
+	var res []core.RoleAudit
+	if err := s.db.list(tableRoleAudit, newFindOptsQuery(o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *roleAuditStorage) Create(in *core.RoleAudit) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(tableRoleAudit, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package memstore
+
+import (
+	"sort"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+// NewStats creates new instance of stats data store.
+func NewStats(c *Client) core.StatsStorage {
+	return &statsStorage{c}
+}
+
+type statsStorage struct {
+	db *Client
+}
+
+func (s *statsStorage) CountUserMarketStatus(userID string) (*core.MarketStatusCount, error) {
+	markets := filterDocs(s.db.all(tableMarket), func(d document) bool {
+		return stringField(d, marketFieldUserID) == userID
+	})
+
+	msc := countMarketStatus(markets)
+	resell := countBy(filterDocs(markets, func(d document) bool {
+		_, ok := d[marketFieldResell]
+		return ok && isMarketType(d, core.MarketTypeAsk)
+	}), marketFieldStatus)
+	msc.ResellLive = resell[float64(core.MarketStatusLive)]
+	msc.ResellSold = resell[float64(core.MarketStatusSold)]
+	msc.ResellReserved = resell[float64(core.MarketStatusReserved)]
+	msc.ResellRemoved = resell[float64(core.MarketStatusRemoved)]
+	msc.ResellCancelled = resell[float64(core.MarketStatusCancelled)]
+	return msc, nil
+}
+
+func (s *statsStorage) CountMarketStatus(opts core.FindOpts) (*core.MarketStatusCount, error) {
+	markets := findOpts(opts).parseOpts(s.db.all(tableMarket), nil)
+	return countMarketStatus(markets), nil
+}
+
+func (s *statsStorage) GraphMarketSales(o core.FindOpts) ([]core.MarketSalesGraph, error) {
+	type group struct {
+		date  time.Time
+		sum   float64
+		count int
+	}
+
+	groups := map[time.Time]*group{}
+	for _, d := range findOpts(o).parseOpts(s.db.all(tableMarket), nil) {
+		st := core.MarketStatus(numberField(d, marketFieldStatus))
+		if st != core.MarketStatusReserved && st != core.MarketStatusSold {
+			continue
+		}
+
+		t, ok := timeField(d, marketFieldUpdatedAt)
+		if !ok {
+			continue
+		}
+
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		g, ok := groups[day]
+		if !ok {
+			g = &group{date: day}
+			groups[day] = g
+		}
+		g.sum += numberField(d, marketFieldPrice)
+		g.count++
+	}
+
+	res := []core.MarketSalesGraph{}
+	for _, g := range groups {
+		date := g.date
+		res = append(res, core.MarketSalesGraph{
+			Date:  &date,
+			Avg:   g.sum / float64(g.count),
+			Count: g.count,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Date.Before(*res[j].Date)
+	})
+
+	return res, nil
+}
+
+func countMarketStatus(markets []document) *core.MarketStatusCount {
+	asks := countBy(filterDocs(markets, func(d document) bool {
+		return isMarketType(d, core.MarketTypeAsk)
+	}), marketFieldStatus)
+	bids := countBy(filterDocs(markets, func(d document) bool {
+		return isMarketType(d, core.MarketTypeBid)
+	}), marketFieldStatus)
+	dlv := countBy(markets, marketFieldDeliveryStatus)
+	inv := countBy(markets, marketFieldInventoryStatus)
+
+	return &core.MarketStatusCount{
+		Pending:   asks[float64(core.MarketStatusPending)],
+		Live:      asks[float64(core.MarketStatusLive)],
+		Sold:      asks[float64(core.MarketStatusSold)],
+		Reserved:  asks[float64(core.MarketStatusReserved)],
+		Removed:   asks[float64(core.MarketStatusRemoved)],
+		Cancelled: asks[float64(core.MarketStatusCancelled)],
+
+		BidLive:      bids[float64(core.MarketStatusLive)],
+		BidCompleted: bids[float64(core.MarketStatusBidCompleted)],
+
+		DeliveryNoHit:          dlv[float64(core.DeliveryStatusNoHit)],
+		DeliveryNameVerified:   dlv[float64(core.DeliveryStatusNameVerified)],
+		DeliverySenderVerified: dlv[float64(core.DeliveryStatusSenderVerified)],
+		DeliveryPrivate:        dlv[float64(core.DeliveryStatusPrivate)],
+		DeliveryError:          dlv[float64(core.DeliveryStatusError)],
+
+		InventoryNoHit:    inv[float64(core.InventoryStatusNoHit)],
+		InventoryVerified: inv[float64(core.InventoryStatusVerified)],
+		InventoryPrivate:  inv[float64(core.InventoryStatusPrivate)],
+		InventoryError:    inv[float64(core.InventoryStatusError)],
+	}
+}
+
+// countBy groups documents by field value and count them.
+func countBy(docs []document, field string) map[float64]int {
+	res := map[float64]int{}
+	for _, d := range docs {
+		res[numberField(d, field)]++
+	}
+
+	return res
+}
+
+func isMarketType(d document, t core.MarketType) bool {
+	return core.MarketType(numberField(d, marketFieldType)) == t
+}
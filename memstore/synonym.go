@@ -0,0 +1,77 @@
+package memstore
+
+import (
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const tableSynonym = "synonym"
+
+// NewSynonym creates new instance of synonym data store.
+func NewSynonym(c *Client) core.SynonymStorage {
+	return &synonymStorage{c}
+}
+
+type synonymStorage struct {
+	db *Client
+}
+
+func (s *synonymStorage) Find(o core.FindOpts) ([]core.Synonym, error) {
+	var res []core.Synonym
+	if err := s.db.list(tableSynonym, newFindOptsQuery(o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *synonymStorage) Get(id string) (*core.Synonym, error) {
+	row := &core.Synonym{}
+	if err := s.db.get(tableSynonym, id, row); err != nil {
+		if err == errEmptyResult {
+			return nil, core.SynonymErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *synonymStorage) Create(in *core.Synonym) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(tableSynonym, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *synonymStorage) Update(in *core.Synonym) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	if err = s.db.update(tableSynonym, in.ID, in); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func (s *synonymStorage) Delete(id string) error {
+	s.db.delete(tableSynonym, id)
+	return nil
+}
@@ -0,0 +1,105 @@
+package memstore
+
+import (
+	"sort"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const (
+	tableTrack          = "track"
+	trackFieldItemID    = "item_id"
+	trackFieldType      = "type"
+	trackFieldKeyword   = "keyword"
+	trackFieldCreatedAt = "created_at"
+)
+
+// NewTrack creates new instance of track data store.
+func NewTrack(c *Client) core.TrackStorage {
+	return &trackStorage{c, []string{"item_id"}}
+}
+
+type trackStorage struct {
+	db            *Client
+	keywordFields []string
+}
+
+func (s *trackStorage) Find(o core.FindOpts) ([]core.Track, error) {
+	var res []core.Track
+	o.KeywordFields = s.keywordFields
+	if err := s.db.list(tableTrack, newFindOptsQuery(o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *trackStorage) Count(o core.FindOpts) (num int, err error) {
+	o = core.FindOpts{
+		Keyword:       o.Keyword,
+		KeywordFields: s.keywordFields,
+		Filter:        o.Filter,
+	}
+	return s.db.count(tableTrack, newFindOptsQuery(o)), nil
+}
+
+func (s *trackStorage) Get(id string) (*core.Track, error) {
+	row := &core.Track{}
+	if err := s.db.get(tableTrack, id, row); err != nil {
+		if err == errEmptyResult {
+			return nil, core.TrackErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *trackStorage) Create(in *core.Track) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	id, err := s.db.insert(tableTrack, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+// TopKeywords returns top recent searched keywords.
+func (s *trackStorage) TopKeywords() ([]core.SearchKeywordScore, error) {
+	const last7Days = -time.Hour * 24 * 7
+	cutOff := time.Now().Add(last7Days)
+
+	scores := map[string]int{}
+	for _, d := range s.db.all(tableTrack) {
+		t, ok := timeField(d, trackFieldCreatedAt)
+		if !ok || t.Before(cutOff) || stringField(d, trackFieldType) != core.TrackTypeSearch {
+			continue
+		}
+
+		scores[stringField(d, trackFieldKeyword)]++
+	}
+
+	res := []core.SearchKeywordScore{}
+	for k, v := range scores {
+		res = append(res, core.SearchKeywordScore{Keyword: k, Score: v})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score == res[j].Score {
+			return res[i].Keyword < res[j].Keyword
+		}
+
+		return res[i].Score > res[j].Score
+	})
+	if len(res) > 12 {
+		res = res[:12]
+	}
+
+	return res, nil
+}
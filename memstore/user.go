@@ -0,0 +1,207 @@
+package memstore
+
+import (
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const (
+	tableUser        = "user"
+	userFieldSteamID = "steam_id"
+	userFieldStatus  = "status"
+	userFieldRoles   = "roles"
+)
+
+var userSearchFields = []string{"name", "steam_id", "url"}
+
+// NewUser creates new instance of user data store.
+func NewUser(c *Client) core.UserStorage {
+	return &userStorage{c, userSearchFields}
+}
+
+type userStorage struct {
+	db            *Client
+	keywordFields []string
+}
+
+func (s *userStorage) Find(o core.FindOpts) ([]core.User, error) {
+	var res []core.User
+	if err := s.db.list(tableUser, newFindOptsQuery(o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *userStorage) FindFlagged(o core.FindOpts) ([]core.User, error) {
+	var res []core.User
+	o.KeywordFields = s.keywordFields
+	if err := s.db.list(tableUser, baseFindOptsQuery(o, s.flaggedFilter), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *userStorage) flaggedFilter(docs []document) []document {
+	return filterDocs(docs, func(d document) bool {
+		return core.UserStatus(numberField(d, userFieldStatus)) >= core.UserStatusSuspended
+	})
+}
+
+func (s *userStorage) FindStaff(o core.FindOpts) ([]core.User, error) {
+	var res []core.User
+	o.KeywordFields = s.keywordFields
+	if err := s.db.list(tableUser, baseFindOptsQuery(o, s.staffFilter), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *userStorage) staffFilter(docs []document) []document {
+	return filterDocs(docs, func(d document) bool {
+		roles, _ := d[userFieldRoles].([]interface{})
+		return len(roles) > 0
+	})
+}
+
+func (s *userStorage) Count(o core.FindOpts) (num int, err error) {
+	o = core.FindOpts{Filter: o.Filter, UserID: o.UserID}
+	return s.db.count(tableUser, newFindOptsQuery(o)), nil
+}
+
+func (s *userStorage) Get(id string) (*core.User, error) {
+	// Check steam ID first exist.
+	row, _ := s.getBySteamID(id)
+	if row != nil {
+		return row, nil
+	}
+
+	// Try find it by user ID.
+	row = &core.User{}
+	if err := s.db.get(tableUser, id, row); err != nil {
+		if err == errEmptyResult {
+			return nil, core.UserErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *userStorage) getBySteamID(steamID string) (*core.User, error) {
+	var res []core.User
+	if err := s.db.list(tableUser, byField(userFieldSteamID, steamID), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+	if len(res) == 0 {
+		return nil, core.UserErrNotFound
+	}
+
+	return &res[0], nil
+}
+
+func (s *userStorage) Create(in *core.User) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	id, err := s.db.insert(tableUser, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *userStorage) Update(in *core.User) error {
+	in.UpdatedAt = now()
+	return s.BaseUpdate(in)
+}
+
+func (s *userStorage) BaseUpdate(in *core.User) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	if err = s.db.update(tableUser, in.ID, in); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func (s *userStorage) AddRole(id, role string) error {
+	return s.updateRoles(id, func(roles []interface{}) []interface{} {
+		for _, r := range roles {
+			if r == role {
+				return roles
+			}
+		}
+		return append(roles, role)
+	})
+}
+
+func (s *userStorage) RemoveRole(id, role string) error {
+	return s.updateRoles(id, func(roles []interface{}) []interface{} {
+		res := []interface{}{}
+		for _, r := range roles {
+			if r != role {
+				res = append(res, r)
+			}
+		}
+		return res
+	})
+}
+
+// updateRoles replaces user roles from its current roles while the document
+// is locked, unlike Update it persists empty roles.
+func (s *userStorage) updateRoles(id string, fn func(roles []interface{}) []interface{}) error {
+	_, err := s.db.updateFunc(tableUser, id, func(cur document) (interface{}, bool) {
+		roles, _ := cur[userFieldRoles].([]interface{})
+		roles = fn(append([]interface{}{}, roles...))
+		return map[string]interface{}{
+			userFieldRoles: roles,
+			"updated_at":   now(),
+		}, true
+	})
+	if err != nil {
+		if err == errEmptyResult {
+			return core.UserErrNotFound
+		}
+
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return nil
+}
+
+// joinUser injects user details by foreign key field and drops documents
+// without matching user.
+func (c *Client) joinUser(docs []document, foreignKey string) []document {
+	users := map[string]document{}
+	for _, u := range c.all(tableUser) {
+		users[stringField(u, "id")] = u
+	}
+
+	var res []document
+	for _, d := range docs {
+		u, ok := users[stringField(d, foreignKey)]
+		if !ok {
+			continue
+		}
+
+		d[tableUser] = map[string]interface{}(u)
+		res = append(res, d)
+	}
+
+	return res
+}
@@ -0,0 +1,42 @@
+package memstore
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+func TestUserStorage_Roles(t *testing.T) {
+	s := NewUser(New())
+	u := &core.User{ID: "u1", SteamID: "7656119001", Name: "Alpha"}
+	if err := s.Create(u); err != nil {
+		t.Fatalf("could not create user: %s", err)
+	}
+
+	for _, role := range []string{core.RoleAdmin, core.RoleSupport, core.RoleAdmin} {
+		if err := s.AddRole(u.ID, role); err != nil {
+			t.Fatalf("AddRole(%s) error = %v", role, err)
+		}
+	}
+	got, err := s.Get(u.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if want := []string{core.RoleAdmin, core.RoleSupport}; !reflect.DeepEqual(got.Roles, want) {
+		t.Errorf("roles = %v, want %v", got.Roles, want)
+	}
+
+	for _, role := range []string{core.RoleAdmin, core.RoleSupport} {
+		if err = s.RemoveRole(u.ID, role); err != nil {
+			t.Fatalf("RemoveRole(%s) error = %v", role, err)
+		}
+	}
+	if got, _ = s.Get(u.ID); len(got.Roles) != 0 {
+		t.Errorf("roles = %v, want empty", got.Roles)
+	}
+
+	if err = s.AddRole("missing", core.RoleAdmin); err != core.UserErrNotFound {
+		t.Errorf("AddRole() on missing user error = %v, want %v", err, core.UserErrNotFound)
+	}
+}
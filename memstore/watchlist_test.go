@@ -0,0 +1,43 @@
+package memstore
+
+import (
+	"testing"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+func TestWatchlistStorage_UpdateThresholds(t *testing.T) {
+	s := NewWatchlist(New())
+	triggered := true
+	w := &core.Watchlist{UserID: "u1", ItemID: "i1", AskBelow: 10, BidAbove: 20, AskTriggered: &triggered}
+	if err := s.Create(w); err != nil {
+		t.Fatalf("could not create watchlist: %s", err)
+	}
+
+	in := &core.Watchlist{ID: w.ID, AskBelow: 0, BidAbove: 25}
+	if err := s.UpdateThresholds(in); err != nil {
+		t.Fatalf("UpdateThresholds() error = %v", err)
+	}
+	if in.AskBelow != 0 || in.BidAbove != 25 || in.ItemID != "i1" {
+		t.Errorf("unexpected updated watchlist %+v", in)
+	}
+
+	got, err := s.Get(w.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got.AskBelow != 0 || got.BidAbove != 25 {
+		t.Errorf("thresholds = %v/%v, want cleared ask below and 25 bid above", got.AskBelow, got.BidAbove)
+	}
+	if got.AskTriggered == nil || *got.AskTriggered {
+		t.Error("ask alert should be re-armed")
+	}
+
+	// Partial updates keeps thresholds.
+	if err = s.Update(&core.Watchlist{ID: w.ID, BidTriggered: &triggered}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if got, _ = s.Get(w.ID); got.BidAbove != 25 {
+		t.Errorf("bid above = %v, want 25", got.BidAbove)
+	}
+}
@@ -0,0 +1,147 @@
+package memstore
+
+import (
+	"time"
+
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const (
+	tableWebhook                      = "webhook"
+	tableWebhookDelivery              = "webhook_delivery"
+	webhookDeliveryFieldWebhookID     = "webhook_id"
+	webhookDeliveryFieldStatus        = "status"
+	webhookDeliveryFieldNextAttemptAt = "next_attempt_at"
+	webhookDeliveryFieldCreatedAt     = "created_at"
+)
+
+// NewWebhook creates new instance of webhook data store.
+func NewWebhook(c *Client) core.WebhookStorage {
+	return &webhookStorage{c}
+}
+
+type webhookStorage struct {
+	db *Client
+}
+
+func (s *webhookStorage) Find(o core.FindOpts) ([]core.Webhook, error) {
+	var res []core.Webhook
+	if err := s.db.list(tableWebhook, newFindOptsQuery(o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *webhookStorage) Get(id string) (*core.Webhook, error) {
+	row := &core.Webhook{}
+	if err := s.db.get(tableWebhook, id, row); err != nil {
+		if err == errEmptyResult {
+			return nil, core.WebhookErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *webhookStorage) Create(in *core.Webhook) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(tableWebhook, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *webhookStorage) Update(in *core.Webhook) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	if err = s.db.update(tableWebhook, in.ID, in); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func (s *webhookStorage) Delete(id string) error {
+	s.db.delete(tableWebhook, id)
+	return nil
+}
+
+// NewWebhookDelivery creates new instance of webhook delivery data store.
+func NewWebhookDelivery(c *Client) core.WebhookDeliveryStorage {
+	return &webhookDeliveryStorage{c}
+}
+
+type webhookDeliveryStorage struct {
+	db *Client
+}
+
+func (s *webhookDeliveryStorage) Find(webhookID string, limit int) ([]core.WebhookDelivery, error) {
+	var res []core.WebhookDelivery
+	o := core.FindOpts{Sort: webhookDeliveryFieldCreatedAt, Desc: true, Limit: limit}
+	q := baseFindOptsQuery(o, byField(webhookDeliveryFieldWebhookID, webhookID))
+	if err := s.db.list(tableWebhookDelivery, q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *webhookDeliveryStorage) ToDeliver(limit int) ([]core.WebhookDelivery, error) {
+	var res []core.WebhookDelivery
+	o := core.FindOpts{Sort: webhookDeliveryFieldNextAttemptAt, Limit: limit}
+	t := time.Now()
+	q := baseFindOptsQuery(o, func(docs []document) []document {
+		return filterDocs(docs, func(d document) bool {
+			next, ok := timeField(d, webhookDeliveryFieldNextAttemptAt)
+			return ok && !next.After(t) &&
+				numberField(d, webhookDeliveryFieldStatus) == float64(core.WebhookDeliveryStatusPending)
+		})
+	})
+	if err := s.db.list(tableWebhookDelivery, q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *webhookDeliveryStorage) Create(in *core.WebhookDelivery) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(tableWebhookDelivery, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *webhookDeliveryStorage) Update(in *core.WebhookDelivery) error {
+	in.UpdatedAt = now()
+	if err := s.db.update(tableWebhookDelivery, in.ID, in); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/gokit/log"
+)
+
+// ErrIrreversible returned by Down step of migrations that cannot be reverted
+// without losing existing records, e.g. baseline tables.
+var ErrIrreversible = errors.New("migration is irreversible")
+
+// Migration represents a named schema or data change. Migrations are applied
+// in the order they are registered and should be safe to run more than once.
+type Migration struct {
+	Name string
+	// Up applies the migration changes.
+	Up func() error
+	// Down reverts the migration changes. A nil Down only removes the
+	// migration record, used on data fixes that has nothing to revert.
+	Down func() error
+}
+
+// Status represents migration state.
+type Status struct {
+	Name      string     `json:"name"`
+	AppliedAt *time.Time `json:"applied_at"`
+}
+
+// Applied returns true when migration was already applied.
+func (s Status) Applied() bool {
+	return s.AppliedAt != nil
+}
+
+// Migrator applies and reverts registered migrations and keeps track of them
+// on the migration data store.
+type Migrator struct {
+	store      core.MigrationStorage
+	migrations []Migration
+	dryRun     bool
+	logger     log.Logger
+}
+
+// New create new instance of migrator.
+func New(ms core.MigrationStorage, lg log.Logger) *Migrator {
+	return &Migrator{store: ms, logger: lg}
+}
+
+// SetDryRun when enabled, pending changes will be listed without executing them.
+func (m *Migrator) SetDryRun(b bool) {
+	m.dryRun = b
+}
+
+// Register adds migrations in order. Migration names should be unique.
+func (m *Migrator) Register(mm ...Migration) {
+	for _, mi := range mm {
+		if mi.Name == "" {
+			panic("migration name is required")
+		}
+		if mi.Up == nil {
+			panic(fmt.Sprintf("migration %s up step is required", mi.Name))
+		}
+		for _, cur := range m.migrations {
+			if cur.Name == mi.Name {
+				panic(fmt.Sprintf("migration %s already registered", mi.Name))
+			}
+		}
+
+		m.migrations = append(m.migrations, mi)
+	}
+}
+
+// List returns registered migrations and their applied state.
+func (m *Migrator) List() ([]Status, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	var res []Status
+	for _, mi := range m.migrations {
+		res = append(res, Status{mi.Name, applied[mi.Name]})
+	}
+
+	return res, nil
+}
+
+// Up applies all pending migrations and returns their names.
+func (m *Migrator) Up() ([]string, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, mi := range m.migrations {
+		if applied[mi.Name] != nil {
+			continue
+		}
+
+		m.logger.Infof("migration %s applying... dry-run:%t", mi.Name, m.dryRun)
+		if m.dryRun {
+			names = append(names, mi.Name)
+			continue
+		}
+
+		if err = mi.Up(); err != nil {
+			return names, fmt.Errorf("could not apply migration %s: %s", mi.Name, err)
+		}
+		if err = m.store.Create(&core.Migration{Name: mi.Name}); err != nil {
+			return names, fmt.Errorf("could not save migration %s: %s", mi.Name, err)
+		}
+		names = append(names, mi.Name)
+	}
+
+	return names, nil
+}
+
+// Down reverts the last applied migrations by number of steps and returns their names.
+func (m *Migrator) Down(steps int) ([]string, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for i := len(m.migrations) - 1; i >= 0 && len(names) < steps; i-- {
+		mi := m.migrations[i]
+		if applied[mi.Name] == nil {
+			continue
+		}
+
+		m.logger.Infof("migration %s reverting... dry-run:%t", mi.Name, m.dryRun)
+		if m.dryRun {
+			names = append(names, mi.Name)
+			continue
+		}
+
+		if mi.Down != nil {
+			if err = mi.Down(); err != nil {
+				return names, fmt.Errorf("could not revert migration %s: %w", mi.Name, err)
+			}
+		}
+		if err = m.store.Delete(mi.Name); err != nil {
+			return names, fmt.Errorf("could not delete migration %s: %s", mi.Name, err)
+		}
+		names = append(names, mi.Name)
+	}
+
+	return names, nil
+}
+
+// applied returns applied migrations indexed by name.
+func (m *Migrator) applied() (map[string]*time.Time, error) {
+	res, err := m.store.Find()
+	if err != nil {
+		return nil, fmt.Errorf("could not get applied migrations: %s", err)
+	}
+
+	applied := map[string]*time.Time{}
+	for _, mm := range res {
+		t := mm.AppliedAt
+		if t == nil {
+			t = &time.Time{}
+		}
+		applied[mm.Name] = t
+	}
+
+	return applied, nil
+}
@@ -0,0 +1,93 @@
+package migration
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/kudarap/dotagiftx/gokit/log"
+	"github.com/kudarap/dotagiftx/memstore"
+)
+
+func TestMigrator(t *testing.T) {
+	var calls []string
+	step := func(name string) func() error {
+		return func() error {
+			calls = append(calls, name)
+			return nil
+		}
+	}
+
+	m := New(memstore.NewMigration(memstore.New()), log.Default())
+	m.Register(
+		Migration{"0001_first", step("up1"), step("down1")},
+		Migration{"0002_second", step("up2"), nil},
+		Migration{"0003_third", step("up3"), step("down3")},
+	)
+
+	tests := []struct {
+		name      string
+		fn        func() ([]string, error)
+		dryRun    bool
+		wantNames []string
+		wantCalls []string
+	}{
+		{"dry-run up", m.Up, true, []string{"0001_first", "0002_second", "0003_third"}, nil},
+		{"up", m.Up, false, []string{"0001_first", "0002_second", "0003_third"}, []string{"up1", "up2", "up3"}},
+		{"up again", m.Up, false, nil, nil},
+		{"dry-run down", func() ([]string, error) { return m.Down(1) }, true, []string{"0003_third"}, nil},
+		{"down 2 steps", func() ([]string, error) { return m.Down(2) }, false, []string{"0003_third", "0002_second"}, []string{"down3"}},
+		{"up after down", m.Up, false, []string{"0002_second", "0003_third"}, []string{"up2", "up3"}},
+	}
+	for _, tc := range tests {
+		calls = nil
+		m.SetDryRun(tc.dryRun)
+		names, err := tc.fn()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		if !reflect.DeepEqual(names, tc.wantNames) {
+			t.Errorf("%s: names got %v, want %v", tc.name, names, tc.wantNames)
+		}
+		if !reflect.DeepEqual(calls, tc.wantCalls) {
+			t.Errorf("%s: calls got %v, want %v", tc.name, calls, tc.wantCalls)
+		}
+	}
+
+	ss, err := m.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range ss {
+		if !s.Applied() {
+			t.Errorf("migration %s should be applied", s.Name)
+		}
+	}
+}
+
+func TestMigrator_DownIrreversible(t *testing.T) {
+	m := New(memstore.NewMigration(memstore.New()), log.Default())
+	m.Register(
+		Migration{"0001_baseline", func() error { return nil }, func() error { return ErrIrreversible }},
+		Migration{"0002_second", func() error { return nil }, nil},
+	)
+	if _, err := m.Up(); err != nil {
+		t.Fatal(err)
+	}
+
+	names, err := m.Down(2)
+	if !errors.Is(err, ErrIrreversible) {
+		t.Fatalf("err got %v, want %v", err, ErrIrreversible)
+	}
+	if !reflect.DeepEqual(names, []string{"0002_second"}) {
+		t.Errorf("names got %v, want [0002_second]", names)
+	}
+
+	ss, err := m.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ss[0].Applied() {
+		t.Error("irreversible migration should stay applied")
+	}
+}
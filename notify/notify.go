@@ -0,0 +1,21 @@
+// Package notify provides notification channels that delivers user
+// notifications to external destinations.
+package notify
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+// text returns plain text body of a notification.
+func text(n core.Notification) string {
+	var b strings.Builder
+	b.WriteString(n.Message)
+	if n.URL != "" {
+		fmt.Fprintf(&b, "\n\n%s", n.URL)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,101 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/gokit/http/safeclient"
+)
+
+func TestWebhook_Send(t *testing.T) {
+	var got map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		got = map[string]string{}
+		json.NewDecoder(r.Body).Decode(&got)
+	}))
+	defer srv.Close()
+
+	n := core.Notification{Subject: "Listing expired", Message: "Your listing has expired.", URL: "https://dotagiftx.com"}
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+		wantMsg bool
+	}{
+		{"no webhook url", "", false, false},
+		{"delivered", srv.URL + "/ok", false, true},
+		{"error response", srv.URL + "/fail", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got = nil
+			err := (&Webhook{srv.Client()}).Send(context.Background(), core.NotificationSetting{WebhookURL: tt.url}, n)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantMsg {
+				if got != nil {
+					t.Errorf("Send() posted unexpected payload %v", got)
+				}
+				return
+			}
+			if got["content"] == "" || got["content"] != got["text"] {
+				t.Errorf("Send() payload content = %q text = %q", got["content"], got["text"])
+			}
+			if !strings.Contains(got["content"], n.Subject) || !strings.Contains(got["content"], n.URL) {
+				t.Errorf("Send() payload %q missing subject or url", got["content"])
+			}
+		})
+	}
+}
+
+func TestWebhook_SendBlocksPrivateAddress(t *testing.T) {
+	var called bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+
+	err := NewWebhook().Send(context.Background(), core.NotificationSetting{WebhookURL: srv.URL}, core.Notification{})
+	if !safeclient.IsBlocked(err) || called {
+		t.Errorf("Send() error = %v called = %v, want blocked address", err, called)
+	}
+}
+
+func TestSMTP_MessageSubjectInjection(t *testing.T) {
+	s := NewSMTP(SMTPConfig{From: "noreply@dotagiftx.com"})
+	n := core.Notification{Subject: "Offer\r\nBcc: victim@example.com", Message: "hello"}
+	msg := string(s.message("user@example.com", n))
+
+	head := msg[:strings.Index(msg, "\r\n\r\n")]
+	for _, h := range strings.Split(head, "\r\n") {
+		if strings.HasPrefix(h, "Bcc:") {
+			t.Fatalf("message() injected header %q", h)
+		}
+	}
+	if !strings.Contains(head, "Subject: Offer Bcc: victim@example.com") {
+		t.Errorf("message() subject header missing in %q", head)
+	}
+}
+
+func TestSink_Send(t *testing.T) {
+	s := NewSink()
+	n := core.Notification{Event: core.NotificationEventMarketExpired, Subject: "test"}
+	if err := s.Send(context.Background(), core.NotificationSetting{UserID: "user-1"}, n); err != nil {
+		t.Fatal(err)
+	}
+
+	msgs := s.Messages()
+	if len(msgs) != 1 || msgs[0].UserID != "user-1" || msgs[0].Notification != n {
+		t.Errorf("Messages() = %v", msgs)
+	}
+}
@@ -0,0 +1,44 @@
+package notify
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+// SinkMessage represents notification captured by sink.
+type SinkMessage struct {
+	UserID       string
+	Notification core.Notification
+}
+
+// NewSink returns a notification channel that keeps sent notifications
+// in memory, use on local development and tests.
+func NewSink() *Sink {
+	return &Sink{}
+}
+
+// Sink represents in-memory notification channel.
+type Sink struct {
+	mu       sync.Mutex
+	messages []SinkMessage
+}
+
+func (s *Sink) Name() string { return "sink" }
+
+func (s *Sink) Send(_ context.Context, ns core.NotificationSetting, n core.Notification) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages = append(s.messages, SinkMessage{ns.UserID, n})
+	return nil
+}
+
+// Messages returns captured notifications.
+func (s *Sink) Messages() []SinkMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]SinkMessage{}, s.messages...)
+}
@@ -0,0 +1,72 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+// SMTPConfig represents SMTP email channel config.
+type SMTPConfig struct {
+	Addr string
+	From string
+	User string
+	Pass string
+}
+
+// NewSMTP returns email notification channel.
+func NewSMTP(c SMTPConfig) *SMTP {
+	return &SMTP{c}
+}
+
+// SMTP represents email notification channel.
+type SMTP struct {
+	config SMTPConfig
+}
+
+func (s *SMTP) Name() string { return "smtp" }
+
+// Send emails the notification when user has email address set.
+func (s *SMTP) Send(_ context.Context, ns core.NotificationSetting, n core.Notification) error {
+	if ns.Email == "" {
+		return nil
+	}
+
+	var auth smtp.Auth
+	if s.config.User != "" {
+		host, _, err := net.SplitHostPort(s.config.Addr)
+		if err != nil {
+			return fmt.Errorf("could not parse smtp address: %s", err)
+		}
+		auth = smtp.PlainAuth("", s.config.User, s.config.Pass, host)
+	}
+
+	return smtp.SendMail(s.config.Addr, auth, s.config.From, []string{ns.Email}, s.message(ns.Email, n))
+}
+
+func (s *SMTP) message(to string, n core.Notification) []byte {
+	h := []string{
+		"From: " + s.config.From,
+		"To: " + to,
+		"Subject: " + encodeHeader(n.Subject),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+	}
+
+	body := strings.ReplaceAll(text(n), "\n", "\r\n")
+	return []byte(strings.Join(h, "\r\n") + "\r\n\r\n" + body + "\r\n")
+}
+
+// encodeHeader replaces line breaks that could inject headers and encodes
+// non-ASCII text of a header value.
+func encodeHeader(v string) string {
+	v = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ").Replace(v)
+	return mime.QEncoding.Encode("utf-8", v)
+}
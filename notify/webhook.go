@@ -0,0 +1,63 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/gokit/http/safeclient"
+)
+
+const webhookTimeout = time.Second * 10
+
+// NewWebhook returns incoming webhook notification channel that only
+// connects to public addresses.
+func NewWebhook() *Webhook {
+	return &Webhook{safeclient.New(webhookTimeout)}
+}
+
+// Webhook represents Discord and Slack style incoming webhook channel.
+type Webhook struct {
+	client *http.Client
+}
+
+func (w *Webhook) Name() string { return "webhook" }
+
+// Send posts the notification when user has webhook URL set. Payload
+// contains both Discord "content" and Slack "text" fields.
+func (w *Webhook) Send(ctx context.Context, ns core.NotificationSetting, n core.Notification) error {
+	if ns.WebhookURL == "" {
+		return nil
+	}
+
+	msg := fmt.Sprintf("**%s**\n%s", n.Subject, text(n))
+	b, err := json.Marshal(map[string]string{
+		"content": msg,
+		"text":    msg,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ns.WebhookURL, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected webhook response status %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const tableAccessToken = "access_token"
+
+// NewAccessToken creates new instance of access token data store.
+func NewAccessToken(c *Client) core.AccessTokenStorage {
+	return &accessTokenStorage{c}
+}
+
+type accessTokenStorage struct {
+	db *Client
+}
+
+func (s *accessTokenStorage) Find(o core.FindOpts) ([]core.AccessToken, error) {
+	var res []core.AccessToken
+	if err := s.db.list(newFindOptsQuery(tableAccessToken, o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *accessTokenStorage) Get(id string) (*core.AccessToken, error) {
+	row := &core.AccessToken{}
+	if err := s.db.get(tableAccessToken, id, row); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, core.AccessTokenErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *accessTokenStorage) GetByHash(hash string) (*core.AccessToken, error) {
+	res, err := s.Find(core.FindOpts{Filter: core.AccessToken{Hash: hash}, Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, core.AccessTokenErrNotFound
+	}
+
+	return &res[0], nil
+}
+
+func (s *accessTokenStorage) Create(in *core.AccessToken) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(tableAccessToken, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *accessTokenStorage) Update(in *core.AccessToken) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	if err = s.db.update(tableAccessToken, in.ID, in); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
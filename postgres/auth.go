@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const tableAuth = "auth"
+
+// NewAuth creates new instance of auth data store.
+func NewAuth(c *Client) core.AuthStorage {
+	return &authStorage{c}
+}
+
+type authStorage struct {
+	db *Client
+}
+
+func (s *authStorage) Get(id string) (*core.Auth, error) {
+	row := &core.Auth{}
+	if err := s.db.get(tableAuth, id, row); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, core.AuthErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *authStorage) GetByUsername(username string) (*core.Auth, error) {
+	return s.findOne(core.Auth{Username: username})
+}
+
+func (s *authStorage) GetByUsernameAndPassword(username, password string) (*core.Auth, error) {
+	return s.findOne(core.Auth{Username: username, Password: password})
+}
+
+func (s *authStorage) GetByRefreshToken(refreshToken string) (*core.Auth, error) {
+	return s.findOne(core.Auth{RefreshToken: refreshToken})
+}
+
+func (s *authStorage) Create(in *core.Auth) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	id, err := s.db.insert(tableAuth, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *authStorage) Update(in *core.Auth) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	if err = s.db.update(tableAuth, in.ID, in); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err := mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func (s *authStorage) find(o core.FindOpts) ([]core.Auth, error) {
+	var res []core.Auth
+	if err := s.db.list(newFindOptsQuery(tableAuth, o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *authStorage) findOne(filter core.Auth) (*core.Auth, error) {
+	o := core.FindOpts{Filter: filter, Limit: 1}
+	res, err := s.find(o)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, core.AuthErrNotFound
+	}
+
+	return &res[0], nil
+}
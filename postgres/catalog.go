@@ -0,0 +1,276 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/fatih/structs"
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+	"github.com/kudarap/dotagiftx/gokit/log"
+)
+
+const (
+	tableCatalog     = "catalog"
+	catalogFieldSlug = "slug"
+)
+
+// NewCatalog creates new instance of catalog data store.
+func NewCatalog(c *Client, lg log.Logger) core.CatalogStorage {
+	return &catalogStorage{c, itemSearchFields, lg}
+}
+
+type catalogStorage struct {
+	db            *Client
+	keywordFields []string
+	logger        log.Logger
+}
+
+func (s *catalogStorage) Trending() ([]core.Catalog, error) {
+	// Date coverage for last 7 days.
+	const last7Days = -time.Hour * 24 * 7
+	endTime := time.Now()
+	startTime := endTime.Add(last7Days)
+
+	// Scoring rate values from item views, entry, and reservations.
+	stmt := fmt.Sprintf(`WITH views AS (
+			SELECT t.doc->>'item_id' AS item_id, count(*) AS score
+			FROM %[1]q t
+			WHERE t.doc->>'type' = $3 AND (t.doc->>'created_at')::timestamptz BETWEEN $1 AND $2
+			GROUP BY 1
+		), entries AS (
+			SELECT t.doc->>'item_id' AS item_id,
+				count(*) FILTER (WHERE %[3]s = %[5]d) AS entry,
+				count(*) FILTER (WHERE %[3]s = %[5]d AND %[4]s = %[7]d) AS reserved,
+				count(*) FILTER (WHERE %[3]s = %[5]d AND %[4]s = %[8]d) AS sold,
+				count(*) FILTER (WHERE %[3]s = %[6]d) AS bid
+			FROM %[2]q t
+			WHERE (t.doc->>'created_at')::timestamptz BETWEEN $1 AND $2
+			GROUP BY 1
+		), scores AS (
+			SELECT v.item_id, (
+				v.score * %[10]f +
+				coalesce(e.entry, 0) * %[11]f +
+				coalesce(e.reserved, 0) * %[12]d +
+				coalesce(e.sold, 0) * %[13]d +
+				coalesce(e.bid, 0) * %[14]d
+			) AS score
+			FROM views v LEFT JOIN entries e ON e.item_id = v.item_id
+		)
+		SELECT t.doc || jsonb_build_object('view_count', floor(s.score)::int)
+		FROM scores s JOIN %[9]q t ON t.id = s.item_id
+		ORDER BY s.score DESC LIMIT 10`,
+		tableTrack,
+		tableMarket,
+		intField(marketFieldType),
+		intField(marketFieldStatus),
+		core.MarketTypeAsk,
+		core.MarketTypeBid,
+		core.MarketStatusReserved,
+		core.MarketStatusSold,
+		tableCatalog,
+		core.TrendScoreRateView,
+		core.TrendScoreRateMarketEntry,
+		core.TrendScoreRateReserved,
+		core.TrendScoreRateSold,
+		core.TrendScoreRateBid,
+	)
+
+	var res []core.Catalog
+	if err := s.db.listRaw(&res, stmt, startTime, endTime, core.TrackTypeView); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *catalogStorage) Find(o core.FindOpts) ([]core.Catalog, error) {
+	var res []core.Catalog
+	o.KeywordFields = s.keywordFields
+	if err := s.db.list(newFindOptsQuery(tableCatalog, o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *catalogStorage) Count(o core.FindOpts) (num int, err error) {
+	o = core.FindOpts{
+		KeywordFields: s.keywordFields,
+		Keyword:       o.Keyword,
+		IDs:           o.IDs,
+		Filter:        o.Filter,
+		FilterExprs:   o.FilterExprs,
+	}
+	return s.db.count(newFindOptsQuery(tableCatalog, o))
+}
+
+func (s *catalogStorage) Facets(o core.FindOpts) (*core.CatalogFacets, error) {
+	o.KeywordFields = s.keywordFields
+	var res []core.Catalog
+	q := newFindOptsQuery(tableCatalog, core.CatalogFacetFindOpts(o))
+	if err := s.db.list(q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return core.NewCatalogFacets(res), nil
+}
+
+func (s *catalogStorage) Get(id string) (*core.Catalog, error) {
+	row, _ := s.getBySlug(id)
+	if row != nil {
+		return row, nil
+	}
+
+	row = &core.Catalog{}
+	if err := s.db.get(tableCatalog, id, row); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, core.CatalogErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *catalogStorage) getBySlug(slug string) (*core.Catalog, error) {
+	row := &core.Catalog{}
+	q := newQuery(tableCatalog).where(textField(catalogFieldSlug)+" = ?", slug)
+	if err := s.db.one(q, row); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, core.CatalogErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *catalogStorage) Index(itemID string) (*core.Catalog, error) {
+	bs := time.Now()
+	defer func() {
+		s.logger.Infof("catalog indexed %s @ %s\n", itemID, time.Now().Sub(bs))
+	}()
+
+	cat := &core.Catalog{}
+
+	// Get item details by item ID.
+	if err := s.db.get(tableItem, itemID, cat); err != nil {
+		return nil, errors.New(core.CatalogErrIndexing, err)
+	}
+
+	// Get market offers from LIVE status, buy orders from BID type and sales
+	// stats which calculated from RESERVED and SOLD statuses.
+	offer := fmt.Sprintf("%s = %d AND %s = %d AND coalesce(%s, 0) = %d",
+		intField(marketFieldType), core.MarketTypeAsk,
+		intField(marketFieldStatus), core.MarketStatusLive,
+		intField(marketFieldInventoryStatus), core.InventoryStatusVerified)
+	bid := fmt.Sprintf("%s = %d AND %s = %d",
+		intField(marketFieldType), core.MarketTypeBid,
+		intField(marketFieldStatus), core.MarketStatusLive)
+	sale := fmt.Sprintf("%s = %d AND %s IN (%d, %d)",
+		intField(marketFieldType), core.MarketTypeAsk,
+		intField(marketFieldStatus), core.MarketStatusReserved, core.MarketStatusSold)
+	reserved := fmt.Sprintf("%s = %d AND %s = %d",
+		intField(marketFieldType), core.MarketTypeAsk,
+		intField(marketFieldStatus), core.MarketStatusReserved)
+	price := fmt.Sprintf("coalesce(%s, 0)", numericField(marketFieldPrice))
+	createdAt := timeField(marketFieldCreatedAt)
+
+	stmt := fmt.Sprintf(`SELECT
+			count(*) FILTER (WHERE %[1]s),
+			coalesce(min(%[5]s) FILTER (WHERE %[1]s), 0),
+			coalesce(percentile_cont(0.5) WITHIN GROUP (ORDER BY %[5]s) FILTER (WHERE %[1]s), 0),
+			max(%[6]s) FILTER (WHERE %[1]s),
+			count(*) FILTER (WHERE %[2]s),
+			coalesce(max(%[5]s) FILTER (WHERE %[2]s), 0),
+			max(%[6]s) FILTER (WHERE %[2]s),
+			count(*) FILTER (WHERE %[3]s),
+			coalesce(avg(%[5]s) FILTER (WHERE %[3]s), 0),
+			max(%[6]s) FILTER (WHERE %[3]s),
+			count(*) FILTER (WHERE %[4]s)
+		FROM %[7]q t WHERE %[8]s = $1`,
+		offer, bid, sale, reserved, price, createdAt, tableMarket, textField(marketFieldItemID))
+
+	var recentAsk, recentBid, recentSale sql.NullTime
+	err := s.db.db.QueryRow(stmt, itemID).Scan(
+		&cat.Quantity, &cat.LowestAsk, &cat.MedianAsk, &recentAsk,
+		&cat.BidCount, &cat.HighestBid, &recentBid,
+		&cat.SaleCount, &cat.AvgSale, &recentSale,
+		&cat.ReservedCount,
+	)
+	if err != nil {
+		return nil, errors.New(core.CatalogErrIndexing, err)
+	}
+	cat.RecentAsk = nullTime(recentAsk)
+	cat.RecentBid = nullTime(recentBid)
+	cat.RecentSale = nullTime(recentSale)
+	cat.SoldCount = cat.SaleCount - cat.ReservedCount
+
+	// Check for exiting entry for update or create.
+	if cur, _ := s.Get(itemID); cur == nil {
+		err = s.create(cat)
+	} else {
+		err = s.update(cat)
+	}
+	if err != nil {
+		return nil, errors.New(core.CatalogErrIndexing, err)
+	}
+
+	return cat, nil
+}
+
+func (s *catalogStorage) create(in *core.Catalog) error {
+	// Fixes missing item in catalog that does not have views yet.
+	in.ViewCount = 1
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	// Convert catalog into map to insert zero value fields.
+	m := catalogToMap(in)
+
+	if _, err := s.db.insert(tableCatalog, m); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return nil
+}
+
+func (s *catalogStorage) update(in *core.Catalog) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	// Convert catalog into map to insert zero value fields.
+	m := catalogToMap(in)
+
+	if err = s.db.update(tableCatalog, in.ID, m); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func catalogToMap(cat *core.Catalog) map[string]interface{} {
+	s := structs.New(cat)
+	s.TagName = "json"
+	return s.Map()
+}
+
+func nullTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	return &t.Time
+}
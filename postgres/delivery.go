@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const (
+	tableDelivery         = "delivery"
+	deliveryFieldMarketID = "market_id"
+	deliveryFieldRetries  = "retries"
+)
+
+var deliverySearchFields = []string{"id", "market_id"}
+
+// NewDelivery creates new instance of delivery data store.
+func NewDelivery(c *Client) core.DeliveryStorage {
+	return &deliveryStorage{c, deliverySearchFields}
+}
+
+type deliveryStorage struct {
+	db            *Client
+	keywordFields []string
+}
+
+func (s *deliveryStorage) Find(o core.FindOpts) ([]core.Delivery, error) {
+	var res []core.Delivery
+	o.KeywordFields = s.keywordFields
+	if err := s.db.list(newFindOptsQuery(tableDelivery, o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *deliveryStorage) Count(o core.FindOpts) (num int, err error) {
+	o = core.FindOpts{
+		Keyword:       o.Keyword,
+		KeywordFields: s.keywordFields,
+		Filter:        o.Filter,
+		UserID:        o.UserID,
+	}
+	return s.db.count(newFindOptsQuery(tableDelivery, o))
+}
+
+func (s *deliveryStorage) ToVerify(o core.FindOpts) ([]core.Delivery, error) {
+	var res []core.Delivery
+	o.KeywordFields = s.keywordFields
+	q := baseFindOptsQuery(tableDelivery, o, func(q *query) {
+		q.where("coalesce("+intField(deliveryFieldRetries)+", 0) < ?", core.DeliveryRetryLimit)
+	})
+	if err := s.db.list(q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *deliveryStorage) Get(id string) (*core.Delivery, error) {
+	row := &core.Delivery{}
+	if err := s.db.get(tableDelivery, id, row); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, core.DeliveryErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *deliveryStorage) GetByMarketID(marketID string) (*core.Delivery, error) {
+	var res []core.Delivery
+	if err := s.db.list(newQuery(tableDelivery).where(textField(deliveryFieldMarketID)+" = ?", marketID), &res); err != nil {
+		return nil, err
+	}
+
+	if len(res) == 0 {
+		return nil, core.DeliveryErrNotFound
+	}
+
+	return &res[0], nil
+}
+
+func (s *deliveryStorage) Create(in *core.Delivery) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(tableDelivery, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *deliveryStorage) Update(in *core.Delivery) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	if err = s.db.update(tableDelivery, in.ID, in); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err := mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
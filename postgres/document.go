@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// document represents a stored record.
+type document map[string]interface{}
+
+// newDocument converts a model into a document using its db struct tags.
+func newDocument(in interface{}) (document, error) {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := document{}
+	if err = json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	doc.pruneEmpty()
+	return doc, nil
+}
+
+// merge updates document fields recursively like rethink update does.
+func (d document) merge(src document) {
+	for k, v := range src {
+		sm, ok := v.(map[string]interface{})
+		if !ok {
+			d[k] = v
+			continue
+		}
+
+		dm, ok := d[k].(map[string]interface{})
+		if !ok {
+			d[k] = v
+			continue
+		}
+		document(dm).merge(sm)
+	}
+}
+
+// pruneEmpty removes nested objects that only contains zero values since
+// struct fields are not covered by the omitempty tag option.
+func (d document) pruneEmpty() {
+	for k, v := range d {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		document(m).pruneEmpty()
+		if isEmptyDocument(m) {
+			delete(d, k)
+		}
+	}
+}
+
+func isEmptyDocument(m map[string]interface{}) bool {
+	for _, v := range m {
+		switch vv := v.(type) {
+		case nil:
+		case float64:
+			if vv != 0 {
+				return false
+			}
+		case string:
+			if vv != "" {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func generateID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	// Sets UUID version 4 and variant bits.
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -0,0 +1,288 @@
+package postgres
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/lib/pq"
+)
+
+// query represents a select statement on a document table aliased as "t".
+type query struct {
+	table   string
+	columns string
+	joins   []string
+	conds   []string
+	args    []interface{}
+	groupBy string
+	orderBy string
+	limit   int
+	offset  int
+}
+
+func newQuery(table string) *query {
+	return &query{table: table, columns: "t.doc"}
+}
+
+// where adds a condition and replaces its "?" placeholders with positional arguments.
+func (q *query) where(cond string, args ...interface{}) *query {
+	q.conds = append(q.conds, q.bind(cond, args...))
+	return q
+}
+
+// join adds a join clause and replaces its "?" placeholders with positional arguments.
+func (q *query) join(clause string, args ...interface{}) *query {
+	q.joins = append(q.joins, q.bind(clause, args...))
+	return q
+}
+
+func (q *query) bind(s string, args ...interface{}) string {
+	for _, a := range args {
+		q.args = append(q.args, a)
+		s = strings.Replace(s, "?", "$"+strconv.Itoa(len(q.args)), 1)
+	}
+
+	return s
+}
+
+func (q *query) from() string {
+	s := fmt.Sprintf(" FROM %s t", pq.QuoteIdentifier(q.table))
+	for _, j := range q.joins {
+		s += " " + j
+	}
+	if len(q.conds) != 0 {
+		s += " WHERE " + strings.Join(q.conds, " AND ")
+	}
+
+	return s
+}
+
+func (q *query) sql() (string, []interface{}) {
+	s := "SELECT " + q.columns + q.from()
+	if q.groupBy != "" {
+		s += " GROUP BY " + q.groupBy
+	}
+	if q.orderBy != "" {
+		s += " ORDER BY " + q.orderBy
+	}
+	if q.limit != 0 {
+		s += " LIMIT " + strconv.Itoa(q.limit)
+	}
+	if q.offset != 0 {
+		s += " OFFSET " + strconv.Itoa(q.offset)
+	}
+
+	return s, q.args
+}
+
+func (q *query) countSQL() (string, []interface{}) {
+	return "SELECT count(*)" + q.from(), q.args
+}
+
+type findOpts core.FindOpts
+
+func newFindOptsQuery(table string, o core.FindOpts) *query {
+	return baseFindOptsQuery(table, o, nil)
+}
+
+func baseFindOptsQuery(table string, o core.FindOpts, hookFn func(*query)) *query {
+	return findOpts(o).parseOpts(newQuery(table), hookFn)
+}
+
+func (o findOpts) parseOpts(q *query, hookFn func(*query)) *query {
+	if hookFn != nil {
+		hookFn(q)
+	}
+
+	if strings.TrimSpace(o.Keyword) != "" {
+		o.parseKeyword(q)
+	}
+
+	if o.IDs != nil {
+		q.where("t.id = ANY(?)", pq.Array(o.IDs))
+	}
+
+	if o.Filter != nil {
+		o.parseFilter(q)
+	}
+
+	if len(o.FilterExprs) != 0 {
+		o.parseFilterExprs(q)
+	}
+
+	if o.UserID != "" {
+		q.where("t.doc->>'user_id' = ?", o.UserID)
+	}
+
+	if o.Sort != "" && isField(o.Sort) {
+		if o.Cursor != nil {
+			o.parseCursor(q)
+		}
+		q.orderBy = o.parseOrder()
+	}
+
+	if o.Limit != 0 {
+		q.limit, q.offset = o.parseSlice()
+	}
+
+	if o.Fields != nil {
+		q.columns = o.parsePluck()
+	}
+
+	return q
+}
+
+func (o findOpts) parseKeyword(q *query) {
+	if len(o.KeywordFields) == 0 {
+		return
+	}
+
+	// Concatenate values of search fields to create a fake index.
+	var fields []string
+	for _, ff := range o.KeywordFields {
+		if isField(ff) {
+			fields = append(fields, docField(ff))
+		}
+	}
+	searchText := fmt.Sprintf("concat_ws(' ', %s)", strings.Join(fields, ", "))
+
+	// Matches that contains the keywords non case sensitive.
+	for _, ww := range strings.Split(normalizeKeyword(o.Keyword), " ") {
+		q.where(searchText+" ~* ?", ww)
+	}
+}
+
+// normalizeKeyword handles special case for the word "Collector's" with apostrophe.
+func normalizeKeyword(keyword string) string {
+	s := strings.ToLower(keyword)
+
+	// Special case for the word "Collector's" with apostrophe.
+	if strings.Contains(s, "collectors") {
+		s = strings.ReplaceAll(s, "collectors", "collector's")
+	}
+
+	return s
+}
+
+// parseFilter matches documents that contains the filter values.
+func (o findOpts) parseFilter(q *query) {
+	doc, err := newDocument(o.Filter)
+	if err != nil || len(doc) == 0 {
+		return
+	}
+
+	b, _ := json.Marshal(doc)
+	q.where("t.doc @> ?::jsonb", string(b))
+}
+
+// filterExprOps comparison operators of filter expressions.
+var filterExprOps = map[core.FilterOp]string{
+	core.FilterOpGt:  ">",
+	core.FilterOpGte: ">=",
+	core.FilterOpLt:  "<",
+	core.FilterOpLte: "<=",
+}
+
+// parseFilterExprs matches documents that satisfies all filter expressions,
+// field values are cast by its filter type.
+func (o findOpts) parseFilterExprs(q *query) {
+	for _, e := range o.FilterExprs {
+		if !isField(e.Field) {
+			continue
+		}
+
+		f, cast := fmt.Sprintf("t.doc->>'%s'", e.Field), "text"
+		switch e.Type {
+		case core.FilterTypeNumber:
+			cast = "numeric"
+		case core.FilterTypeTime:
+			cast = "timestamptz"
+		}
+		f = fmt.Sprintf("(%s)::%s", f, cast)
+
+		if e.Op == core.FilterOpIn {
+			q.where(fmt.Sprintf("%s = ANY(?::%s[])", f, cast), pq.Array(filterExprValues(e)))
+			continue
+		}
+		if op, ok := filterExprOps[e.Op]; ok {
+			q.where(fmt.Sprintf("%s %s ?::%s", f, op, cast), filterExprValues(e)[0])
+		}
+	}
+}
+
+// filterExprValues returns text values of filter expression that are cast
+// on comparison.
+func filterExprValues(e core.FilterExpr) []string {
+	var ss []string
+	for _, v := range e.Values {
+		switch vv := v.(type) {
+		case time.Time:
+			ss = append(ss, vv.Format(time.RFC3339Nano))
+		case float64:
+			ss = append(ss, strconv.FormatFloat(vv, 'f', -1, 64))
+		default:
+			ss = append(ss, fmt.Sprint(vv))
+		}
+	}
+
+	return ss
+}
+
+// parseOrder orders by sort field and id for records with the same value.
+func (o findOpts) parseOrder() string {
+	s := fmt.Sprintf("t.doc->'%s'", o.Sort)
+	if o.Desc {
+		return s + " DESC NULLS LAST, t.id DESC"
+	}
+
+	return s + " ASC NULLS FIRST, t.id ASC"
+}
+
+// parseCursor matches records that comes after the cursor record, on
+// descending order null values are placed last and comes after any value.
+func (o findOpts) parseCursor(q *query) {
+	v, _ := json.Marshal(o.Cursor.SortValue())
+	f := fmt.Sprintf("t.doc->'%s'", o.Sort)
+	if o.Desc {
+		q.where(fmt.Sprintf("((%s, t.id) < (?::jsonb, ?) OR %s IS NULL)", f, f), string(v), o.Cursor.ID)
+		return
+	}
+
+	q.where(fmt.Sprintf("(%s, t.id) > (?::jsonb, ?)", f), string(v), o.Cursor.ID)
+}
+
+func (o findOpts) parseSlice() (limit int, offset int) {
+	if o.Page < 1 || o.Cursor != nil {
+		o.Page = 1
+	}
+	o.Page--
+
+	return o.Limit, o.Page * o.Limit
+}
+
+func (o findOpts) parsePluck() string {
+	var pairs []string
+	for _, ff := range o.Fields {
+		if isField(ff) {
+			pairs = append(pairs, fmt.Sprintf("'%s', t.doc->'%s'", ff, ff))
+		}
+	}
+
+	return fmt.Sprintf("jsonb_strip_nulls(jsonb_build_object(%s))", strings.Join(pairs, ", "))
+}
+
+var fieldNameRe = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// isField checks field name is safe to be used on query.
+func isField(name string) bool {
+	return fieldNameRe.MatchString(name)
+}
+
+// docField returns text value expression of a document field.
+func docField(name string) string {
+	return fmt.Sprintf("coalesce(t.doc->>'%s', '')", name)
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/lib/pq"
+)
+
+func TestFindOpts_parseOpts(t *testing.T) {
+	tests := []struct {
+		name string
+		opts core.FindOpts
+		sql  string
+		args []interface{}
+	}{
+		{
+			"empty",
+			core.FindOpts{},
+			`SELECT t.doc FROM "market" t`,
+			nil,
+		},
+		{
+			"keyword",
+			core.FindOpts{Keyword: "Collectors Cache", KeywordFields: []string{"name", "hero"}},
+			`SELECT t.doc FROM "market" t WHERE concat_ws(' ', coalesce(t.doc->>'name', ''), coalesce(t.doc->>'hero', '')) ~* $1` +
+				` AND concat_ws(' ', coalesce(t.doc->>'name', ''), coalesce(t.doc->>'hero', '')) ~* $2`,
+			[]interface{}{"collector's", "cache"},
+		},
+		{
+			"filter and user scope",
+			core.FindOpts{Filter: core.Market{Status: core.MarketStatusLive}, UserID: "u1"},
+			`SELECT t.doc FROM "market" t WHERE t.doc @> $1::jsonb AND t.doc->>'user_id' = $2`,
+			[]interface{}{`{"status":200}`, "u1"},
+		},
+		{
+			"sort and page",
+			core.FindOpts{Sort: "price", Desc: true, Page: 3, Limit: 10},
+			`SELECT t.doc FROM "market" t ORDER BY t.doc->'price' DESC NULLS LAST, t.id DESC LIMIT 10 OFFSET 20`,
+			nil,
+		},
+		{
+			"cursor",
+			core.FindOpts{Sort: "price", Page: 3, Limit: 10, Cursor: &core.Cursor{Sort: "price", Value: 2.5, ID: "m1"}},
+			`SELECT t.doc FROM "market" t WHERE (t.doc->'price', t.id) > ($1::jsonb, $2) ORDER BY t.doc->'price' ASC NULLS FIRST, t.id ASC LIMIT 10`,
+			[]interface{}{"2.5", "m1"},
+		},
+		{
+			"descending cursor",
+			core.FindOpts{Sort: "price", Desc: true, Limit: 10, Cursor: &core.Cursor{Sort: "price", Desc: true, Value: 2.5, ID: "m1"}},
+			`SELECT t.doc FROM "market" t WHERE ((t.doc->'price', t.id) < ($1::jsonb, $2) OR t.doc->'price' IS NULL) ORDER BY t.doc->'price' DESC NULLS LAST, t.id DESC LIMIT 10`,
+			[]interface{}{"2.5", "m1"},
+		},
+		{
+			"filter expressions",
+			core.FindOpts{FilterExprs: []core.FilterExpr{
+				{Field: "price", Op: core.FilterOpGte, Type: core.FilterTypeNumber, Values: []interface{}{5.0}},
+				{Field: "created_at", Op: core.FilterOpLt, Type: core.FilterTypeTime, Values: []interface{}{time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)}},
+				{Field: "status", Op: core.FilterOpIn, Type: core.FilterTypeNumber, Values: []interface{}{200.0, 300.0}},
+			}},
+			`SELECT t.doc FROM "market" t WHERE (t.doc->>'price')::numeric >= $1::numeric` +
+				` AND (t.doc->>'created_at')::timestamptz < $2::timestamptz` +
+				` AND (t.doc->>'status')::numeric = ANY($3::numeric[])`,
+			[]interface{}{"5", "2023-05-01T00:00:00Z", pq.Array([]string{"200", "300"})},
+		},
+		{
+			"invalid sort field",
+			core.FindOpts{Sort: "price; DROP TABLE market"},
+			`SELECT t.doc FROM "market" t`,
+			nil,
+		},
+		{
+			"fields",
+			core.FindOpts{Fields: []string{"id", "price"}},
+			`SELECT jsonb_strip_nulls(jsonb_build_object('id', t.doc->'id', 'price', t.doc->'price')) FROM "market" t`,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args := newFindOptsQuery(tableMarket, tt.opts).sql()
+			if sql != tt.sql {
+				t.Errorf("sql() got = %v, want %v", sql, tt.sql)
+			}
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("sql() args = %v, want %v", args, tt.args)
+			}
+		})
+	}
+}
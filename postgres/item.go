@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"fmt"
+
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const (
+	tableItem     = "item"
+	itemFieldName = "name"
+	itemFieldSlug = "slug"
+)
+
+var itemSearchFields = []string{"name", "hero", "origin", "rarity"}
+
+// NewItem creates new instance of item data store.
+func NewItem(c *Client) core.ItemStorage {
+	return &itemStorage{c, itemSearchFields}
+}
+
+type itemStorage struct {
+	db            *Client
+	keywordFields []string
+}
+
+func (s *itemStorage) Find(o core.FindOpts) ([]core.Item, error) {
+	var res []core.Item
+	o.KeywordFields = s.keywordFields
+	if err := s.db.list(newFindOptsQuery(tableItem, o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *itemStorage) Count(o core.FindOpts) (num int, err error) {
+	o = core.FindOpts{
+		Keyword:       o.Keyword,
+		KeywordFields: s.keywordFields,
+		Filter:        o.Filter,
+		UserID:        o.UserID,
+	}
+	return s.db.count(newFindOptsQuery(tableItem, o))
+}
+
+func (s *itemStorage) Get(id string) (*core.Item, error) {
+	row, _ := s.GetBySlug(id)
+	if row != nil {
+		return row, nil
+	}
+
+	row = &core.Item{}
+	if err := s.db.get(tableItem, id, row); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, core.ItemErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *itemStorage) GetBySlug(slug string) (*core.Item, error) {
+	var res []core.Item
+	if err := s.db.list(newQuery(tableItem).where(textField(itemFieldSlug)+" = ?", slug), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+	if len(res) == 0 {
+		return nil, core.ItemErrNotFound
+	}
+
+	return &res[0], nil
+}
+
+func (s *itemStorage) Create(in *core.Item) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(tableItem, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *itemStorage) Update(in *core.Item) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	if err = s.db.update(tableItem, in.ID, in); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err := mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func (s *itemStorage) IsItemExist(name string) error {
+	// Matches exact name and non case sensitive.
+	q := newQuery(tableItem).where(textField(itemFieldName)+" ~* ?", fmt.Sprintf("^%s$", name))
+	n, err := s.db.count(q)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	if n != 0 {
+		return core.ItemErrCreateItemExists
+	}
+
+	return nil
+}
+
+func (s *itemStorage) AddViewCount(id string) error {
+	cur, err := s.Get(id)
+	if err != nil {
+		return err
+	}
+
+	cur.ViewCount++
+	if err := s.Update(cur); err != nil {
+		return err
+	}
+
+	if err := s.updateCatalogViewCount(id, cur.ViewCount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *itemStorage) updateCatalogViewCount(itemID string, viewCount int) error {
+	err := s.db.update(tableCatalog, itemID, &core.Catalog{ViewCount: viewCount})
+	if err == sql.ErrNoRows {
+		return nil
+	}
+
+	return err
+}
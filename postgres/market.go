@@ -0,0 +1,328 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const (
+	tableMarket                = "market"
+	marketFieldItemID          = "item_id"
+	marketFieldUserID          = "user_id"
+	marketFieldType            = "type"
+	marketFieldStatus          = "status"
+	marketFieldInventoryStatus = "inventory_status"
+	marketFieldDeliveryStatus  = "delivery_status"
+	marketFieldPrice           = "price"
+	marketFieldResell          = "resell"
+	marketFieldCreatedAt       = "created_at"
+	marketFieldUpdatedAt       = "updated_at"
+	// Hidden field for searching item details.
+	marketItemSearchTags = "search_text"
+)
+
+// NewMarket creates new instance of market data store.
+func NewMarket(c *Client) core.MarketStorage {
+	return &marketStorage{c, []string{marketItemSearchTags}}
+}
+
+type marketStorage struct {
+	db            *Client
+	keywordFields []string
+}
+
+func (s *marketStorage) Find(o core.FindOpts) ([]core.Market, error) {
+	var res []core.Market
+	o.KeywordFields = s.keywordFields
+	q := newFindOptsQuery(tableMarket, o)
+	if err := s.db.list(q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	for i, rr := range res {
+		res[i].User = s.includeUser(rr.UserID)
+	}
+
+	return res, nil
+}
+
+// PendingInventoryStatus returns market entries that is pending for checking
+// inventory status or needs re-processing of re-process error status.
+func (s *marketStorage) PendingInventoryStatus(o core.FindOpts) ([]core.Market, error) {
+	q := baseFindOptsQuery(tableMarket, o, func(q *query) {
+		q.where(fmt.Sprintf("(t.doc->'%s' IS NULL OR %s = ?)",
+			marketFieldInventoryStatus, intField(marketFieldInventoryStatus)), core.InventoryStatusError)
+		q.where(intField(marketFieldStatus)+" IN (?, ?)", core.MarketStatusLive, core.MarketStatusReserved)
+		q.where(intField(marketFieldType)+" = ?", core.MarketTypeAsk)
+		s.includeRelatedFields(q)
+	})
+
+	var res []core.Market
+	if err := s.db.list(q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+// PendingDeliveryStatus returns market entries that is pending for checking
+// delivery status or needs re-processing of re-process error status.
+func (s *marketStorage) PendingDeliveryStatus(o core.FindOpts) ([]core.Market, error) {
+	q := baseFindOptsQuery(tableMarket, o, func(q *query) {
+		q.where(fmt.Sprintf("(t.doc->'%s' IS NULL OR %s = ?)",
+			marketFieldDeliveryStatus, intField(marketFieldDeliveryStatus)), core.DeliveryStatusError)
+		s.includeRelatedFields(q)
+	})
+
+	var res []core.Market
+	if err := s.db.list(q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *marketStorage) RevalidateDeliveryStatus(o core.FindOpts) ([]core.Market, error) {
+	q := baseFindOptsQuery(tableMarket, o, func(q *query) {
+		q.where(intField(marketFieldStatus)+" = ?", core.MarketStatusSold)
+		q.where(timeField(marketFieldUpdatedAt) + "::date = current_date")
+		q.where(intField(marketFieldDeliveryStatus)+" IN (?, ?)", core.DeliveryStatusNoHit, core.DeliveryStatusPrivate)
+		s.includeRelatedFields(q)
+	})
+
+	var res []core.Market
+	if err := s.db.list(q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *marketStorage) Count(o core.FindOpts) (num int, err error) {
+	o = core.FindOpts{
+		Keyword:       o.Keyword,
+		IDs:           o.IDs,
+		KeywordFields: s.keywordFields,
+		Filter:        o.Filter,
+		FilterExprs:   o.FilterExprs,
+		UserID:        o.UserID,
+	}
+	return s.db.count(newFindOptsQuery(tableMarket, o))
+}
+
+// includeRelatedFields injects user details base on market foreign keys.
+func (s *marketStorage) includeRelatedFields(q *query) {
+	q.joinUser(marketFieldUserID)
+}
+
+func (s *marketStorage) Get(id string) (*core.Market, error) {
+	row := &core.Market{}
+	if err := s.db.get(tableMarket, id, row); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, core.MarketErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	row.User = s.includeUser(row.UserID)
+	return row, nil
+}
+
+func (s *marketStorage) includeUser(userID string) *core.User {
+	var user core.User
+	_ = s.db.get(tableUser, userID, &user)
+	return &user
+}
+
+func (s *marketStorage) Index(id string) (*core.Market, error) {
+	mkt, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var item core.Item
+	_ = s.db.get(tableItem, mkt.ItemID, &item)
+	mkt.Item = &item
+
+	var invs []core.Inventory
+	_ = s.db.list(newQuery(tableInventory).where(textField(inventoryFieldMarketID)+" = ?", mkt.ID), &invs)
+	if len(invs) != 0 {
+		mkt.Inventory = &invs[0]
+	}
+
+	var dels []core.Delivery
+	_ = s.db.list(newQuery(tableDelivery).where(textField(deliveryFieldMarketID)+" = ?", mkt.ID), &dels)
+	if len(dels) != 0 {
+		mkt.Delivery = &dels[0]
+	}
+
+	mkt.SearchText = mkt.Notes
+	if mkt.Item != nil {
+		mkt.SearchText += strings.Join([]string{
+			"",
+			mkt.Item.Name,
+			mkt.Item.Hero,
+			mkt.Item.Origin,
+			mkt.Item.Rarity,
+		}, " ")
+	}
+
+	if err = s.BaseUpdate(mkt); err != nil {
+		return nil, err
+	}
+
+	return mkt, nil
+}
+
+func (s *marketStorage) Create(in *core.Market) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	in.User = nil
+	in.Item = nil
+	id, err := s.db.insert(tableMarket, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *marketStorage) Update(in *core.Market) error {
+	in.UpdatedAt = now()
+	return s.BaseUpdate(in)
+}
+
+func (s *marketStorage) UpdateUserScore(userID string, rankScore int) error {
+	if userID == "" {
+		return fmt.Errorf("user id is required to update user score")
+	}
+
+	// Sets new user rank score of all user live market.
+	stmt := fmt.Sprintf(`UPDATE %q t SET doc = t.doc || jsonb_build_object('user_rank_score', $1::int)
+		WHERE %s = $2 AND %s = $3`, tableMarket, textField(marketFieldUserID), intField(marketFieldStatus))
+	if err := s.db.exec(stmt, rankScore, userID, core.MarketStatusLive); err != nil {
+		return fmt.Errorf("could not update market user rank: %s", err)
+	}
+
+	return nil
+}
+
+func (s *marketStorage) BaseUpdate(in *core.Market) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.User = nil
+	if err = s.db.update(tableMarket, in.ID, in); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func (s *marketStorage) UpdateIfStatus(in *core.Market, status core.MarketStatus) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.User = nil
+	in.UpdatedAt = now()
+	ok, err := s.db.updateIf(tableMarket, in.ID, in, func(d document) bool {
+		st, _ := d[marketFieldStatus].(float64)
+		return core.MarketStatus(st) == status
+	})
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	if !ok {
+		return core.MarketErrStatusChanged
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func (s *marketStorage) UpdateExpiring(t core.MarketType, b core.UserBoon, cutOff time.Time) ([]core.Market, error) {
+	now := time.Now()
+	expired, err := newDocument(core.Market{Status: core.MarketStatusExpired, UpdatedAt: &now})
+	if err != nil {
+		return nil, err
+	}
+	eb, _ := json.Marshal(expired)
+
+	// Sets expired entry state base on cutOff time, skips exempted users
+	// and returns affected markets.
+	stmt := fmt.Sprintf(`UPDATE %q t SET doc = t.doc || $1::jsonb
+		WHERE %s = $2 AND %s = $3 AND %s < $4
+		AND %s NOT IN (SELECT u.id FROM %q u WHERE u.doc->'boons' @> jsonb_build_array($5::text))
+		RETURNING t.doc`,
+		tableMarket,
+		intField(marketFieldStatus),
+		intField(marketFieldType),
+		timeField(marketFieldCreatedAt),
+		textField(marketFieldUserID),
+		tableUser,
+	)
+	var res []core.Market
+	if err = s.db.listRaw(&res, stmt, string(eb), core.MarketStatusLive, t, cutOff, string(b)); err != nil {
+		return nil, fmt.Errorf("could not update expiring markets: %s", err)
+	}
+
+	return res, nil
+}
+
+func (s *marketStorage) BulkDeleteByStatus(ms core.MarketStatus, cutOff time.Time, limit int) ([]core.Market, error) {
+	if ms != core.MarketStatusRemoved && ms != core.MarketStatusExpired {
+		return nil, fmt.Errorf("market status %s not allowed to bulk delete", ms)
+	}
+
+	stmt := fmt.Sprintf(`DELETE FROM %[1]q WHERE id IN (
+		SELECT t.id FROM %[1]q t WHERE %[2]s = $1 AND %[3]s < $2 LIMIT $3)
+		RETURNING doc`,
+		tableMarket, intField(marketFieldStatus), timeField(marketFieldCreatedAt))
+	var res []core.Market
+	if err := s.db.listRaw(&res, stmt, ms, cutOff, limit); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// textField returns text value expression of a document field.
+func textField(name string) string {
+	return fmt.Sprintf("t.doc->>'%s'", name)
+}
+
+// intField returns integer value expression of a document field.
+func intField(name string) string {
+	return fmt.Sprintf("(t.doc->>'%s')::int", name)
+}
+
+// numericField returns numeric value expression of a document field.
+func numericField(name string) string {
+	return fmt.Sprintf("(t.doc->>'%s')::numeric", name)
+}
+
+// timeField returns timestamp value expression of a document field.
+func timeField(name string) string {
+	return fmt.Sprintf("(t.doc->>'%s')::timestamptz", name)
+}
@@ -0,0 +1,273 @@
+package postgres
+
+import (
+	_ "embed"
+	"fmt"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+	"github.com/kudarap/dotagiftx/migration"
+)
+
+const (
+	tableMigration     = "migration"
+	migrationFieldName = "name"
+)
+
+const migrationSchema = `CREATE TABLE IF NOT EXISTS "migration" (
+    id  TEXT PRIMARY KEY,
+    doc JSONB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS migration_name_idx ON "migration" ((doc->>'name'));`
+
+//go:embed schema.sql
+var schema string
+
+// NewMigration creates new instance of migration data store.
+func NewMigration(c *Client) core.MigrationStorage {
+	return &migrationStorage{c}
+}
+
+type migrationStorage struct {
+	db *Client
+}
+
+func (s *migrationStorage) Find() ([]core.Migration, error) {
+	var res []core.Migration
+	if err := s.db.list(newQuery(tableMigration), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *migrationStorage) Create(in *core.Migration) error {
+	in.ID = ""
+	in.AppliedAt = now()
+	id, err := s.db.insert(tableMigration, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *migrationStorage) Delete(name string) error {
+	stmt := fmt.Sprintf("DELETE FROM %q t WHERE %s = $1", tableMigration, textField(migrationFieldName))
+	if err := s.db.exec(stmt, name); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return nil
+}
+
+// Migrations returns postgres schema migrations that creates tables and indexes.
+func Migrations(c *Client) []migration.Migration {
+	return []migration.Migration{
+		{
+			Name: "0001_create_tables",
+			Up: func() error {
+				return c.exec(schema)
+			},
+			// Baseline tables holds production records and are never dropped.
+			Down: func() error {
+				return migration.ErrIrreversible
+			},
+		},
+		{
+			Name: "0002_create_market_history",
+			Up: func() error {
+				return c.exec(`CREATE TABLE IF NOT EXISTS "market_history" (
+					id  TEXT PRIMARY KEY,
+					doc JSONB NOT NULL
+				);
+				CREATE INDEX IF NOT EXISTS market_history_market_id_idx ON "market_history" ((doc->>'market_id'));`)
+			},
+			Down: func() error {
+				return c.exec(`DROP TABLE IF EXISTS "market_history"`)
+			},
+		},
+		{
+			Name: "0003_create_webhooks",
+			Up: func() error {
+				return c.exec(`CREATE TABLE IF NOT EXISTS "webhook" (
+					id  TEXT PRIMARY KEY,
+					doc JSONB NOT NULL
+				);
+				CREATE INDEX IF NOT EXISTS webhook_user_id_idx ON "webhook" ((doc->>'user_id'));
+				CREATE TABLE IF NOT EXISTS "webhook_delivery" (
+					id  TEXT PRIMARY KEY,
+					doc JSONB NOT NULL
+				);
+				CREATE INDEX IF NOT EXISTS webhook_delivery_webhook_id_idx ON "webhook_delivery" ((doc->>'webhook_id'));
+				CREATE INDEX IF NOT EXISTS webhook_delivery_status_idx ON "webhook_delivery" ((doc->>'status'));`)
+			},
+			Down: func() error {
+				return c.exec(`DROP TABLE IF EXISTS "webhook_delivery", "webhook"`)
+			},
+		},
+		{
+			Name: "0004_create_notification_settings",
+			Up: func() error {
+				return c.exec(`CREATE TABLE IF NOT EXISTS "notification_setting" (
+					id  TEXT PRIMARY KEY,
+					doc JSONB NOT NULL
+				);
+				CREATE INDEX IF NOT EXISTS notification_setting_user_id_idx ON "notification_setting" ((doc->>'user_id'));`)
+			},
+			Down: func() error {
+				return c.exec(`DROP TABLE IF EXISTS "notification_setting"`)
+			},
+		},
+		{
+			Name: "0005_create_watchlists",
+			Up: func() error {
+				return c.exec(`CREATE TABLE IF NOT EXISTS "watchlist" (
+					id  TEXT PRIMARY KEY,
+					doc JSONB NOT NULL
+				);
+				CREATE INDEX IF NOT EXISTS watchlist_user_id_idx ON "watchlist" ((doc->>'user_id'));
+				CREATE INDEX IF NOT EXISTS watchlist_item_id_idx ON "watchlist" ((doc->>'item_id'));
+				CREATE TABLE IF NOT EXISTS "watchlist_alert" (
+					id  TEXT PRIMARY KEY,
+					doc JSONB NOT NULL
+				);
+				CREATE INDEX IF NOT EXISTS watchlist_alert_user_id_idx ON "watchlist_alert" ((doc->>'user_id'));`)
+			},
+			Down: func() error {
+				return c.exec(`DROP TABLE IF EXISTS "watchlist_alert", "watchlist"`)
+			},
+		},
+		{
+			Name: "0006_create_market_matches",
+			Up: func() error {
+				return c.exec(`CREATE TABLE IF NOT EXISTS "market_match" (
+					id  TEXT PRIMARY KEY,
+					doc JSONB NOT NULL
+				);
+				CREATE INDEX IF NOT EXISTS market_match_seller_id_idx ON "market_match" ((doc->>'seller_id'));
+				CREATE INDEX IF NOT EXISTS market_match_buyer_id_idx ON "market_match" ((doc->>'buyer_id'));
+				CREATE INDEX IF NOT EXISTS market_match_status_idx ON "market_match" ((doc->>'status'));`)
+			},
+			Down: func() error {
+				return c.exec(`DROP TABLE IF EXISTS "market_match"`)
+			},
+		},
+		{
+			Name: "0007_create_offers",
+			Up: func() error {
+				return c.exec(`CREATE TABLE IF NOT EXISTS "offer" (
+					id  TEXT PRIMARY KEY,
+					doc JSONB NOT NULL
+				);
+				CREATE INDEX IF NOT EXISTS offer_market_id_idx ON "offer" ((doc->>'market_id'));
+				CREATE INDEX IF NOT EXISTS offer_seller_id_idx ON "offer" ((doc->>'seller_id'));
+				CREATE INDEX IF NOT EXISTS offer_buyer_id_idx ON "offer" ((doc->>'buyer_id'));
+				CREATE INDEX IF NOT EXISTS offer_status_idx ON "offer" ((doc->>'status'));`)
+			},
+			Down: func() error {
+				return c.exec(`DROP TABLE IF EXISTS "offer"`)
+			},
+		},
+		{
+			Name: "0008_create_exchange_rates",
+			Up: func() error {
+				return c.exec(`CREATE TABLE IF NOT EXISTS "exchange_rate" (
+					id  TEXT PRIMARY KEY,
+					doc JSONB NOT NULL
+				);
+				CREATE UNIQUE INDEX IF NOT EXISTS exchange_rate_currency_idx ON "exchange_rate" ((doc->>'currency'));`)
+			},
+			Down: func() error {
+				return c.exec(`DROP TABLE IF EXISTS "exchange_rate"`)
+			},
+		},
+		{
+			Name: "0009_create_price_candles",
+			Up: func() error {
+				return c.exec(`CREATE TABLE IF NOT EXISTS "price_candle" (
+					id  TEXT PRIMARY KEY,
+					doc JSONB NOT NULL
+				);
+				CREATE UNIQUE INDEX IF NOT EXISTS price_candle_key_idx ON "price_candle" ((doc->>'key'));
+				CREATE INDEX IF NOT EXISTS price_candle_item_id_idx ON "price_candle" ((doc->>'item_id'));
+				CREATE INDEX IF NOT EXISTS price_candle_time_idx ON "price_candle" ((doc->>'time'));`)
+			},
+			Down: func() error {
+				return c.exec(`DROP TABLE IF EXISTS "price_candle"`)
+			},
+		},
+		{
+			Name: "0010_create_synonyms",
+			Up: func() error {
+				return c.exec(`CREATE TABLE IF NOT EXISTS "synonym" (
+					id  TEXT PRIMARY KEY,
+					doc JSONB NOT NULL
+				);
+				CREATE UNIQUE INDEX IF NOT EXISTS synonym_term_idx ON "synonym" ((doc->>'term'));`)
+			},
+			Down: func() error {
+				return c.exec(`DROP TABLE IF EXISTS "synonym"`)
+			},
+		},
+		{
+			Name: "0011_create_access_tokens",
+			Up: func() error {
+				return c.exec(`CREATE TABLE IF NOT EXISTS "access_token" (
+					id  TEXT PRIMARY KEY,
+					doc JSONB NOT NULL
+				);
+				CREATE INDEX IF NOT EXISTS access_token_user_id_idx ON "access_token" ((doc->>'user_id'));
+				CREATE UNIQUE INDEX IF NOT EXISTS access_token_hash_idx ON "access_token" ((doc->>'hash'));`)
+			},
+			Down: func() error {
+				return c.exec(`DROP TABLE IF EXISTS "access_token"`)
+			},
+		},
+		{
+			Name: "0012_create_role_audits",
+			Up: func() error {
+				return c.exec(`CREATE TABLE IF NOT EXISTS "role_audit" (
+					id  TEXT PRIMARY KEY,
+					doc JSONB NOT NULL
+				);
+				CREATE INDEX IF NOT EXISTS role_audit_user_id_idx ON "role_audit" ((doc->>'user_id'));
+				CREATE INDEX IF NOT EXISTS role_audit_actor_id_idx ON "role_audit" ((doc->>'actor_id'));`)
+			},
+			Down: func() error {
+				return c.exec(`DROP TABLE IF EXISTS "role_audit"`)
+			},
+		},
+		{
+			// Replaces indexes that did not match query expressions, finds
+			// filters by document containment and int fields are cast.
+			Name: "0013_match_query_indexes",
+			Up: func() error {
+				return c.exec(`DROP INDEX IF EXISTS market_status_idx;
+				CREATE INDEX IF NOT EXISTS market_type_status_idx ON "market" (((doc->>'type')::int), ((doc->>'status')::int));
+				CREATE INDEX IF NOT EXISTS user_status_idx ON "user" (((doc->>'status')::int));
+				DROP INDEX IF EXISTS webhook_delivery_status_idx;
+				CREATE INDEX IF NOT EXISTS webhook_delivery_status_idx ON "webhook_delivery" (((doc->>'status')::int));
+				DROP INDEX IF EXISTS market_match_seller_id_idx, market_match_buyer_id_idx, market_match_status_idx;
+				CREATE INDEX IF NOT EXISTS market_match_doc_idx ON "market_match" USING GIN (doc jsonb_path_ops);
+				DROP INDEX IF EXISTS offer_market_id_idx, offer_seller_id_idx, offer_buyer_id_idx, offer_status_idx;
+				CREATE INDEX IF NOT EXISTS offer_doc_idx ON "offer" USING GIN (doc jsonb_path_ops);
+				DROP INDEX IF EXISTS watchlist_item_id_idx;
+				CREATE INDEX IF NOT EXISTS watchlist_doc_idx ON "watchlist" USING GIN (doc jsonb_path_ops);
+				CREATE INDEX IF NOT EXISTS webhook_doc_idx ON "webhook" USING GIN (doc jsonb_path_ops);
+				DROP INDEX IF EXISTS price_candle_item_id_idx, price_candle_time_idx;
+				CREATE INDEX IF NOT EXISTS price_candle_doc_idx ON "price_candle" USING GIN (doc jsonb_path_ops);
+				CREATE INDEX IF NOT EXISTS price_candle_time_idx ON "price_candle" ((doc->'time'));
+				CREATE INDEX IF NOT EXISTS access_token_doc_idx ON "access_token" USING GIN (doc jsonb_path_ops);
+				CREATE INDEX IF NOT EXISTS role_audit_doc_idx ON "role_audit" USING GIN (doc jsonb_path_ops);`)
+			},
+			Down: func() error {
+				return c.exec(`DROP INDEX IF EXISTS market_type_status_idx, user_status_idx, market_match_doc_idx,
+					offer_doc_idx, watchlist_doc_idx, webhook_doc_idx, price_candle_doc_idx, access_token_doc_idx,
+					role_audit_doc_idx;
+				CREATE INDEX IF NOT EXISTS market_status_idx ON "market" ((doc->'status'));`)
+			},
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const (
+	tableNotification       = "notification_setting"
+	notificationFieldUserID = "user_id"
+)
+
+// NewNotification creates new instance of notification setting data store.
+func NewNotification(c *Client) core.NotificationStorage {
+	return &notificationStorage{c}
+}
+
+type notificationStorage struct {
+	db *Client
+}
+
+func (s *notificationStorage) GetByUserID(userID string) (*core.NotificationSetting, error) {
+	var res []core.NotificationSetting
+	q := newQuery(tableNotification).where(textField(notificationFieldUserID)+" = ?", userID)
+	if err := s.db.list(q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if len(res) == 0 {
+		return nil, core.NotificationErrNotFound
+	}
+
+	return &res[0], nil
+}
+
+func (s *notificationStorage) get(id string) (*core.NotificationSetting, error) {
+	row := &core.NotificationSetting{}
+	if err := s.db.get(tableNotification, id, row); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, core.NotificationErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *notificationStorage) Create(in *core.NotificationSetting) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(tableNotification, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *notificationStorage) Update(in *core.NotificationSetting) error {
+	cur, err := s.get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	if err = s.db.update(tableNotification, in.ID, in); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
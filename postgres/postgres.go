@@ -0,0 +1,233 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/lib/pq"
+)
+
+const tagName = "db"
+
+// json encodes records using the same db struct tag as the rethink storage so
+// documents keep the exact same shape on both databases.
+var json = jsoniter.Config{TagKey: tagName}.Froze()
+
+// Config represents postgres database config.
+type Config struct {
+	Addr    string
+	Name    string
+	User    string
+	Pass    string
+	SSLMode string
+}
+
+// DSN returns postgres connection string.
+func (c Config) DSN() string {
+	host := c.Addr
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		host = net.JoinHostPort(host, "5432")
+	}
+
+	sslMode := c.SSLMode
+	if sslMode == "" {
+		sslMode = "disable"
+	}
+
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(c.User, c.Pass),
+		Host:     host,
+		Path:     c.Name,
+		RawQuery: url.Values{"sslmode": {sslMode}}.Encode(),
+	}
+	return u.String()
+}
+
+// Client represents postgres database client.
+type Client struct {
+	db *sql.DB
+}
+
+// New create new postgres database instance.
+func New(c Config) (*Client, error) {
+	db, err := sql.Open("postgres", c.DSN())
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(10)
+	db.SetMaxIdleConns(10)
+	db.SetConnMaxIdleTime(time.Minute)
+
+	if err = db.Ping(); err != nil {
+		return nil, err
+	}
+
+	cl := &Client{db}
+	if err = cl.autoMigrate(); err != nil {
+		return nil, fmt.Errorf("could not create migration table: %s", err)
+	}
+
+	return cl, nil
+}
+
+// Close closes postgres database connections.
+func (c *Client) Close() error {
+	return c.db.Close()
+}
+
+// autoMigrate creates migration table that tracks schema changes. Tables
+// and indexes are created by schema migrations, see Migrations.
+func (c *Client) autoMigrate() error {
+	_, err := c.db.Exec(migrationSchema)
+	return err
+}
+
+// list decodes all documents returned by the query into out.
+func (c *Client) list(q *query, out interface{}) error {
+	stmt, args := q.sql()
+	return c.listRaw(out, stmt, args...)
+}
+
+// listRaw decodes all documents returned by the statement into out.
+func (c *Client) listRaw(out interface{}, stmt string, args ...interface{}) error {
+	rows, err := c.db.Query(stmt, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var docs []string
+	for rows.Next() {
+		var doc []byte
+		if err = rows.Scan(&doc); err != nil {
+			return err
+		}
+		docs = append(docs, string(doc))
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	return json.UnmarshalFromString("["+strings.Join(docs, ",")+"]", out)
+}
+
+// one decodes the first document returned by the query into out and
+// returns sql.ErrNoRows when its empty.
+func (c *Client) one(q *query, out interface{}) error {
+	stmt, args := q.sql()
+	var doc []byte
+	if err := c.db.QueryRow(stmt, args...).Scan(&doc); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(doc, out)
+}
+
+func (c *Client) count(q *query) (num int, err error) {
+	stmt, args := q.countSQL()
+	err = c.db.QueryRow(stmt, args...).Scan(&num)
+	return
+}
+
+func (c *Client) get(table, id string, out interface{}) error {
+	return c.one(newQuery(table).where("t.id = ?", id), out)
+}
+
+// insert persists a new document and generates its id when not provided.
+func (c *Client) insert(table string, in interface{}) (id string, err error) {
+	doc, err := newDocument(in)
+	if err != nil {
+		return "", err
+	}
+
+	id, _ = doc["id"].(string)
+	if id == "" {
+		id = generateID()
+		doc["id"] = id
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	stmt := fmt.Sprintf("INSERT INTO %s (id, doc) VALUES ($1, $2::jsonb)", pq.QuoteIdentifier(table))
+	if _, err = c.db.Exec(stmt, id, string(b)); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// update merges non-empty fields of the input into the stored document.
+func (c *Client) update(table, id string, in interface{}) error {
+	_, err := c.updateIf(table, id, in, nil)
+	return err
+}
+
+// updateIf merges non-empty fields of the input into the stored document
+// when the locked document passes the condition, nil condition always passes.
+func (c *Client) updateIf(table, id string, in interface{}, cond func(document) bool) (updated bool, err error) {
+	return c.updateFunc(table, id, func(cur document) (interface{}, bool) {
+		if cond != nil && !cond(cur) {
+			return nil, false
+		}
+		return in, true
+	})
+}
+
+// updateFunc merges non-empty fields of the input returned by fn from the
+// locked document into it, fn skips the update by returning false.
+func (c *Client) updateFunc(table, id string, fn func(document) (interface{}, bool)) (updated bool, err error) {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var b []byte
+	stmt := fmt.Sprintf("SELECT doc FROM %s WHERE id = $1 FOR UPDATE", pq.QuoteIdentifier(table))
+	if err = tx.QueryRow(stmt, id).Scan(&b); err != nil {
+		return false, err
+	}
+	cur := document{}
+	if err = json.Unmarshal(b, &cur); err != nil {
+		return false, err
+	}
+	in, ok := fn(cur)
+	if !ok {
+		return false, nil
+	}
+	doc, err := newDocument(in)
+	if err != nil {
+		return false, err
+	}
+	delete(doc, "id")
+	cur.merge(doc)
+
+	if b, err = json.Marshal(cur); err != nil {
+		return false, err
+	}
+	stmt = fmt.Sprintf("UPDATE %s SET doc = $2::jsonb WHERE id = $1", pq.QuoteIdentifier(table))
+	if _, err = tx.Exec(stmt, id, string(b)); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+func (c *Client) exec(stmt string, args ...interface{}) error {
+	_, err := c.db.Exec(stmt, args...)
+	return err
+}
+
+func now() *time.Time {
+	t := time.Now()
+	return &t
+}
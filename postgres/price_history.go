@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const tablePriceCandle = "price_candle"
+
+// NewPriceHistory creates new instance of price history data store.
+func NewPriceHistory(c *Client) core.PriceHistoryStorage {
+	return &priceHistoryStorage{c}
+}
+
+type priceHistoryStorage struct {
+	db *Client
+}
+
+func (s *priceHistoryStorage) Find(o core.FindOpts) ([]core.PriceCandle, error) {
+	var res []core.PriceCandle
+	if err := s.db.list(newFindOptsQuery(tablePriceCandle, o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *priceHistoryStorage) Get(id string) (*core.PriceCandle, error) {
+	row := &core.PriceCandle{}
+	if err := s.db.get(tablePriceCandle, id, row); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, core.PriceHistoryErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *priceHistoryStorage) Create(in *core.PriceCandle) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = in.Key
+	id, err := s.db.insert(tablePriceCandle, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *priceHistoryStorage) Update(in *core.PriceCandle) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	if err = s.db.update(tablePriceCandle, in.ID, in); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+// AddPrice inserts the candle or updates the stored one by its unique key
+// index within a single statement.
+func (s *priceHistoryStorage) AddPrice(in *core.PriceCandle, price float64) error {
+	c := *in
+	c.ID = c.Key
+	c.Volume = 0
+	c.Add(price)
+	t := now()
+	c.CreatedAt = t
+	c.UpdatedAt = t
+	doc, err := newDocument(c)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	stmt := fmt.Sprintf(`INSERT INTO %q AS t (id, doc) VALUES ($1, $2::jsonb)
+		ON CONFLICT ((doc->>'key')) DO UPDATE SET doc = t.doc || jsonb_build_object(
+			'high', GREATEST((t.doc->>'high')::numeric, $3::numeric),
+			'low', LEAST((t.doc->>'low')::numeric, $3::numeric),
+			'close', $3::numeric,
+			'volume', COALESCE((t.doc->>'volume')::int, 0) + 1,
+			'updated_at', EXCLUDED.doc->'updated_at')`, tablePriceCandle)
+	if err = s.db.exec(stmt, c.ID, string(b), price); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+-- DotagiftX postgres schema.
+--
+-- Records are stored as JSONB documents keyed by the same field names as the
+-- db struct tags, which keeps this storage interchangeable with rethink.
+
+CREATE TABLE IF NOT EXISTS "auth" (
+    id  TEXT PRIMARY KEY,
+    doc JSONB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS auth_doc_idx ON "auth" USING GIN (doc jsonb_path_ops);
+
+CREATE TABLE IF NOT EXISTS "user" (
+    id  TEXT PRIMARY KEY,
+    doc JSONB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS user_doc_idx ON "user" USING GIN (doc jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS user_steam_id_idx ON "user" ((doc->>'steam_id'));
+
+CREATE TABLE IF NOT EXISTS "item" (
+    id  TEXT PRIMARY KEY,
+    doc JSONB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS item_doc_idx ON "item" USING GIN (doc jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS item_slug_idx ON "item" ((doc->>'slug'));
+
+CREATE TABLE IF NOT EXISTS "catalog" (
+    id  TEXT PRIMARY KEY,
+    doc JSONB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS catalog_doc_idx ON "catalog" USING GIN (doc jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS catalog_slug_idx ON "catalog" ((doc->>'slug'));
+
+CREATE TABLE IF NOT EXISTS "market" (
+    id  TEXT PRIMARY KEY,
+    doc JSONB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS market_doc_idx ON "market" USING GIN (doc jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS market_item_id_idx ON "market" ((doc->>'item_id'));
+CREATE INDEX IF NOT EXISTS market_user_id_idx ON "market" ((doc->>'user_id'));
+CREATE INDEX IF NOT EXISTS market_status_idx ON "market" ((doc->'status'));
+CREATE INDEX IF NOT EXISTS market_created_at_idx ON "market" ((doc->'created_at'));
+
+CREATE TABLE IF NOT EXISTS "delivery" (
+    id  TEXT PRIMARY KEY,
+    doc JSONB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS delivery_doc_idx ON "delivery" USING GIN (doc jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS delivery_market_id_idx ON "delivery" ((doc->>'market_id'));
+
+CREATE TABLE IF NOT EXISTS "inventory" (
+    id  TEXT PRIMARY KEY,
+    doc JSONB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS inventory_doc_idx ON "inventory" USING GIN (doc jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS inventory_market_id_idx ON "inventory" ((doc->>'market_id'));
+
+CREATE TABLE IF NOT EXISTS "track" (
+    id  TEXT PRIMARY KEY,
+    doc JSONB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS track_doc_idx ON "track" USING GIN (doc jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS track_created_at_idx ON "track" ((doc->'created_at'));
+
+CREATE TABLE IF NOT EXISTS "report" (
+    id  TEXT PRIMARY KEY,
+    doc JSONB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS report_doc_idx ON "report" USING GIN (doc jsonb_path_ops);
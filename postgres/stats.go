@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+// NewStats creates new instance of stats data store.
+func NewStats(c *Client) core.StatsStorage {
+	return &statsStorage{c}
+}
+
+type statsStorage struct {
+	db *Client
+}
+
+func (s *statsStorage) CountUserMarketStatus(userID string) (*core.MarketStatusCount, error) {
+	userScope := func() *query {
+		return newQuery(tableMarket).where(textField(marketFieldUserID)+" = ?", userID)
+	}
+
+	msc, err := s.countMarketStatus(userScope)
+	if err != nil {
+		return nil, err
+	}
+
+	resell, err := s.countGroup(userScope().
+		where(fmt.Sprintf("t.doc->'%s' IS NOT NULL", marketFieldResell)).
+		where(intField(marketFieldType)+" = ?", core.MarketTypeAsk), marketFieldStatus)
+	if err != nil {
+		return nil, err
+	}
+	msc.ResellLive = resell[int(core.MarketStatusLive)]
+	msc.ResellSold = resell[int(core.MarketStatusSold)]
+	msc.ResellReserved = resell[int(core.MarketStatusReserved)]
+	msc.ResellRemoved = resell[int(core.MarketStatusRemoved)]
+	msc.ResellCancelled = resell[int(core.MarketStatusCancelled)]
+	return msc, nil
+}
+
+func (s *statsStorage) CountMarketStatus(opts core.FindOpts) (*core.MarketStatusCount, error) {
+	opts = core.FindOpts{
+		Keyword:       opts.Keyword,
+		KeywordFields: opts.KeywordFields,
+		Filter:        opts.Filter,
+		UserID:        opts.UserID,
+	}
+	return s.countMarketStatus(func() *query {
+		return newFindOptsQuery(tableMarket, opts)
+	})
+}
+
+func (s *statsStorage) countMarketStatus(base func() *query) (*core.MarketStatusCount, error) {
+	asks, err := s.countGroup(base().where(intField(marketFieldType)+" = ?", core.MarketTypeAsk), marketFieldStatus)
+	if err != nil {
+		return nil, err
+	}
+	bids, err := s.countGroup(base().where(intField(marketFieldType)+" = ?", core.MarketTypeBid), marketFieldStatus)
+	if err != nil {
+		return nil, err
+	}
+	dlv, err := s.countGroup(base(), marketFieldDeliveryStatus)
+	if err != nil {
+		return nil, err
+	}
+	inv, err := s.countGroup(base(), marketFieldInventoryStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.MarketStatusCount{
+		Pending:   asks[int(core.MarketStatusPending)],
+		Live:      asks[int(core.MarketStatusLive)],
+		Sold:      asks[int(core.MarketStatusSold)],
+		Reserved:  asks[int(core.MarketStatusReserved)],
+		Removed:   asks[int(core.MarketStatusRemoved)],
+		Cancelled: asks[int(core.MarketStatusCancelled)],
+
+		BidLive:      bids[int(core.MarketStatusLive)],
+		BidCompleted: bids[int(core.MarketStatusBidCompleted)],
+
+		DeliveryNoHit:          dlv[int(core.DeliveryStatusNoHit)],
+		DeliveryNameVerified:   dlv[int(core.DeliveryStatusNameVerified)],
+		DeliverySenderVerified: dlv[int(core.DeliveryStatusSenderVerified)],
+		DeliveryPrivate:        dlv[int(core.DeliveryStatusPrivate)],
+		DeliveryError:          dlv[int(core.DeliveryStatusError)],
+
+		InventoryNoHit:    inv[int(core.InventoryStatusNoHit)],
+		InventoryVerified: inv[int(core.InventoryStatusVerified)],
+		InventoryPrivate:  inv[int(core.InventoryStatusPrivate)],
+		InventoryError:    inv[int(core.InventoryStatusError)],
+	}, nil
+}
+
+// countGroup groups documents by integer field value and count them.
+func (s *statsStorage) countGroup(q *query, field string) (map[int]int, error) {
+	q.columns = fmt.Sprintf("coalesce(%s, 0), count(*)", intField(field))
+	q.groupBy = "1"
+
+	stmt, args := q.sql()
+	rows, err := s.db.db.Query(stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := map[int]int{}
+	for rows.Next() {
+		var group, count int
+		if err = rows.Scan(&group, &count); err != nil {
+			return nil, err
+		}
+		res[group] = count
+	}
+
+	return res, rows.Err()
+}
+
+func (s *statsStorage) GraphMarketSales(o core.FindOpts) ([]core.MarketSalesGraph, error) {
+	o = core.FindOpts{
+		Keyword:       o.Keyword,
+		KeywordFields: o.KeywordFields,
+		Filter:        o.Filter,
+		UserID:        o.UserID,
+	}
+	q := newFindOptsQuery(tableMarket, o).
+		where(intField(marketFieldStatus)+" IN (?, ?)", core.MarketStatusReserved, core.MarketStatusSold)
+	q.columns = fmt.Sprintf("date_trunc('day', %s), avg(%s), count(*)",
+		timeField(marketFieldUpdatedAt), numericField(marketFieldPrice))
+	q.groupBy = "1"
+	q.orderBy = "1"
+
+	stmt, args := q.sql()
+	rows, err := s.db.db.Query(stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []core.MarketSalesGraph{}
+	for rows.Next() {
+		var msg core.MarketSalesGraph
+		if err = rows.Scan(&msg.Date, &msg.Avg, &msg.Count); err != nil {
+			return nil, err
+		}
+		res = append(res, msg)
+	}
+
+	return res, rows.Err()
+}
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"fmt"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const (
+	tableTrack          = "track"
+	trackFieldItemID    = "item_id"
+	trackFieldType      = "type"
+	trackFieldKeyword   = "keyword"
+	trackFieldCreatedAt = "created_at"
+)
+
+// NewTrack creates new instance of track data store.
+func NewTrack(c *Client) core.TrackStorage {
+	return &trackStorage{c, []string{"item_id"}}
+}
+
+type trackStorage struct {
+	db            *Client
+	keywordFields []string
+}
+
+func (s *trackStorage) Find(o core.FindOpts) ([]core.Track, error) {
+	var res []core.Track
+	o.KeywordFields = s.keywordFields
+	if err := s.db.list(newFindOptsQuery(tableTrack, o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *trackStorage) Count(o core.FindOpts) (num int, err error) {
+	o = core.FindOpts{
+		Keyword:       o.Keyword,
+		KeywordFields: s.keywordFields,
+		Filter:        o.Filter,
+	}
+	return s.db.count(newFindOptsQuery(tableTrack, o))
+}
+
+func (s *trackStorage) Get(id string) (*core.Track, error) {
+	row := &core.Track{}
+	if err := s.db.get(tableTrack, id, row); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, core.TrackErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *trackStorage) Create(in *core.Track) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	id, err := s.db.insert(tableTrack, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+// TopKeywords returns top recent searched keywords.
+func (s *trackStorage) TopKeywords() ([]core.SearchKeywordScore, error) {
+	const last7Days = -time.Hour * 24 * 7
+
+	stmt := fmt.Sprintf(`SELECT %[1]s, count(*) FROM %[2]q t
+		WHERE %[3]s = $1 AND %[4]s >= $2
+		GROUP BY %[1]s ORDER BY count(*) DESC, %[1]s LIMIT 12`,
+		textField(trackFieldKeyword), tableTrack, textField(trackFieldType), timeField(trackFieldCreatedAt))
+	rows, err := s.db.db.Query(stmt, core.TrackTypeSearch, time.Now().Add(last7Days))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []core.SearchKeywordScore{}
+	for rows.Next() {
+		var ks core.SearchKeywordScore
+		var keyword sql.NullString
+		if err = rows.Scan(&keyword, &ks.Score); err != nil {
+			return nil, err
+		}
+		ks.Keyword = keyword.String
+		res = append(res, ks)
+	}
+
+	return res, rows.Err()
+}
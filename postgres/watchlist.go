@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+const (
+	tableWatchlist               = "watchlist"
+	tableWatchlistAlert          = "watchlist_alert"
+	watchlistAlertFieldUserID    = "user_id"
+	watchlistAlertFieldCreatedAt = "created_at"
+	watchlistFieldAskBelow       = "ask_below"
+	watchlistFieldBidAbove       = "bid_above"
+	watchlistFieldAskTriggered   = "ask_triggered"
+	watchlistFieldBidTriggered   = "bid_triggered"
+)
+
+// NewWatchlist creates new instance of watchlist data store.
+func NewWatchlist(c *Client) core.WatchlistStorage {
+	return &watchlistStorage{c}
+}
+
+type watchlistStorage struct {
+	db *Client
+}
+
+func (s *watchlistStorage) Find(o core.FindOpts) ([]core.Watchlist, error) {
+	var res []core.Watchlist
+	if err := s.db.list(newFindOptsQuery(tableWatchlist, o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *watchlistStorage) Get(id string) (*core.Watchlist, error) {
+	row := &core.Watchlist{}
+	if err := s.db.get(tableWatchlist, id, row); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, core.WatchlistErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *watchlistStorage) Create(in *core.Watchlist) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(tableWatchlist, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *watchlistStorage) Update(in *core.Watchlist) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	if err = s.db.update(tableWatchlist, in.ID, in); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func (s *watchlistStorage) UpdateThresholds(in *core.Watchlist) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	f := false
+	in.AskTriggered = &f
+	in.BidTriggered = &f
+	in.UpdatedAt = now()
+	doc := map[string]interface{}{
+		watchlistFieldAskBelow:     in.AskBelow,
+		watchlistFieldBidAbove:     in.BidAbove,
+		watchlistFieldAskTriggered: f,
+		watchlistFieldBidTriggered: f,
+		"updated_at":               in.UpdatedAt,
+	}
+	if err = s.db.update(tableWatchlist, in.ID, doc); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	// Zero thresholds are cleared and should not be filled by merge.
+	askBelow, bidAbove := in.AskBelow, in.BidAbove
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+	in.AskBelow, in.BidAbove = askBelow, bidAbove
+
+	return nil
+}
+
+func (s *watchlistStorage) Delete(id string) error {
+	stmt := fmt.Sprintf("DELETE FROM %q WHERE id = $1", tableWatchlist)
+	if err := s.db.exec(stmt, id); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return nil
+}
+
+// NewWatchlistAlert creates new instance of watchlist alert data store.
+func NewWatchlistAlert(c *Client) core.WatchlistAlertStorage {
+	return &watchlistAlertStorage{c}
+}
+
+type watchlistAlertStorage struct {
+	db *Client
+}
+
+func (s *watchlistAlertStorage) Find(userID string, limit int) ([]core.WatchlistAlert, error) {
+	var res []core.WatchlistAlert
+	q := newQuery(tableWatchlistAlert).where(textField(watchlistAlertFieldUserID)+" = ?", userID)
+	q.orderBy = timeField(watchlistAlertFieldCreatedAt) + " DESC"
+	q.limit = limit
+	if err := s.db.list(q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *watchlistAlertStorage) Create(in *core.WatchlistAlert) error {
+	in.CreatedAt = now()
+	in.ID = ""
+	id, err := s.db.insert(tableWatchlistAlert, in)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package redis
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/kudarap/dotagiftx/core"
+)
+
+const (
+	catalogIndexFieldQueued       = "queued"
+	catalogIndexFieldDeduplicated = "deduplicated"
+	catalogIndexFieldIndexed      = "indexed"
+	catalogIndexFieldFailed       = "failed"
+	catalogIndexFieldSLAMissed    = "sla_missed"
+	catalogIndexFieldLatency      = "latency_ms"
+)
+
+// NewCatalogIndexQueue returns a deduplicating catalog index queue backed by
+// redis sorted set scored by its first queued time, counters are kept on a
+// separate hash so they are shared across running instances. Retried requests
+// keep their first queued time and attempts on a retry hash.
+func NewCatalogIndexQueue(c *Client, key string) *CatalogIndexQueue {
+	return &CatalogIndexQueue{c.db, key, key + ":stats", key + ":retry"}
+}
+
+// CatalogIndexQueue represents redis catalog index queue.
+type CatalogIndexQueue struct {
+	db       *redis.Client
+	key      string
+	statsKey string
+	retryKey string
+}
+
+// Push adds items to the queue, NX keeps the queued time of existing items.
+func (q *CatalogIndexQueue) Push(itemIDs ...string) (int, error) {
+	if len(itemIDs) == 0 {
+		return 0, nil
+	}
+
+	score := float64(time.Now().UnixMilli())
+	var zz []*redis.Z
+	for _, id := range itemIDs {
+		zz = append(zz, &redis.Z{Score: score, Member: id})
+	}
+
+	n, err := q.db.ZAddNX(ctx, q.key, zz...).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = q.db.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.HIncrBy(ctx, q.statsKey, catalogIndexFieldQueued, n)
+		p.HIncrBy(ctx, q.statsKey, catalogIndexFieldDeduplicated, int64(len(itemIDs))-n)
+		return nil
+	})
+	return int(n), err
+}
+
+// Pop claims requests by removing them from the set, only the instance that
+// removed the item gets to index it.
+func (q *CatalogIndexQueue) Pop(t time.Time, n int) ([]core.CatalogIndexRequest, error) {
+	res, err := q.db.ZRangeByScoreWithScores(ctx, q.key, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(t.UnixMilli(), 10),
+		Count: int64(n),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var rr []core.CatalogIndexRequest
+	for _, z := range res {
+		id, _ := z.Member.(string)
+		claimed, err := q.db.ZRem(ctx, q.key, id).Result()
+		if err != nil {
+			return rr, err
+		}
+		if claimed == 0 {
+			continue
+		}
+
+		r := core.CatalogIndexRequest{
+			ItemID:   id,
+			QueuedAt: time.UnixMilli(int64(z.Score)),
+		}
+		// Claimed request is still returned so it does not get lost.
+		err = q.claimRetry(&r)
+		rr = append(rr, r)
+		if err != nil {
+			return rr, err
+		}
+	}
+
+	return rr, nil
+}
+
+// Retry puts back the request scored by its retry time, NX keeps the queued
+// time when the item was queued again in the meantime.
+func (q *CatalogIndexQueue) Retry(r core.CatalogIndexRequest, t time.Time) error {
+	_, err := q.db.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.HSet(ctx, q.retryKey, r.ItemID, fmt.Sprintf("%d:%d", r.QueuedAt.UnixMilli(), r.Attempts))
+		p.ZAddNX(ctx, q.key, &redis.Z{Score: float64(t.UnixMilli()), Member: r.ItemID})
+		return nil
+	})
+	return err
+}
+
+// claimRetry restores first queued time and attempts of retried request.
+func (q *CatalogIndexQueue) claimRetry(r *core.CatalogIndexRequest) error {
+	v, err := q.db.HGet(ctx, q.retryKey, r.ItemID).Result()
+	if err == redis.Nil {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err = q.db.HDel(ctx, q.retryKey, r.ItemID).Err(); err != nil {
+		return err
+	}
+
+	var queuedAt int64
+	if _, err = fmt.Sscanf(v, "%d:%d", &queuedAt, &r.Attempts); err != nil {
+		return err
+	}
+	r.QueuedAt = time.UnixMilli(queuedAt)
+	return nil
+}
+
+// Record increments queue counters of drained batch.
+func (q *CatalogIndexQueue) Record(r core.CatalogIndexResult) error {
+	_, err := q.db.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.HIncrBy(ctx, q.statsKey, catalogIndexFieldIndexed, int64(r.Indexed))
+		p.HIncrBy(ctx, q.statsKey, catalogIndexFieldFailed, int64(r.Failed))
+		p.HIncrBy(ctx, q.statsKey, catalogIndexFieldSLAMissed, int64(r.SLAMissed))
+		p.HIncrBy(ctx, q.statsKey, catalogIndexFieldLatency, r.Latency.Milliseconds())
+		return nil
+	})
+	return err
+}
+
+// Stats returns queue depth, its oldest request and counters.
+func (q *CatalogIndexQueue) Stats() (*core.CatalogIndexStats, error) {
+	pending, err := q.db.ZCard(ctx, q.key).Result()
+	if err != nil {
+		return nil, err
+	}
+	oldest, err := q.db.ZRangeWithScores(ctx, q.key, 0, 0).Result()
+	if err != nil {
+		return nil, err
+	}
+	counters, err := q.db.HGetAll(ctx, q.statsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	count := func(field string) int {
+		n, _ := strconv.Atoi(counters[field])
+		return n
+	}
+	s := &core.CatalogIndexStats{
+		Pending:      int(pending),
+		Queued:       count(catalogIndexFieldQueued),
+		Deduplicated: count(catalogIndexFieldDeduplicated),
+		Indexed:      count(catalogIndexFieldIndexed),
+		Failed:       count(catalogIndexFieldFailed),
+		SLAMissed:    count(catalogIndexFieldSLAMissed),
+	}
+	if len(oldest) != 0 {
+		t := time.UnixMilli(int64(oldest[0].Score))
+		s.OldestQueuedAt = &t
+	}
+	if done := s.Indexed + s.Failed; done != 0 {
+		s.AvgLatency = float64(count(catalogIndexFieldLatency)) / float64(done) / 1000
+	}
+
+	return s, nil
+}
@@ -0,0 +1,316 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/kudarap/dotagiftx/events"
+	"github.com/kudarap/dotagiftx/gokit/log"
+)
+
+const (
+	eventStreamMaxLen    = 10000
+	eventStreamReadCount = 10
+	eventStreamReadBlock = time.Second * 5
+
+	// Pending events that failed or left by stopped consumer are reclaimed
+	// after idle time and dropped after max deliveries.
+	eventStreamClaimIdle     = time.Minute
+	eventStreamClaimInterval = time.Second * 30
+	eventStreamMaxDeliveries = 5
+	// Handlers that succeeded on pending events are recorded until the event
+	// is acknowledged or expired.
+	eventStreamHandledExpr = time.Hour
+
+	eventFieldType = "type"
+	eventFieldData = "data"
+)
+
+// NewEventStream returns an event bus backed by redis stream. Events are
+// consumed by a consumer group so each event is handled by one of multiple
+// running instances, events are only acknowledged when all handlers succeed
+// and failed ones are redelivered to the handlers that failed only.
+func NewEventStream(c *Client, stream, group, consumer string, lg log.Logger) *EventStream {
+	return &EventStream{
+		db:        c.db,
+		stream:    stream,
+		group:     group,
+		consumer:  consumer,
+		local:     events.NewLocal(lg),
+		broadcast: events.NewLocal(lg),
+		logger:    lg,
+	}
+}
+
+// EventStream represents redis stream event bus.
+type EventStream struct {
+	db        *redis.Client
+	stream    string
+	group     string
+	consumer  string
+	local     *events.Local
+	broadcast *events.Local
+	logger    log.Logger
+}
+
+// Publish appends event to the stream.
+func (s *EventStream) Publish(ctx context.Context, e events.Event) error {
+	b, err := events.Encode(e)
+	if err != nil {
+		return err
+	}
+
+	return s.db.XAdd(ctx, &redis.XAddArgs{
+		Stream: s.stream,
+		MaxLen: eventStreamMaxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			eventFieldType: string(e.EventType()),
+			eventFieldData: string(b),
+		},
+	}).Err()
+}
+
+// Subscribe registers handler on event type that runs when consuming the stream.
+// Handlers are recorded by order of subscription so every instance should
+// subscribe them in the same order.
+func (s *EventStream) Subscribe(t events.Type, h events.Handler) {
+	s.local.Subscribe(t, h)
+}
+
+// Broadcast returns subscriber which handlers runs on every running instance
+// for each event, unlike Subscribe that runs on one of them. Broadcast events
+// are read from the time Listen starts and are never redelivered.
+func (s *EventStream) Broadcast() events.Subscriber {
+	return s.broadcast
+}
+
+// Listen consumes events from the stream until context is done.
+func (s *EventStream) Listen(ctx context.Context) error {
+	err := s.db.XGroupCreateMkStream(ctx, s.stream, s.group, "$").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("could not create stream group: %s", err)
+	}
+	go s.listenBroadcast(ctx)
+
+	var claimedAt time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		if time.Since(claimedAt) >= eventStreamClaimInterval {
+			s.reclaim(ctx)
+			claimedAt = time.Now()
+		}
+
+		res, err := s.db.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    s.group,
+			Consumer: s.consumer,
+			Streams:  []string{s.stream, ">"},
+			Count:    eventStreamReadCount,
+			Block:    eventStreamReadBlock,
+		}).Result()
+		if err == redis.Nil || ctx.Err() != nil {
+			continue
+		}
+		if err != nil {
+			s.logger.Errorf("could not read stream %s: %s", s.stream, err)
+			time.Sleep(eventStreamReadBlock)
+			continue
+		}
+
+		for _, rs := range res {
+			for _, msg := range rs.Messages {
+				s.handle(ctx, msg)
+			}
+		}
+	}
+}
+
+// listenBroadcast reads the stream without consumer group so every instance
+// receives all events.
+func (s *EventStream) listenBroadcast(ctx context.Context) {
+	lastID := s.lastID(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		res, err := s.db.XRead(ctx, &redis.XReadArgs{
+			Streams: []string{s.stream, lastID},
+			Count:   eventStreamReadCount,
+			Block:   eventStreamReadBlock,
+		}).Result()
+		if err == redis.Nil || ctx.Err() != nil {
+			continue
+		}
+		if err != nil {
+			s.logger.Errorf("could not read broadcast stream %s: %s", s.stream, err)
+			time.Sleep(eventStreamReadBlock)
+			continue
+		}
+
+		for _, rs := range res {
+			for _, msg := range rs.Messages {
+				lastID = msg.ID
+				if e, err := decodeEvent(msg); err == nil {
+					_ = s.broadcast.Dispatch(ctx, e)
+				}
+			}
+		}
+	}
+}
+
+// lastID returns id of the latest event on the stream, events added between
+// blocking reads would be skipped when reading from "$" again.
+func (s *EventStream) lastID(ctx context.Context) string {
+	res, err := s.db.XRevRangeN(ctx, s.stream, "+", "-", 1).Result()
+	if err != nil {
+		return "$"
+	}
+	if len(res) == 0 {
+		return "0-0"
+	}
+
+	return res[0].ID
+}
+
+// reclaim takes over idle pending events and handles them again.
+func (s *EventStream) reclaim(ctx context.Context) {
+	msgs, _, err := s.db.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   s.stream,
+		Group:    s.group,
+		Consumer: s.consumer,
+		MinIdle:  eventStreamClaimIdle,
+		Start:    "0-0",
+		Count:    eventStreamReadCount,
+	}).Result()
+	if err != nil {
+		if err != redis.Nil && ctx.Err() == nil {
+			s.logger.Errorf("could not reclaim stream %s: %s", s.stream, err)
+		}
+		return
+	}
+
+	for _, msg := range msgs {
+		if s.deliveries(ctx, msg.ID) > eventStreamMaxDeliveries {
+			s.logger.Errorf("dropping event %s after %d deliveries", msg.ID, eventStreamMaxDeliveries)
+			s.ack(ctx, msg.ID)
+			s.clearHandled(ctx, msg.ID)
+			continue
+		}
+
+		s.handle(ctx, msg)
+	}
+}
+
+// deliveries returns number of times pending event was delivered.
+func (s *EventStream) deliveries(ctx context.Context, id string) int64 {
+	res, err := s.db.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: s.stream,
+		Group:  s.group,
+		Start:  id,
+		End:    id,
+		Count:  1,
+	}).Result()
+	if err != nil || len(res) == 0 {
+		return 0
+	}
+
+	return res[0].RetryCount
+}
+
+func (s *EventStream) handle(ctx context.Context, msg redis.XMessage) {
+	e, err := decodeEvent(msg)
+	if err != nil {
+		// Acknowledge message on decoding failure since it will never succeed.
+		s.logger.Errorf("could not decode event %s: %s", msg.ID, err)
+		s.ack(ctx, msg.ID)
+		return
+	}
+
+	handled, err := s.handled(ctx, msg.ID)
+	if err != nil {
+		// Leave event pending since handlers that already succeeded are unknown.
+		s.logger.Errorf("could not get handled event %s: %s", msg.ID, err)
+		return
+	}
+
+	// Failed events are left pending and reclaimed later, handlers that
+	// succeeded are recorded so it will not run again on redelivery.
+	ok, err := s.local.DispatchExcept(ctx, e, handled)
+	if err != nil {
+		s.addHandled(ctx, msg.ID, ok)
+		return
+	}
+	s.ack(ctx, msg.ID)
+	s.clearHandled(ctx, msg.ID)
+}
+
+// handled returns subscription indexes of handlers that succeeded on event.
+func (s *EventStream) handled(ctx context.Context, id string) (map[int]bool, error) {
+	res, err := s.db.SMembers(ctx, s.handledKey(id)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	handled := map[int]bool{}
+	for _, r := range res {
+		if i, err := strconv.Atoi(r); err == nil {
+			handled[i] = true
+		}
+	}
+
+	return handled, nil
+}
+
+func (s *EventStream) addHandled(ctx context.Context, id string, handlers []int) {
+	if len(handlers) == 0 {
+		return
+	}
+
+	members := make([]interface{}, len(handlers))
+	for i, h := range handlers {
+		members[i] = h
+	}
+	key := s.handledKey(id)
+	_, err := s.db.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.SAdd(ctx, key, members...)
+		p.Expire(ctx, key, eventStreamHandledExpr)
+		return nil
+	})
+	if err != nil {
+		s.logger.Errorf("could not record handled event %s: %s", id, err)
+	}
+}
+
+func (s *EventStream) clearHandled(ctx context.Context, id string) {
+	if err := s.db.Del(ctx, s.handledKey(id)).Err(); err != nil {
+		s.logger.Errorf("could not clear handled event %s: %s", id, err)
+	}
+}
+
+func (s *EventStream) handledKey(id string) string {
+	return s.stream + ":" + s.group + ":handled:" + id
+}
+
+func (s *EventStream) ack(ctx context.Context, id string) {
+	if err := s.db.XAck(ctx, s.stream, s.group, id).Err(); err != nil {
+		s.logger.Errorf("could not ack event %s: %s", id, err)
+	}
+}
+
+func decodeEvent(msg redis.XMessage) (events.Event, error) {
+	t, _ := msg.Values[eventFieldType].(string)
+	data, _ := msg.Values[eventFieldData].(string)
+	return events.Decode(events.Type(t), []byte(data))
+}
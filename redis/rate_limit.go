@@ -0,0 +1,57 @@
+package redis
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/kudarap/dotagiftx/core"
+)
+
+// NewRateLimiter returns sliding window rate limiter that keeps request
+// counters per fixed window, keys expires after the next window so only
+// two counters are kept per client.
+func NewRateLimiter(c *Client, prefix string) *RateLimiter {
+	return &RateLimiter{c.db, prefix}
+}
+
+// RateLimiter represents redis rate limiter.
+type RateLimiter struct {
+	db     *redis.Client
+	prefix string
+}
+
+// Allow increments counter of current window and rolls it back when the
+// request is over the limit.
+func (l *RateLimiter) Allow(key string, rl core.RateLimit) (*core.RateLimitResult, error) {
+	now := time.Now()
+	start := rl.WindowStart(now)
+	currKey := l.windowKey(key, start)
+	prevKey := l.windowKey(key, start.Add(-rl.Window))
+
+	var curr *redis.IntCmd
+	var prev *redis.StringCmd
+	_, err := l.db.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		curr = p.Incr(ctx, currKey)
+		p.PExpire(ctx, currKey, rl.Window*2)
+		prev = p.Get(ctx, prevKey)
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	prevCount, _ := prev.Int()
+	res := rl.Result(now, prevCount, int(curr.Val()))
+	if !res.Allowed {
+		if err = l.db.Decr(ctx, currKey).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &res, nil
+}
+
+func (l *RateLimiter) windowKey(key string, start time.Time) string {
+	return fmt.Sprintf("%s:%s:%d", l.prefix, key, start.Unix())
+}
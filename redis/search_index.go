@@ -0,0 +1,95 @@
+package redis
+
+import (
+	"context"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/gokit/log"
+)
+
+// NewSearchIndexBroadcast returns search index writer that publishes entry
+// changes to every running instance with local search index through redis
+// pub/sub. Changes published while an instance is not listening are missed
+// and only restored by rebuilding its index.
+func NewSearchIndexBroadcast(c *Client, channel string, lg log.Logger) *SearchIndexBroadcast {
+	return &SearchIndexBroadcast{c.db, channel, lg}
+}
+
+// SearchIndexBroadcast represents redis pub/sub search index writer.
+type SearchIndexBroadcast struct {
+	db      *redis.Client
+	channel string
+	logger  log.Logger
+}
+
+type searchIndexMessage struct {
+	Catalog        *core.Catalog `json:"catalog,omitempty"`
+	Market         *core.Market  `json:"market,omitempty"`
+	DeleteMarketID string        `json:"delete_market_id,omitempty"`
+}
+
+func (b *SearchIndexBroadcast) IndexCatalog(c core.Catalog) error {
+	return b.publish(searchIndexMessage{Catalog: &c})
+}
+
+func (b *SearchIndexBroadcast) IndexMarket(m core.Market) error {
+	return b.publish(searchIndexMessage{Market: &m})
+}
+
+func (b *SearchIndexBroadcast) DeleteMarket(id string) error {
+	return b.publish(searchIndexMessage{DeleteMarketID: id})
+}
+
+func (b *SearchIndexBroadcast) publish(msg searchIndexMessage) error {
+	p, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return b.db.Publish(ctx, b.channel, p).Err()
+}
+
+// Listen applies published changes to the local search index until context
+// is done.
+func (b *SearchIndexBroadcast) Listen(ctx context.Context, idx core.SearchIndexWriter) error {
+	sub := b.db.Subscribe(ctx, b.channel)
+	defer sub.Close()
+	if _, err := sub.Receive(ctx); err != nil {
+		return err
+	}
+
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case m, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			b.apply(idx, m.Payload)
+		}
+	}
+}
+
+func (b *SearchIndexBroadcast) apply(idx core.SearchIndexWriter, payload string) {
+	var msg searchIndexMessage
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		b.logger.Errorf("could not decode search index message: %s", err)
+		return
+	}
+
+	var err error
+	switch {
+	case msg.Catalog != nil:
+		err = idx.IndexCatalog(*msg.Catalog)
+	case msg.Market != nil:
+		err = idx.IndexMarket(*msg.Market)
+	case msg.DeleteMarketID != "":
+		err = idx.DeleteMarket(msg.DeleteMarketID)
+	}
+	if err != nil {
+		b.logger.Errorf("could not apply search index message: %s", err)
+	}
+}
@@ -1,8 +1,6 @@
 package rethink
 
 import (
-	"log"
-
 	"github.com/kudarap/dotagiftx/core"
 	"github.com/kudarap/dotagiftx/errors"
 	r "gopkg.in/rethinkdb/rethinkdb-go.v6"
@@ -14,10 +12,6 @@ const tableAuth = "auth"
 
 // NewAuth creates new instance of auth data store.
 func NewAuth(c *Client) *authStorage {
-	if err := c.autoMigrate(tableAuth); err != nil {
-		log.Fatalf("could not create %s table: %s", tableAuth, err)
-	}
-
 	return &authStorage{c}
 }
 
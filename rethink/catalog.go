@@ -17,14 +17,6 @@ const tableCatalog = "catalog"
 
 // NewCatalog creates new instance of catalog data store.
 func NewCatalog(c *Client, lg log.Logger) core.CatalogStorage {
-	if err := c.autoMigrate(tableCatalog); err != nil {
-		lg.Fatalf("could not create %s table: %s", tableCatalog, err)
-	}
-
-	if err := c.autoIndex(tableCatalog, core.Catalog{}); err != nil {
-		lg.Fatalf("could not create index on %s table: %s", tableCatalog, err)
-	}
-
 	return &catalogStorage{c, itemSearchFields, lg}
 }
 
@@ -144,7 +136,9 @@ func (s *catalogStorage) Count(o core.FindOpts) (num int, err error) {
 		KeywordFields: s.keywordFields,
 		IndexSorting:  true,
 		Keyword:       o.Keyword,
+		IDs:           o.IDs,
 		Filter:        o.Filter,
+		FilterExprs:   o.FilterExprs,
 		Sort:          o.Sort,
 	}
 	q := newFindOptsQuery(s.table(), o)
@@ -153,6 +147,17 @@ func (s *catalogStorage) Count(o core.FindOpts) (num int, err error) {
 	return
 }
 
+func (s *catalogStorage) Facets(o core.FindOpts) (*core.CatalogFacets, error) {
+	o.KeywordFields = s.keywordFields
+	var res []core.Catalog
+	q := newFindOptsQuery(s.table(), core.CatalogFacetFindOpts(o))
+	if err := s.db.list(q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return core.NewCatalogFacets(res), nil
+}
+
 func (s *catalogStorage) filterOutZeroQty(q r.Term) r.Term {
 	return q.Filter(r.Row.Field("quantity").Gt(0))
 }
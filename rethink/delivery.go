@@ -1,8 +1,6 @@
 package rethink
 
 import (
-	"log"
-
 	"github.com/imdario/mergo"
 	"github.com/kudarap/dotagiftx/core"
 	"github.com/kudarap/dotagiftx/errors"
@@ -18,14 +16,6 @@ var deliverySearchFields = []string{"id", "market_id"}
 
 // NewDelivery creates new instance of delivery data store.
 func NewDelivery(c *Client) core.DeliveryStorage {
-	if err := c.autoMigrate(tableDelivery); err != nil {
-		log.Fatalf("could not create %s table: %s", tableDelivery, err)
-	}
-
-	if err := c.autoIndex(tableDelivery, core.Delivery{}); err != nil {
-		log.Fatalf("could not create index on %s table: %s", tableDelivery, err)
-	}
-
 	return &deliveryStorage{c, deliverySearchFields}
 }
 
@@ -22,6 +22,9 @@ func baseFindOptsQuery(q r.Term, o core.FindOpts, hookFn func(r.Term) r.Term) r.
 
 func (o findOpts) parseOpts(q r.Term, hookFn func(r.Term) r.Term) r.Term {
 	if o.IndexSorting && o.Sort != "" {
+		if o.Cursor != nil {
+			q = o.parseIndexCursor(q)
+		}
 		q = q.OrderBy(r.OrderByOpts{Index: o.parseOrder()})
 	}
 
@@ -33,19 +36,32 @@ func (o findOpts) parseOpts(q r.Term, hookFn func(r.Term) r.Term) r.Term {
 		q = q.Filter(o.parseKeyword())
 	}
 
+	if o.IDs != nil {
+		q = q.Filter(o.parseIDs())
+	}
+
 	if o.Filter != nil {
 		q = q.Filter(o.parseFilter())
 	}
 
+	if len(o.FilterExprs) != 0 {
+		q = q.Filter(o.parseFilterExprs())
+	}
+
 	if o.UserID != "" {
 		q = q.Filter(o.setUserScope())
 	}
 
 	if !o.IndexSorting && o.Sort != "" {
-		q = q.OrderBy(o.parseOrder())
+		if o.Cursor != nil {
+			q = q.Filter(o.parseCursor())
+		}
+		q = q.OrderBy(o.parseOrder(), o.parseOrderID())
 	}
 
-	if o.Limit != 0 {
+	if o.Limit != 0 && o.Cursor != nil {
+		q = q.Limit(o.Limit)
+	} else if o.Limit != 0 {
 		q = q.Slice(o.parseSlice())
 	}
 
@@ -78,6 +94,12 @@ func (o findOpts) parseKeyword() interface{} {
 	}
 }
 
+func (o findOpts) parseIDs() interface{} {
+	return func(t r.Term) r.Term {
+		return r.Expr(o.IDs).Contains(t.Field("id"))
+	}
+}
+
 // normalizeKeyword handles special case for the word "Collector's" with apostrophe.
 func normalizeKeyword(keyword string) string {
 	s := strings.ToLower(keyword)
@@ -99,6 +121,30 @@ func (o findOpts) parseFilter() map[string]interface{} {
 	return structs.New(o.Filter).Map()
 }
 
+// parseFilterExprs matches records that satisfies all filter expressions.
+func (o findOpts) parseFilterExprs() interface{} {
+	return func(t r.Term) r.Term {
+		q := r.Expr(true)
+		for _, e := range o.FilterExprs {
+			f := t.Field(e.Field)
+			switch e.Op {
+			case core.FilterOpGt:
+				q = q.And(f.Gt(e.Value()))
+			case core.FilterOpGte:
+				q = q.And(f.Ge(e.Value()))
+			case core.FilterOpLt:
+				q = q.And(f.Lt(e.Value()))
+			case core.FilterOpLte:
+				q = q.And(f.Le(e.Value()))
+			case core.FilterOpIn:
+				q = q.And(r.Expr(e.Values).Contains(f))
+			}
+		}
+
+		return q
+	}
+}
+
 func (o findOpts) parseOrder() interface{} {
 	if o.Desc {
 		return r.Desc(o.Sort)
@@ -107,6 +153,52 @@ func (o findOpts) parseOrder() interface{} {
 	return o.Sort
 }
 
+// parseOrderID orders records with the same sort value by id the same way
+// secondary index does.
+func (o findOpts) parseOrderID() interface{} {
+	if o.Desc {
+		return r.Desc("id")
+	}
+
+	return "id"
+}
+
+// parseIndexCursor continues from the cursor record using sort index range,
+// records with the same sort value are ordered by id on the index.
+func (o findOpts) parseIndexCursor(q r.Term) r.Term {
+	v := o.Cursor.SortValue()
+	if o.Desc {
+		q = q.Between(r.MinVal, v, r.BetweenOpts{Index: o.Sort, RightBound: "closed"})
+	} else {
+		q = q.Between(v, r.MaxVal, r.BetweenOpts{Index: o.Sort, LeftBound: "closed"})
+	}
+
+	return q.Filter(func(t r.Term) r.Term {
+		return t.Field(o.Sort).Ne(v).Or(o.afterCursorID(t))
+	})
+}
+
+// parseCursor matches records that comes after the cursor record.
+func (o findOpts) parseCursor() interface{} {
+	v := o.Cursor.SortValue()
+	return func(t r.Term) r.Term {
+		after := t.Field(o.Sort).Gt(v)
+		if o.Desc {
+			after = t.Field(o.Sort).Lt(v)
+		}
+
+		return after.Or(t.Field(o.Sort).Eq(v).And(o.afterCursorID(t)))
+	}
+}
+
+func (o findOpts) afterCursorID(t r.Term) r.Term {
+	if o.Desc {
+		return t.Field("id").Lt(o.Cursor.ID)
+	}
+
+	return t.Field("id").Gt(o.Cursor.ID)
+}
+
 func (o findOpts) parseSlice() (start int, end int) {
 	if o.Page < 1 {
 		o.Page = 1
@@ -1,8 +1,6 @@
 package rethink
 
 import (
-	"log"
-
 	"github.com/imdario/mergo"
 	"github.com/kudarap/dotagiftx/core"
 	"github.com/kudarap/dotagiftx/errors"
@@ -18,14 +16,6 @@ var inventorySearchFields = []string{"id", "market_id"}
 
 // NewInventory creates new instance of inventory data store.
 func NewInventory(c *Client) core.InventoryStorage {
-	if err := c.autoMigrate(tableInventory); err != nil {
-		log.Fatalf("could not create %s table: %s", tableInventory, err)
-	}
-
-	if err := c.autoIndex(tableInventory, core.Inventory{}); err != nil {
-		log.Fatalf("could not create index on %s table: %s", tableInventory, err)
-	}
-
 	return &inventoryStorage{c, inventorySearchFields}
 }
 
@@ -2,7 +2,6 @@ package rethink
 
 import (
 	"fmt"
-	"log"
 
 	"github.com/imdario/mergo"
 	"github.com/kudarap/dotagiftx/core"
@@ -20,14 +19,6 @@ var itemSearchFields = []string{"name", "hero", "origin", "rarity"}
 
 // NewItem creates new instance of item data store.
 func NewItem(c *Client) core.ItemStorage {
-	if err := c.autoMigrate(tableItem); err != nil {
-		log.Fatalf("could not create %s table: %s", tableItem, err)
-	}
-
-	if err := c.createIndex(tableItem, itemFieldSlug); err != nil {
-		log.Fatalf("could not create index on %s table: %s", tableItem, err)
-	}
-
 	return &itemStorage{c, itemSearchFields}
 }
 
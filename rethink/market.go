@@ -2,7 +2,6 @@ package rethink
 
 import (
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -10,6 +9,7 @@ import (
 	"github.com/kudarap/dotagiftx/core"
 	"github.com/kudarap/dotagiftx/errors"
 	r "gopkg.in/rethinkdb/rethinkdb-go.v6"
+	"gopkg.in/rethinkdb/rethinkdb-go.v6/encoding"
 )
 
 const (
@@ -31,13 +31,6 @@ const (
 
 // NewMarket creates new instance of market data store.
 func NewMarket(c *Client) core.MarketStorage {
-	if err := c.autoMigrate(tableMarket); err != nil {
-		log.Fatalf("could not create %s table: %s", tableMarket, err)
-	}
-	if err := c.autoIndex(tableMarket, core.Market{}); err != nil {
-		log.Fatalf("could not create index on %s table: %s", tableMarket, err)
-	}
-
 	return &marketStorage{c, []string{marketItemSearchTags}}
 }
 
@@ -131,8 +124,10 @@ func (s *marketStorage) RevalidateDeliveryStatus(o core.FindOpts) ([]core.Market
 func (s *marketStorage) Count(o core.FindOpts) (num int, err error) {
 	o = core.FindOpts{
 		Keyword:       o.Keyword,
+		IDs:           o.IDs,
 		KeywordFields: s.keywordFields,
 		Filter:        o.Filter,
+		FilterExprs:   o.FilterExprs,
 		UserID:        o.UserID,
 	}
 	q := findOpts(o).parseOpts(s.table(), nil)
@@ -290,14 +285,41 @@ func (s *marketStorage) BaseUpdate(in *core.Market) error {
 	return nil
 }
 
-func (s *marketStorage) UpdateExpiring(t core.MarketType, b core.UserBoon, cutOff time.Time) (ids []string, err error) {
+func (s *marketStorage) UpdateIfStatus(in *core.Market, status core.MarketStatus) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	// Status is checked within the atomic single document update.
+	in.User = nil
+	in.UpdatedAt = now()
+	q := s.table().Get(in.ID).Update(func(row r.Term) r.Term {
+		return r.Branch(row.Field(marketFieldStatus).Eq(status), in, map[string]interface{}{})
+	})
+	res, err := s.db.runWrite(q)
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	if res.Replaced == 0 {
+		return core.MarketErrStatusChanged
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func (s *marketStorage) UpdateExpiring(t core.MarketType, b core.UserBoon, cutOff time.Time) ([]core.Market, error) {
 	// Collects exempted users ids.
 	q := r.Table(tableUser).
 		HasFields("boons").
 		Filter(r.Row.Field("boons").Contains(b)).
 		Field("id")
 	var exemptedUserIDs []string
-	if err = s.db.list(q, &exemptedUserIDs); err != nil {
+	if err := s.db.list(q, &exemptedUserIDs); err != nil {
 		return nil, fmt.Errorf("could not get users: %s", err)
 	}
 
@@ -311,35 +333,46 @@ func (s *marketStorage) UpdateExpiring(t core.MarketType, b core.UserBoon, cutOf
 		Update(core.Market{
 			Status: core.MarketStatusExpired, UpdatedAt: &now,
 		})
-	if err = s.db.update(q); err != nil {
+	if err := s.db.update(q); err != nil {
 		return nil, fmt.Errorf("could not update expiring markets: %s", err)
 	}
 
-	// Collect and return affected item ids.
+	// Collect and return affected markets.
 	q = s.table().Filter(core.Market{Status: core.MarketStatusExpired, UpdatedAt: &now}).
-		Group(marketFieldItemID).Count().Ungroup().
-		Field("group")
-	var itemIDs []string
-	if err = s.db.list(q, &itemIDs); err != nil {
+		Pluck("id", marketFieldItemID, marketFieldUserID, marketFieldStatus)
+	var res []core.Market
+	if err := s.db.list(q, &res); err != nil {
 		return nil, fmt.Errorf("could not get affected markets: %s", err)
 	}
 
-	return itemIDs, nil
+	return res, nil
 }
 
-func (s *marketStorage) BulkDeleteByStatus(ms core.MarketStatus, cutOff time.Time, limit int) error {
+func (s *marketStorage) BulkDeleteByStatus(ms core.MarketStatus, cutOff time.Time, limit int) ([]core.Market, error) {
 	if ms != core.MarketStatusRemoved && ms != core.MarketStatusExpired {
-		return fmt.Errorf("market status %s not allowed to bulk delete", ms)
+		return nil, fmt.Errorf("market status %s not allowed to bulk delete", ms)
 	}
 
 	q := s.table().Filter(core.Market{Status: ms}).
 		Filter(r.Row.Field(marketFieldCreatedAt).Lt(cutOff)).
 		Limit(limit).
-		Delete()
-	if err := s.db.delete(q); err != nil && err != r.ErrEmptyResult {
-		return err
+		Delete(r.DeleteOpts{ReturnChanges: true})
+	res, err := s.db.runWrite(q)
+	if err != nil && err != r.ErrEmptyResult {
+		return nil, err
 	}
-	return nil
+
+	// Collect and return deleted markets.
+	var deleted []core.Market
+	for _, c := range res.Changes {
+		var m core.Market
+		if err = encoding.Decode(&m, c.OldValue); err != nil {
+			return nil, fmt.Errorf("could not decode deleted market: %s", err)
+		}
+		deleted = append(deleted, m)
+	}
+
+	return deleted, nil
 }
 
 func (s *marketStorage) findIndexLegacy(o core.FindOpts) ([]core.Catalog, error) {
@@ -361,6 +394,7 @@ func (s *marketStorage) countIndexLegacy(o core.FindOpts) (num int, err error) {
 		Keyword:       o.Keyword,
 		KeywordFields: s.keywordFields,
 		Filter:        o.Filter,
+		FilterExprs:   o.FilterExprs,
 	}
 	q = newFindOptsQuery(q, o)
 	err = s.db.one(q.Count(), &num)
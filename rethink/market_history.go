@@ -0,0 +1,54 @@
+package rethink
+
+import (
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+	r "gopkg.in/rethinkdb/rethinkdb-go.v6"
+)
+
+const (
+	tableMarketHistory          = "market_history"
+	marketHistoryFieldMarketID  = "market_id"
+	marketHistoryFieldCreatedAt = "created_at"
+)
+
+// NewMarketHistory creates new instance of market history data store.
+func NewMarketHistory(c *Client) core.MarketHistoryStorage {
+	return &marketHistoryStorage{c}
+}
+
+type marketHistoryStorage struct {
+	db *Client
+}
+
+func (s *marketHistoryStorage) Find(marketID string) ([]core.MarketHistory, error) {
+	var res []core.MarketHistory
+	q := s.table().GetAllByIndex(marketHistoryFieldMarketID, marketID).
+		OrderBy(r.Asc(marketHistoryFieldCreatedAt))
+	if err := s.db.list(q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *marketHistoryStorage) Create(in ...core.MarketHistory) error {
+	if len(in) == 0 {
+		return nil
+	}
+
+	t := now()
+	for i := range in {
+		in[i].ID = ""
+		in[i].CreatedAt = t
+	}
+	if _, err := s.db.insert(s.table().Insert(in)); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return nil
+}
+
+func (s *marketHistoryStorage) table() r.Term {
+	return r.Table(tableMarketHistory)
+}
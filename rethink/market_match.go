@@ -0,0 +1,77 @@
+package rethink
+
+import (
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+	r "gopkg.in/rethinkdb/rethinkdb-go.v6"
+)
+
+const tableMarketMatch = "market_match"
+
+// NewMarketMatch creates new instance of market match data store.
+func NewMarketMatch(c *Client) core.MarketMatchStorage {
+	return &marketMatchStorage{c}
+}
+
+type marketMatchStorage struct {
+	db *Client
+}
+
+func (s *marketMatchStorage) Find(o core.FindOpts) ([]core.MarketMatch, error) {
+	var res []core.MarketMatch
+	if err := s.db.list(newFindOptsQuery(s.table(), o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *marketMatchStorage) Get(id string) (*core.MarketMatch, error) {
+	row := &core.MarketMatch{}
+	if err := s.db.one(s.table().Get(id), row); err != nil {
+		if err == r.ErrEmptyResult {
+			return nil, core.MarketMatchErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *marketMatchStorage) Create(in *core.MarketMatch) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(s.table().Insert(in))
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *marketMatchStorage) Update(in *core.MarketMatch) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	if err = s.db.update(s.table().Get(in.ID).Update(in)); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func (s *marketMatchStorage) table() r.Term {
+	return r.Table(tableMarketMatch)
+}
@@ -0,0 +1,293 @@
+package rethink
+
+import (
+	"fmt"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+	"github.com/kudarap/dotagiftx/migration"
+	r "gopkg.in/rethinkdb/rethinkdb-go.v6"
+)
+
+const (
+	tableMigration     = "migration"
+	migrationFieldName = "name"
+)
+
+// NewMigration creates new instance of migration data store.
+func NewMigration(c *Client) (core.MigrationStorage, error) {
+	// Migration table needs to exist before any migration could be tracked.
+	if err := c.autoMigrate(tableMigration); err != nil {
+		return nil, fmt.Errorf("could not create %s table: %s", tableMigration, err)
+	}
+
+	if err := c.autoIndex(tableMigration, core.Migration{}); err != nil {
+		return nil, fmt.Errorf("could not create index on %s table: %s", tableMigration, err)
+	}
+
+	return &migrationStorage{c}, nil
+}
+
+type migrationStorage struct {
+	db *Client
+}
+
+func (s *migrationStorage) Find() ([]core.Migration, error) {
+	var res []core.Migration
+	if err := s.db.list(s.table(), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *migrationStorage) Create(in *core.Migration) error {
+	in.ID = ""
+	in.AppliedAt = now()
+	id, err := s.db.insert(s.table().Insert(in))
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *migrationStorage) Delete(name string) error {
+	q := s.table().GetAllByIndex(migrationFieldName, name).Delete()
+	if err := s.db.delete(q); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return nil
+}
+
+func (s *migrationStorage) table() r.Term {
+	return r.Table(tableMigration)
+}
+
+// schemaTables lists tables and its model that has tag "indexed" fields.
+var schemaTables = []struct {
+	name  string
+	model interface{}
+}{
+	{tableAuth, nil},
+	{tableUser, nil},
+	{tableItem, nil},
+	{tableCatalog, core.Catalog{}},
+	{tableMarket, core.Market{}},
+	{tableDelivery, core.Delivery{}},
+	{tableInventory, core.Inventory{}},
+	{tableTrack, core.Track{}},
+	{tableReport, core.Report{}},
+}
+
+// Migrations returns rethink schema migrations that creates tables and indexes.
+func Migrations(c *Client) []migration.Migration {
+	return []migration.Migration{
+		{
+			Name: "0001_create_tables",
+			Up: func() error {
+				for _, t := range schemaTables {
+					if err := c.autoMigrate(t.name); err != nil {
+						return fmt.Errorf("could not create %s table: %s", t.name, err)
+					}
+				}
+				return nil
+			},
+			// Baseline tables holds production records and are never dropped.
+			Down: func() error {
+				return migration.ErrIrreversible
+			},
+		},
+		{
+			Name: "0002_create_indexes",
+			Up: func() error {
+				if err := c.createIndex(tableUser, userFieldSteamID); err != nil {
+					return fmt.Errorf("could not create index on %s table: %s", tableUser, err)
+				}
+				if err := c.createIndex(tableItem, itemFieldSlug); err != nil {
+					return fmt.Errorf("could not create index on %s table: %s", tableItem, err)
+				}
+				for _, t := range schemaTables {
+					if t.model == nil {
+						continue
+					}
+					if err := c.autoIndex(t.name, t.model); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Down: func() error {
+				if err := c.dropIndex(tableUser, userFieldSteamID); err != nil {
+					return err
+				}
+				if err := c.dropIndex(tableItem, itemFieldSlug); err != nil {
+					return err
+				}
+				for _, t := range schemaTables {
+					if t.model == nil {
+						continue
+					}
+					for _, ff := range getModelIndexedFields(t.model) {
+						if err := c.dropIndex(t.name, ff); err != nil {
+							return err
+						}
+					}
+				}
+				return nil
+			},
+		},
+		{
+			Name: "0003_create_market_history",
+			Up: func() error {
+				if err := c.autoMigrate(tableMarketHistory); err != nil {
+					return fmt.Errorf("could not create %s table: %s", tableMarketHistory, err)
+				}
+				return c.autoIndex(tableMarketHistory, core.MarketHistory{})
+			},
+			Down: func() error {
+				return c.dropTable(tableMarketHistory)
+			},
+		},
+		{
+			Name: "0004_create_webhooks",
+			Up: func() error {
+				if err := c.autoMigrate(tableWebhook); err != nil {
+					return fmt.Errorf("could not create %s table: %s", tableWebhook, err)
+				}
+				if err := c.autoIndex(tableWebhook, core.Webhook{}); err != nil {
+					return err
+				}
+				if err := c.autoMigrate(tableWebhookDelivery); err != nil {
+					return fmt.Errorf("could not create %s table: %s", tableWebhookDelivery, err)
+				}
+				return c.autoIndex(tableWebhookDelivery, core.WebhookDelivery{})
+			},
+			Down: func() error {
+				if err := c.dropTable(tableWebhookDelivery); err != nil {
+					return err
+				}
+				return c.dropTable(tableWebhook)
+			},
+		},
+		{
+			Name: "0005_create_notification_settings",
+			Up: func() error {
+				if err := c.autoMigrate(tableNotification); err != nil {
+					return fmt.Errorf("could not create %s table: %s", tableNotification, err)
+				}
+				return c.autoIndex(tableNotification, core.NotificationSetting{})
+			},
+			Down: func() error {
+				return c.dropTable(tableNotification)
+			},
+		},
+		{
+			Name: "0006_create_watchlists",
+			Up: func() error {
+				if err := c.autoMigrate(tableWatchlist); err != nil {
+					return fmt.Errorf("could not create %s table: %s", tableWatchlist, err)
+				}
+				if err := c.autoIndex(tableWatchlist, core.Watchlist{}); err != nil {
+					return err
+				}
+				if err := c.autoMigrate(tableWatchlistAlert); err != nil {
+					return fmt.Errorf("could not create %s table: %s", tableWatchlistAlert, err)
+				}
+				return c.autoIndex(tableWatchlistAlert, core.WatchlistAlert{})
+			},
+			Down: func() error {
+				if err := c.dropTable(tableWatchlistAlert); err != nil {
+					return err
+				}
+				return c.dropTable(tableWatchlist)
+			},
+		},
+		{
+			Name: "0007_create_market_matches",
+			Up: func() error {
+				if err := c.autoMigrate(tableMarketMatch); err != nil {
+					return fmt.Errorf("could not create %s table: %s", tableMarketMatch, err)
+				}
+				return c.autoIndex(tableMarketMatch, core.MarketMatch{})
+			},
+			Down: func() error {
+				return c.dropTable(tableMarketMatch)
+			},
+		},
+		{
+			Name: "0008_create_offers",
+			Up: func() error {
+				if err := c.autoMigrate(tableOffer); err != nil {
+					return fmt.Errorf("could not create %s table: %s", tableOffer, err)
+				}
+				return c.autoIndex(tableOffer, core.Offer{})
+			},
+			Down: func() error {
+				return c.dropTable(tableOffer)
+			},
+		},
+		{
+			Name: "0009_create_exchange_rates",
+			Up: func() error {
+				if err := c.autoMigrate(tableExchangeRate); err != nil {
+					return fmt.Errorf("could not create %s table: %s", tableExchangeRate, err)
+				}
+				return c.autoIndex(tableExchangeRate, core.ExchangeRate{})
+			},
+			Down: func() error {
+				return c.dropTable(tableExchangeRate)
+			},
+		},
+		{
+			Name: "0010_create_price_candles",
+			Up: func() error {
+				if err := c.autoMigrate(tablePriceCandle); err != nil {
+					return fmt.Errorf("could not create %s table: %s", tablePriceCandle, err)
+				}
+				return c.autoIndex(tablePriceCandle, core.PriceCandle{})
+			},
+			Down: func() error {
+				return c.dropTable(tablePriceCandle)
+			},
+		},
+		{
+			Name: "0011_create_synonyms",
+			Up: func() error {
+				if err := c.autoMigrate(tableSynonym); err != nil {
+					return fmt.Errorf("could not create %s table: %s", tableSynonym, err)
+				}
+				return c.autoIndex(tableSynonym, core.Synonym{})
+			},
+			Down: func() error {
+				return c.dropTable(tableSynonym)
+			},
+		},
+		{
+			Name: "0012_create_access_tokens",
+			Up: func() error {
+				if err := c.autoMigrate(tableAccessToken); err != nil {
+					return fmt.Errorf("could not create %s table: %s", tableAccessToken, err)
+				}
+				return c.autoIndex(tableAccessToken, core.AccessToken{})
+			},
+			Down: func() error {
+				return c.dropTable(tableAccessToken)
+			},
+		},
+		{
+			Name: "0013_create_role_audits",
+			Up: func() error {
+				if err := c.autoMigrate(tableRoleAudit); err != nil {
+					return fmt.Errorf("could not create %s table: %s", tableRoleAudit, err)
+				}
+				return c.autoIndex(tableRoleAudit, core.RoleAudit{})
+			},
+			Down: func() error {
+				return c.dropTable(tableRoleAudit)
+			},
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package rethink
+
+import (
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+	r "gopkg.in/rethinkdb/rethinkdb-go.v6"
+)
+
+const (
+	tableNotification       = "notification_setting"
+	notificationFieldUserID = "user_id"
+)
+
+// NewNotification creates new instance of notification setting data store.
+func NewNotification(c *Client) core.NotificationStorage {
+	return &notificationStorage{c}
+}
+
+type notificationStorage struct {
+	db *Client
+}
+
+func (s *notificationStorage) GetByUserID(userID string) (*core.NotificationSetting, error) {
+	var res []core.NotificationSetting
+	q := s.table().GetAllByIndex(notificationFieldUserID, userID)
+	if err := s.db.list(q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if len(res) == 0 {
+		return nil, core.NotificationErrNotFound
+	}
+
+	return &res[0], nil
+}
+
+func (s *notificationStorage) get(id string) (*core.NotificationSetting, error) {
+	row := &core.NotificationSetting{}
+	if err := s.db.one(s.table().Get(id), row); err != nil {
+		if err == r.ErrEmptyResult {
+			return nil, core.NotificationErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *notificationStorage) Create(in *core.NotificationSetting) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(s.table().Insert(in))
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *notificationStorage) Update(in *core.NotificationSetting) error {
+	cur, err := s.get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	if err = s.db.update(s.table().Get(in.ID).Update(in)); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func (s *notificationStorage) table() r.Term {
+	return r.Table(tableNotification)
+}
@@ -0,0 +1,77 @@
+package rethink
+
+import (
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+	r "gopkg.in/rethinkdb/rethinkdb-go.v6"
+)
+
+const tableOffer = "offer"
+
+// NewOffer creates new instance of offer data store.
+func NewOffer(c *Client) core.OfferStorage {
+	return &offerStorage{c}
+}
+
+type offerStorage struct {
+	db *Client
+}
+
+func (s *offerStorage) Find(o core.FindOpts) ([]core.Offer, error) {
+	var res []core.Offer
+	if err := s.db.list(newFindOptsQuery(s.table(), o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *offerStorage) Get(id string) (*core.Offer, error) {
+	row := &core.Offer{}
+	if err := s.db.one(s.table().Get(id), row); err != nil {
+		if err == r.ErrEmptyResult {
+			return nil, core.OfferErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *offerStorage) Create(in *core.Offer) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(s.table().Insert(in))
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *offerStorage) Update(in *core.Offer) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	if err = s.db.update(s.table().Get(in.ID).Update(in)); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func (s *offerStorage) table() r.Term {
+	return r.Table(tableOffer)
+}
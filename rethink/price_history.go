@@ -0,0 +1,106 @@
+package rethink
+
+import (
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+	r "gopkg.in/rethinkdb/rethinkdb-go.v6"
+)
+
+const tablePriceCandle = "price_candle"
+
+// NewPriceHistory creates new instance of price history data store.
+func NewPriceHistory(c *Client) core.PriceHistoryStorage {
+	return &priceHistoryStorage{c}
+}
+
+type priceHistoryStorage struct {
+	db *Client
+}
+
+func (s *priceHistoryStorage) Find(o core.FindOpts) ([]core.PriceCandle, error) {
+	var res []core.PriceCandle
+	if err := s.db.list(newFindOptsQuery(s.table(), o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *priceHistoryStorage) Get(id string) (*core.PriceCandle, error) {
+	row := &core.PriceCandle{}
+	if err := s.db.one(s.table().Get(id), row); err != nil {
+		if err == r.ErrEmptyResult {
+			return nil, core.PriceHistoryErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *priceHistoryStorage) Create(in *core.PriceCandle) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = in.Key
+	id, err := s.db.insert(s.table().Insert(in))
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *priceHistoryStorage) Update(in *core.PriceCandle) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	if err = s.db.update(s.table().Get(in.ID).Update(in)); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+// AddPrice inserts the candle with its key as id and resolves conflict with
+// the stored candle within the same write.
+func (s *priceHistoryStorage) AddPrice(in *core.PriceCandle, price float64) error {
+	c := *in
+	c.ID = c.Key
+	c.Volume = 0
+	c.Add(price)
+	t := now()
+	c.CreatedAt = t
+	c.UpdatedAt = t
+
+	q := s.table().Insert(c, r.InsertOpts{
+		Conflict: func(_, old, _ r.Term) interface{} {
+			return old.Merge(map[string]interface{}{
+				"high":       r.Expr([]interface{}{old.Field("high"), price}).Max(),
+				"low":        r.Expr([]interface{}{old.Field("low"), price}).Min(),
+				"close":      price,
+				"volume":     old.Field("volume").Default(0).Add(1),
+				"updated_at": t,
+			})
+		},
+	})
+	if err := s.db.update(q); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return nil
+}
+
+func (s *priceHistoryStorage) table() r.Term {
+	return r.Table(tablePriceCandle)
+}
@@ -1,8 +1,6 @@
 package rethink
 
 import (
-	"log"
-
 	"github.com/kudarap/dotagiftx/core"
 	"github.com/kudarap/dotagiftx/errors"
 	r "gopkg.in/rethinkdb/rethinkdb-go.v6"
@@ -17,14 +15,6 @@ var reportSearchFields = []string{"label", "text"}
 
 // NewReport creates new instance of report data store.
 func NewReport(c *Client) core.ReportStorage {
-	if err := c.autoMigrate(tableReport); err != nil {
-		log.Fatalf("could not create %s table: %s", tableReport, err)
-	}
-
-	if err := c.autoIndex(tableReport, core.Report{}); err != nil {
-		log.Fatalf("could not create index on %s table: %s", tableReport, err)
-	}
-
 	return &reportStorage{c, reportSearchFields}
 }
 
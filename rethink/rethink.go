@@ -63,14 +63,37 @@ func (c *Client) autoMigrate(table string) error {
 		}
 	}
 
-	return c.exec(r.TableCreate(table))
+	if err := c.exec(r.TableCreate(table)); err != nil {
+		return err
+	}
+
+	c.tables = append(c.tables, table)
+	return nil
+}
+
+// dropTable removes table and its records. Missing table will be ignored.
+func (c *Client) dropTable(table string) error {
+	for i, t := range c.tables {
+		if t != table {
+			continue
+		}
+
+		if err := c.exec(r.TableDrop(table)); err != nil {
+			return err
+		}
+
+		c.tables = append(c.tables[:i], c.tables[i+1:]...)
+		return nil
+	}
+
+	return nil
 }
 
 // autoIndex creates table index base model that has tag "index".
 func (c *Client) autoIndex(table string, model interface{}) error {
 	for _, ff := range getModelIndexedFields(model) {
 		if err := c.createIndex(table, ff); err != nil {
-			return fmt.Errorf("could not create %s index on %s table: %s", ff, tableCatalog, err)
+			return fmt.Errorf("could not create %s index on %s table: %s", ff, table, err)
 		}
 	}
 
@@ -157,6 +180,24 @@ func (c *Client) createIndex(tableName, index string) error {
 	return c.exec(tbl.IndexCreate(index))
 }
 
+func (c *Client) dropIndex(tableName, index string) error {
+	tbl := r.Table(tableName)
+
+	var indexes []string
+	if err := c.list(tbl.IndexList(), &indexes); err != nil {
+		return err
+	}
+
+	for _, ii := range indexes {
+		if ii == index {
+			return c.exec(tbl.IndexDrop(index))
+		}
+	}
+
+	// Skip missing index.
+	return nil
+}
+
 func getTables(s *r.Session) (table []string, err error) {
 	res, _ := r.TableList().Run(s)
 	err = res.All(&table)
@@ -0,0 +1,45 @@
+package rethink
+
+import (
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+	r "gopkg.in/rethinkdb/rethinkdb-go.v6"
+)
+
+const tableRoleAudit = "role_audit"
+
+// NewRoleAudit creates new instance of role audit data store.
+func NewRoleAudit(c *Client) core.RoleAuditStorage {
+	return &roleAuditStorage{c}
+}
+
+type roleAuditStorage struct {
+	db *Client
+}
+
+func (s *roleAuditStorage) Find(o core.FindOpts) ([]core.RoleAudit, error) {
+	var res []core.RoleAudit
+	if err := s.db.list(newFindOptsQuery(s.table(), o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *roleAuditStorage) Create(in *core.RoleAudit) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(s.table().Insert(in))
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *roleAuditStorage) table() r.Term {
+	return r.Table(tableRoleAudit)
+}
@@ -1,8 +1,6 @@
 package rethink
 
 import (
-	"log"
-
 	"github.com/kudarap/dotagiftx/core"
 	"github.com/kudarap/dotagiftx/errors"
 	r "gopkg.in/rethinkdb/rethinkdb-go.v6"
@@ -17,14 +15,6 @@ const (
 
 // NewTrack creates new instance of track data store.
 func NewTrack(c *Client) *trackStorage {
-	if err := c.autoMigrate(tableTrack); err != nil {
-		log.Fatalf("could not create %s table: %s", tableTrack, err)
-	}
-
-	if err := c.autoIndex(tableTrack, core.Track{}); err != nil {
-		log.Fatalf("could not create index on %s table: %s", tableMarket, err)
-	}
-
 	return &trackStorage{c, []string{"item_id"}}
 }
 
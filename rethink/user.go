@@ -1,8 +1,6 @@
 package rethink
 
 import (
-	"log"
-
 	"github.com/imdario/mergo"
 	"github.com/kudarap/dotagiftx/core"
 	"github.com/kudarap/dotagiftx/errors"
@@ -12,20 +10,13 @@ import (
 const (
 	tableUser        = "user"
 	userFieldSteamID = "steam_id"
+	userFieldRoles   = "roles"
 )
 
 var userSearchFields = []string{"name", "steam_id", "url"}
 
 // NewUser creates new instance of user data store.
 func NewUser(c *Client) core.UserStorage {
-	if err := c.autoMigrate(tableUser); err != nil {
-		log.Fatalf("could not create %s table: %s", tableUser, err)
-	}
-
-	if err := c.createIndex(tableUser, userFieldSteamID); err != nil {
-		log.Fatalf("could not create index on %s table: %s", tableUser, err)
-	}
-
 	return &userStorage{c, userSearchFields}
 }
 
@@ -61,6 +52,23 @@ func (s *userStorage) flaggedFilter(q r.Term) r.Term {
 	})
 }
 
+func (s *userStorage) FindStaff(o core.FindOpts) ([]core.User, error) {
+	var res []core.User
+	o.KeywordFields = s.keywordFields
+	q := baseFindOptsQuery(s.table(), o, s.staffFilter)
+	if err := s.db.list(q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *userStorage) staffFilter(q r.Term) r.Term {
+	return q.Filter(func(t r.Term) interface{} {
+		return t.Field(userFieldRoles).Default([]interface{}{}).Count().Gt(0)
+	})
+}
+
 func (s *userStorage) Count(o core.FindOpts) (num int, err error) {
 	o = core.FindOpts{Filter: o.Filter, UserID: o.UserID}
 	q := newFindOptsQuery(s.table(), o)
@@ -138,6 +146,34 @@ func (s *userStorage) BaseUpdate(in *core.User) error {
 	return nil
 }
 
+func (s *userStorage) AddRole(id, role string) error {
+	return s.updateRoles(id, func(roles r.Term) r.Term {
+		return roles.SetInsert(role)
+	})
+}
+
+func (s *userStorage) RemoveRole(id, role string) error {
+	return s.updateRoles(id, func(roles r.Term) r.Term {
+		return roles.SetDifference([]string{role})
+	})
+}
+
+// updateRoles replaces user roles from its current roles within a single
+// document update, unlike Update it persists empty roles.
+func (s *userStorage) updateRoles(id string, fn func(roles r.Term) r.Term) error {
+	q := s.table().Get(id).Update(func(t r.Term) interface{} {
+		return map[string]interface{}{
+			userFieldRoles: fn(t.Field(userFieldRoles).Default([]interface{}{})),
+			"updated_at":   now(),
+		}
+	})
+	if err := s.db.update(q); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return nil
+}
+
 func (s *userStorage) table() r.Term {
 	return r.Table(tableUser)
 }
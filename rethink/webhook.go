@@ -0,0 +1,155 @@
+package rethink
+
+import (
+	"time"
+
+	"github.com/imdario/mergo"
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+	r "gopkg.in/rethinkdb/rethinkdb-go.v6"
+)
+
+const (
+	tableWebhook                      = "webhook"
+	tableWebhookDelivery              = "webhook_delivery"
+	webhookDeliveryFieldWebhookID     = "webhook_id"
+	webhookDeliveryFieldStatus        = "status"
+	webhookDeliveryFieldNextAttemptAt = "next_attempt_at"
+	webhookDeliveryFieldCreatedAt     = "created_at"
+)
+
+// NewWebhook creates new instance of webhook data store.
+func NewWebhook(c *Client) core.WebhookStorage {
+	return &webhookStorage{c}
+}
+
+type webhookStorage struct {
+	db *Client
+}
+
+func (s *webhookStorage) Find(o core.FindOpts) ([]core.Webhook, error) {
+	var res []core.Webhook
+	if err := s.db.list(newFindOptsQuery(s.table(), o), &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *webhookStorage) Get(id string) (*core.Webhook, error) {
+	row := &core.Webhook{}
+	if err := s.db.one(s.table().Get(id), row); err != nil {
+		if err == r.ErrEmptyResult {
+			return nil, core.WebhookErrNotFound
+		}
+
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return row, nil
+}
+
+func (s *webhookStorage) Create(in *core.Webhook) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(s.table().Insert(in))
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *webhookStorage) Update(in *core.Webhook) error {
+	cur, err := s.Get(in.ID)
+	if err != nil {
+		return err
+	}
+
+	in.UpdatedAt = now()
+	if err = s.db.update(s.table().Get(in.ID).Update(in)); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	if err = mergo.Merge(in, cur); err != nil {
+		return errors.New(core.StorageMergeErr, err)
+	}
+
+	return nil
+}
+
+func (s *webhookStorage) Delete(id string) error {
+	if err := s.db.delete(s.table().Get(id).Delete()); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return nil
+}
+
+func (s *webhookStorage) table() r.Term {
+	return r.Table(tableWebhook)
+}
+
+// NewWebhookDelivery creates new instance of webhook delivery data store.
+func NewWebhookDelivery(c *Client) core.WebhookDeliveryStorage {
+	return &webhookDeliveryStorage{c}
+}
+
+type webhookDeliveryStorage struct {
+	db *Client
+}
+
+func (s *webhookDeliveryStorage) Find(webhookID string, limit int) ([]core.WebhookDelivery, error) {
+	var res []core.WebhookDelivery
+	q := s.table().GetAllByIndex(webhookDeliveryFieldWebhookID, webhookID).
+		OrderBy(r.Desc(webhookDeliveryFieldCreatedAt)).
+		Limit(limit)
+	if err := s.db.list(q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *webhookDeliveryStorage) ToDeliver(limit int) ([]core.WebhookDelivery, error) {
+	var res []core.WebhookDelivery
+	q := s.table().GetAllByIndex(webhookDeliveryFieldStatus, core.WebhookDeliveryStatusPending).
+		Filter(r.Row.Field(webhookDeliveryFieldNextAttemptAt).Le(time.Now())).
+		OrderBy(r.Asc(webhookDeliveryFieldNextAttemptAt)).
+		Limit(limit)
+	if err := s.db.list(q, &res); err != nil {
+		return nil, errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return res, nil
+}
+
+func (s *webhookDeliveryStorage) Create(in *core.WebhookDelivery) error {
+	t := now()
+	in.CreatedAt = t
+	in.UpdatedAt = t
+	in.ID = ""
+	id, err := s.db.insert(s.table().Insert(in))
+	if err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+	in.ID = id
+
+	return nil
+}
+
+func (s *webhookDeliveryStorage) Update(in *core.WebhookDelivery) error {
+	in.UpdatedAt = now()
+	if err := s.db.update(s.table().Get(in.ID).Update(in)); err != nil {
+		return errors.New(core.StorageUncaughtErr, err)
+	}
+
+	return nil
+}
+
+func (s *webhookDeliveryStorage) table() r.Term {
+	return r.Table(tableWebhookDelivery)
+}
@@ -0,0 +1,333 @@
+// Package search provides full-text search index of catalogs and markets
+// backed by bleve indexes on local disk.
+package search
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/custom"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/v2/analysis/lang/en"
+	"github.com/blevesearch/bleve/v2/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/v2/analysis/tokenizer/unicode"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/query"
+	"github.com/kudarap/dotagiftx/core"
+)
+
+const (
+	analyzerName = "dotagiftx"
+
+	// Index directories are versioned with their document fields, new
+	// version creates a new index that gets rebuilt from existing records.
+	catalogIndexDir = "catalog"
+	marketIndexDir  = "market_v2"
+
+	// exactMatchBoost favors whole word matches over prefix matches.
+	exactMatchBoost = 2
+	// fuzzyMatchBoost ranks typo tolerant matches below prefix matches.
+	fuzzyMatchBoost = 0.5
+	// fuzzyMinLength shortest token that allows typos, shorter tokens
+	// matches too many unrelated words.
+	fuzzyMinLength = 4
+	// fuzzyMaxLength token length that allows two typos.
+	fuzzyMaxLength = 8
+)
+
+// Searchable fields and its relevance weight.
+var (
+	catalogFields = map[string]float64{
+		"name":   3,
+		"hero":   2,
+		"origin": 1,
+		"rarity": 1,
+	}
+	marketFields = map[string]float64{
+		"name":   3,
+		"hero":   2,
+		"origin": 1,
+		"rarity": 1,
+		"seller": 1,
+		"notes":  0.5,
+	}
+)
+
+// Config represents search index config.
+type Config struct {
+	Path string
+}
+
+// Index represents catalog and market search indexes.
+type Index struct {
+	catalogs bleve.Index
+	markets  bleve.Index
+	created  bool
+}
+
+type (
+	catalogDocument struct {
+		Name   string `json:"name"`
+		Hero   string `json:"hero"`
+		Origin string `json:"origin"`
+		Rarity string `json:"rarity"`
+	}
+
+	marketDocument struct {
+		Name   string `json:"name"`
+		Hero   string `json:"hero"`
+		Origin string `json:"origin"`
+		Rarity string `json:"rarity"`
+		Seller string `json:"seller"`
+		Notes  string `json:"notes"`
+		// Filter fields.
+		Type   float64 `json:"type"`
+		Status float64 `json:"status"`
+		UserID string  `json:"user_id"`
+	}
+)
+
+// New returns search index that opens existing indexes on the config path
+// or creates new ones.
+func New(c Config) (*Index, error) {
+	if c.Path == "" {
+		return nil, fmt.Errorf("search index path is required")
+	}
+
+	cat, catNew, err := open(filepath.Join(c.Path, catalogIndexDir))
+	if err != nil {
+		return nil, fmt.Errorf("could not open catalog search index: %s", err)
+	}
+	mkt, mktNew, err := open(filepath.Join(c.Path, marketIndexDir))
+	if err != nil {
+		cat.Close()
+		return nil, fmt.Errorf("could not open market search index: %s", err)
+	}
+
+	return &Index{cat, mkt, catNew || mktNew}, nil
+}
+
+func open(path string) (idx bleve.Index, created bool, err error) {
+	idx, err = bleve.Open(path)
+	if err == bleve.ErrorIndexPathDoesNotExist {
+		idx, err = bleve.New(path, newMapping())
+		created = true
+	}
+
+	return
+}
+
+func newMapping() mapping.IndexMapping {
+	m := bleve.NewIndexMapping()
+	// Tokens are lower cased and possessive "'s" are removed to match
+	// "collector's" and "collector".
+	_ = m.AddCustomAnalyzer(analyzerName, map[string]interface{}{
+		"type":          custom.Name,
+		"tokenizer":     unicode.Name,
+		"token_filters": []string{lowercase.Name, en.PossessiveName},
+	})
+	m.DefaultAnalyzer = analyzerName
+	m.StoreDynamic = false
+	m.DocValuesDynamic = false
+
+	// Filter fields are matched as is.
+	num := bleve.NewNumericFieldMapping()
+	num.Store = false
+	num.IncludeInAll = false
+	id := bleve.NewTextFieldMapping()
+	id.Analyzer = keyword.Name
+	id.Store = false
+	id.IncludeInAll = false
+	m.DefaultMapping.AddFieldMappingsAt("type", num)
+	m.DefaultMapping.AddFieldMappingsAt("status", num)
+	m.DefaultMapping.AddFieldMappingsAt("user_id", id)
+	return m
+}
+
+// IsNew returns true when indexes were just created and needs to be
+// rebuilt from existing records.
+func (i *Index) IsNew() bool {
+	return i.created
+}
+
+// Close closes search indexes.
+func (i *Index) Close() error {
+	if err := i.catalogs.Close(); err != nil {
+		return err
+	}
+
+	return i.markets.Close()
+}
+
+func (i *Index) IndexCatalog(c core.Catalog) error {
+	return i.catalogs.Index(c.ID, catalogDocument{
+		Name:   c.Name,
+		Hero:   c.Hero,
+		Origin: c.Origin,
+		Rarity: c.Rarity,
+	})
+}
+
+func (i *Index) IndexMarket(m core.Market) error {
+	var doc marketDocument
+	if m.Item != nil {
+		doc.Name = m.Item.Name
+		doc.Hero = m.Item.Hero
+		doc.Origin = m.Item.Origin
+		doc.Rarity = m.Item.Rarity
+	}
+	if m.User != nil {
+		doc.Seller = m.User.Name
+	}
+	doc.Notes = m.Notes
+	doc.Type = float64(m.Type)
+	doc.Status = float64(m.Status)
+	doc.UserID = m.UserID
+
+	return i.markets.Index(m.ID, doc)
+}
+
+func (i *Index) DeleteMarket(id string) error {
+	return i.markets.Delete(id)
+}
+
+func (i *Index) SearchCatalogs(keyword string, synonyms core.SynonymDictionary) ([]string, error) {
+	return search(i.catalogs, keyword, synonyms, catalogFields, nil)
+}
+
+func (i *Index) SearchMarkets(keyword string, synonyms core.SynonymDictionary, filter core.MarketSearchFilter) ([]string, error) {
+	var ff []query.Query
+	if filter.Type != 0 {
+		ff = append(ff, numberQuery("type", float64(filter.Type)))
+	}
+	if filter.Status != 0 {
+		ff = append(ff, numberQuery("status", float64(filter.Status)))
+	}
+	if filter.UserID != "" {
+		q := bleve.NewTermQuery(filter.UserID)
+		q.SetField("user_id")
+		ff = append(ff, q)
+	}
+
+	return search(i.markets, keyword, synonyms, marketFields, ff)
+}
+
+// search returns ids of documents that matches every keyword token or its
+// synonyms on any of the fields and all filters, ordered by relevance.
+func search(
+	idx bleve.Index,
+	keyword string,
+	synonyms core.SynonymDictionary,
+	fields map[string]float64,
+	filters []query.Query,
+) ([]string, error) {
+	tokens := analyze(idx, keyword)
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	var must []query.Query
+	for _, t := range tokens {
+		should := []query.Query{tokenQuery(t, fields)}
+		// Synonym matches every word it stands for, e.g. "am" for "anti-mage".
+		for _, s := range synonyms.Expand(t) {
+			var words []query.Query
+			for _, w := range analyze(idx, s) {
+				words = append(words, tokenQuery(w, fields))
+			}
+			if len(words) != 0 {
+				should = append(should, bleve.NewConjunctionQuery(words...))
+			}
+		}
+		must = append(must, bleve.NewDisjunctionQuery(should...))
+	}
+	must = append(must, filters...)
+
+	req := bleve.NewSearchRequestOptions(bleve.NewConjunctionQuery(must...), core.SearchMaxResults, 0, false)
+	res, err := idx.Search(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(res.Hits))
+	for n, h := range res.Hits {
+		ids[n] = h.ID
+	}
+	return ids, nil
+}
+
+// numberQuery matches exact number on the field.
+func numberQuery(field string, n float64) query.Query {
+	inclusive := true
+	q := bleve.NewNumericRangeInclusiveQuery(&n, &n, &inclusive, &inclusive)
+	q.SetField(field)
+	return q
+}
+
+// tokenQuery matches token on any of the fields by prefix, whole word or
+// within edit distance for longer tokens.
+func tokenQuery(token string, fields map[string]float64) query.Query {
+	fuzziness := 0
+	if n := len([]rune(token)); n >= fuzzyMaxLength {
+		fuzziness = 2
+	} else if n >= fuzzyMinLength {
+		fuzziness = 1
+	}
+
+	var should []query.Query
+	for f, boost := range fields {
+		p := bleve.NewPrefixQuery(token)
+		p.SetField(f)
+		p.SetBoost(boost)
+		e := bleve.NewTermQuery(token)
+		e.SetField(f)
+		e.SetBoost(boost * exactMatchBoost)
+		should = append(should, p, e)
+
+		if fuzziness != 0 {
+			z := bleve.NewFuzzyQuery(token)
+			z.SetField(f)
+			z.SetFuzziness(fuzziness)
+			z.SetBoost(boost * fuzzyMatchBoost)
+			should = append(should, z)
+		}
+	}
+
+	return bleve.NewDisjunctionQuery(should...)
+}
+
+// analyze returns unique keyword tokens using the same analyzer as
+// the indexed fields.
+func analyze(idx bleve.Index, keyword string) []string {
+	a := idx.Mapping().AnalyzerNamed(analyzerName)
+	if a == nil {
+		return nil
+	}
+
+	var tokens []string
+	seen := map[string]struct{}{}
+	for _, t := range a.Analyze([]byte(normalizeKeyword(keyword))) {
+		s := string(t.Term)
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		tokens = append(tokens, s)
+	}
+
+	return tokens
+}
+
+// normalizeKeyword handles special case for the word "Collector's" with apostrophe.
+func normalizeKeyword(keyword string) string {
+	s := strings.ToLower(keyword)
+
+	// Special case for the word "Collector's" with apostrophe.
+	if strings.Contains(s, "collectors") {
+		s = strings.ReplaceAll(s, "collectors", "collector's")
+	}
+
+	return s
+}
@@ -0,0 +1,137 @@
+package search
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+func TestIndex_SearchCatalogs(t *testing.T) {
+	idx, err := New(Config{Path: t.TempDir()})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer idx.Close()
+	if !idx.IsNew() {
+		t.Error("IsNew() should be true on empty path")
+	}
+
+	for _, c := range []core.Catalog{
+		{ID: "1", Name: "Gothic Whisper", Hero: "Phantom Assassin", Origin: "Collector's Cache", Rarity: "very rare"},
+		{ID: "2", Name: "Phantom Advance", Hero: "Phantom Lancer", Origin: "Immortal Treasure I", Rarity: "regular"},
+		{ID: "3", Name: "Shadow Fiend Arcana", Hero: "Shadow Fiend", Origin: "Battle Pass", Rarity: "ultra rare"},
+		{ID: "4", Name: "Manifold Paradox", Hero: "Phantom Assassin", Origin: "Battle Pass", Rarity: "ultra rare"},
+		{ID: "5", Name: "Blades of Voth Domosh", Hero: "Anti-Mage", Origin: "Battle Pass", Rarity: "ultra rare"},
+	} {
+		if err = idx.IndexCatalog(c); err != nil {
+			t.Fatalf("IndexCatalog() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		keyword string
+		want    []string
+	}{
+		{"phan whis", []string{"1"}},
+		{"PHANTOM lanc", []string{"2"}},
+		{"collectors", []string{"1"}},
+		{"collector's cache", []string{"1"}},
+		{"shadow pass", []string{"3"}},
+		{"shadow lancer", nil},
+		{"   ", nil},
+		{"manifld paradx", []string{"4"}},
+		{"am arcana", nil},
+		{"am domosh", []string{"5"}},
+		{"pa manifold", []string{"4"}},
+		{"sf arcana", []string{"3"}},
+	}
+	synonyms := core.NewSynonymDictionary(core.DefaultSynonyms)
+	for _, tc := range tests {
+		t.Run(tc.keyword, func(t *testing.T) {
+			got, err := idx.SearchCatalogs(tc.keyword, synonyms)
+			if err != nil {
+				t.Fatalf("SearchCatalogs() error = %v", err)
+			}
+			if len(got) == 0 && len(tc.want) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("SearchCatalogs() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIndex_SearchMarkets(t *testing.T) {
+	idx, err := New(Config{Path: t.TempDir()})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer idx.Close()
+
+	item := &core.Item{Name: "Gothic Whisper", Hero: "Phantom Assassin"}
+	for _, m := range []core.Market{
+		{ID: "a", Item: item, User: &core.User{Name: "kudarap"}},
+		{ID: "b", Item: item, User: &core.User{Name: "someone"}, Notes: "fast trade"},
+	} {
+		if err = idx.IndexMarket(m); err != nil {
+			t.Fatalf("IndexMarket() error = %v", err)
+		}
+	}
+
+	got, err := idx.SearchMarkets("gothic kuda", nil, core.MarketSearchFilter{})
+	if err != nil {
+		t.Fatalf("SearchMarkets() error = %v", err)
+	}
+	if want := []string{"a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SearchMarkets() = %v, want %v", got, want)
+	}
+}
+
+func TestIndex_SearchMarketsFilter(t *testing.T) {
+	idx, err := New(Config{Path: t.TempDir()})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer idx.Close()
+
+	item := &core.Item{Name: "Gothic Whisper", Hero: "Phantom Assassin"}
+	for _, m := range []core.Market{
+		{ID: "a", UserID: "user-a1", Type: core.MarketTypeAsk, Status: core.MarketStatusLive, Item: item},
+		{ID: "b", UserID: "user-b2", Type: core.MarketTypeAsk, Status: core.MarketStatusSold, Item: item},
+		{ID: "c", UserID: "user-a1", Type: core.MarketTypeBid, Status: core.MarketStatusLive, Item: item},
+		{ID: "d", UserID: "user-b2", Type: core.MarketTypeAsk, Status: core.MarketStatusLive, Item: item},
+	} {
+		if err = idx.IndexMarket(m); err != nil {
+			t.Fatalf("IndexMarket() error = %v", err)
+		}
+	}
+	if err = idx.DeleteMarket("d"); err != nil {
+		t.Fatalf("DeleteMarket() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		filter core.MarketSearchFilter
+		want   []string
+	}{
+		{"no filter", core.MarketSearchFilter{}, []string{"a", "b", "c"}},
+		{"live asks", core.MarketSearchFilter{Type: core.MarketTypeAsk, Status: core.MarketStatusLive}, []string{"a"}},
+		{"user bids", core.MarketSearchFilter{Type: core.MarketTypeBid, UserID: "user-a1"}, []string{"c"}},
+		{"user sold", core.MarketSearchFilter{UserID: "user-b2", Status: core.MarketStatusSold}, []string{"b"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := idx.SearchMarkets("gothic", nil, tc.filter)
+			if err != nil {
+				t.Fatalf("SearchMarkets() error = %v", err)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("SearchMarkets() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
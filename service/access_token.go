@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+// accessTokenUsageInterval throttles last used time updates of access
+// tokens so requests does not write on every call.
+const accessTokenUsageInterval = time.Minute
+
+// NewAccessToken returns new AccessToken service.
+func NewAccessToken(ts core.AccessTokenStorage) core.AccessTokenService {
+	return &accessTokenService{ts}
+}
+
+type accessTokenService struct {
+	tokenStg core.AccessTokenStorage
+}
+
+func (s *accessTokenService) AccessTokens(ctx context.Context) ([]core.AccessToken, error) {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return nil, core.AuthErrNoAccess
+	}
+
+	return s.userTokens(au.UserID)
+}
+
+func (s *accessTokenService) Create(ctx context.Context, t *core.AccessToken) error {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return core.AuthErrNoAccess
+	}
+	// Access tokens should not be able to create more tokens.
+	if core.AccessTokenFromContext(ctx) != nil {
+		return core.AuthErrForbidden
+	}
+
+	t.Name = strings.TrimSpace(t.Name)
+	if err := t.CheckCreate(); err != nil {
+		if err == core.AccessTokenErrInvalidScope {
+			return err
+		}
+		return errors.New(core.AccessTokenErrRequiredFields, err)
+	}
+	now := time.Now()
+	if t.IsExpired(now) {
+		return errors.New(core.AccessTokenErrRequiredFields, fmt.Errorf("expires_at should be in the future"))
+	}
+
+	cur, err := s.userTokens(au.UserID)
+	if err != nil {
+		return err
+	}
+	var active int
+	for _, c := range cur {
+		if c.CheckActive(now) == nil {
+			active++
+		}
+	}
+	if active >= core.MaxAccessTokensPerUser {
+		return core.AccessTokenErrLimitReached
+	}
+
+	token, err := generateAccessToken()
+	if err != nil {
+		return err
+	}
+	t.UserID = au.UserID
+	t.LastUsedAt = nil
+	t.RevokedAt = nil
+	t.SetToken(token)
+	return s.tokenStg.Create(t)
+}
+
+func (s *accessTokenService) Revoke(ctx context.Context, id string) (*core.AccessToken, error) {
+	if id == "" {
+		return nil, core.AccessTokenErrRequiredID
+	}
+	t, err := s.checkOwnership(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.IsRevoked() {
+		return t, nil
+	}
+
+	now := time.Now()
+	t = &core.AccessToken{ID: id, RevokedAt: &now}
+	if err = s.tokenStg.Update(t); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (s *accessTokenService) Authenticate(token string) (*core.AccessToken, error) {
+	if !core.IsAccessToken(token) {
+		return nil, core.AccessTokenErrNotFound
+	}
+
+	t, err := s.tokenStg.GetByHash(core.HashAccessToken(token))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err = t.CheckActive(now); err != nil {
+		return nil, err
+	}
+
+	if t.LastUsedAt == nil || now.Sub(*t.LastUsedAt) > accessTokenUsageInterval {
+		// Usage tracking should not fail the request.
+		_ = s.tokenStg.Update(&core.AccessToken{ID: t.ID, LastUsedAt: &now})
+		t.LastUsedAt = &now
+	}
+
+	return t, nil
+}
+
+func (s *accessTokenService) userTokens(userID string) ([]core.AccessToken, error) {
+	return s.tokenStg.Find(core.FindOpts{
+		Filter: core.AccessToken{UserID: userID},
+		Sort:   "created_at",
+		Desc:   true,
+	})
+}
+
+func (s *accessTokenService) checkOwnership(ctx context.Context, id string) (*core.AccessToken, error) {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return nil, core.AuthErrNoAccess
+	}
+
+	t, err := s.tokenStg.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if t.UserID != au.UserID {
+		return nil, core.AccessTokenErrNotFound
+	}
+
+	return t, nil
+}
+
+func generateAccessToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return core.AccessTokenPrefix + hex.EncodeToString(b), nil
+}
@@ -57,6 +57,7 @@ func (s *authService) SteamLogin(w http.ResponseWriter, r *http.Request) (*core.
 			return nil, errors.New(core.UserErrSteamSync, err)
 		}
 
+		au.Roles = u.Roles
 		return au, nil
 	}
 
@@ -79,6 +80,13 @@ func (s *authService) RenewToken(refreshToken string) (*core.Auth, error) {
 		return nil, errors.New(core.AuthErrRefreshToken, err)
 	}
 
+	// Renewed access token picks up granted and revoked roles.
+	u, err := s.userSvc.User(au.UserID)
+	if err != nil {
+		return nil, err
+	}
+	au.Roles = u.Roles
+
 	return au, nil
 }
 
@@ -2,8 +2,11 @@ package service
 
 import (
 	"context"
+	"strings"
 
 	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/events"
+	"github.com/kudarap/dotagiftx/gokit/log"
 )
 
 func (s *marketService) Catalog(opts core.FindOpts) ([]core.Catalog, *core.FindMetadata, error) {
@@ -21,11 +24,22 @@ func (s *marketService) Catalog(opts core.FindOpts) ([]core.Catalog, *core.FindM
 	if err != nil {
 		return nil, nil, err
 	}
-
-	return res, &core.FindMetadata{
+	md := &core.FindMetadata{
 		ResultCount: len(res),
 		TotalCount:  tc,
-	}, nil
+	}
+	if n := len(res); n != 0 {
+		md.NextCursor = opts.NextCursor(n, res[n-1])
+	}
+
+	// Keyword searches includes facet counts for narrowing down results.
+	if strings.TrimSpace(opts.Keyword) != "" {
+		if md.Facets, err = s.catalogStg.Facets(opts); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return res, md, nil
 }
 
 func (s *marketService) TrendingCatalog(opts core.FindOpts) ([]core.Catalog, *core.FindMetadata, error) {
@@ -76,3 +90,28 @@ func (s *marketService) CatalogDetails(slug string, opts core.FindOpts) (*core.C
 
 	return catalog, err
 }
+
+// NewCatalogIndexPublisher returns catalog storage that publishes catalog
+// indexed event after every successful re-index.
+func NewCatalogIndexPublisher(cs core.CatalogStorage, ev events.Publisher, lg log.Logger) core.CatalogStorage {
+	return &catalogIndexPublisher{cs, ev, lg}
+}
+
+type catalogIndexPublisher struct {
+	core.CatalogStorage
+	events events.Publisher
+	logger log.Logger
+}
+
+func (p *catalogIndexPublisher) Index(itemID string) (*core.Catalog, error) {
+	c, err := p.CatalogStorage.Index(itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Index result should not fail because of event handlers.
+	if err = p.events.Publish(context.Background(), events.CatalogIndexed{Catalog: *c}); err != nil {
+		p.logger.Errorf("could not publish %s event: %s", events.TypeCatalogIndexed, err)
+	}
+	return c, nil
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/gokit/log"
+)
+
+// Catalog index queue defaults when not configured.
+const (
+	catalogIndexDefaultDebounce = time.Second * 10
+	catalogIndexDefaultSLA      = time.Minute
+	catalogIndexDefaultBatch    = 100
+
+	// Failed index requests are retried with backoff up to max attempts.
+	catalogIndexMaxAttempts   = 5
+	catalogIndexMaxRetryDelay = time.Minute * 5
+)
+
+// CatalogIndexConfig represents catalog index queue settings.
+type CatalogIndexConfig struct {
+	// Debounce duration an item stays queued before it gets indexed, index
+	// requests within this window are only indexed once.
+	Debounce time.Duration
+	// SLA maximum duration from first queued to indexed before it counts
+	// as a missed freshness target.
+	SLA time.Duration
+	// Batch number of items claimed from the queue at a time.
+	Batch int
+}
+
+func (c *CatalogIndexConfig) setDefaults() {
+	if c.Debounce <= 0 {
+		c.Debounce = catalogIndexDefaultDebounce
+	}
+	if c.SLA <= 0 {
+		c.SLA = catalogIndexDefaultSLA
+	}
+	if c.Batch <= 0 {
+		c.Batch = catalogIndexDefaultBatch
+	}
+}
+
+// NewCatalogIndexer returns new queued catalog index service.
+func NewCatalogIndexer(
+	cfg CatalogIndexConfig,
+	q core.CatalogIndexQueue,
+	cs core.CatalogStorage,
+	us core.UserStorage,
+	lg log.Logger,
+) core.CatalogIndexService {
+	cfg.setDefaults()
+	return &catalogIndexService{cfg, q, cs, us, lg}
+}
+
+type catalogIndexService struct {
+	config     CatalogIndexConfig
+	queue      core.CatalogIndexQueue
+	catalogStg core.CatalogStorage
+	userStg    core.UserStorage
+	logger     log.Logger
+}
+
+func (s *catalogIndexService) Queue(itemIDs ...string) error {
+	if _, err := s.queue.Push(itemIDs...); err != nil {
+		// Falls back to indexing right away so catalogs don't go stale
+		// while the queue is unavailable.
+		s.logger.Errorf("could not queue catalog index, indexing %d items now: %s", len(itemIDs), err)
+		for _, id := range itemIDs {
+			if _, err = s.catalogStg.Index(id); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *catalogIndexService) Drain(ctx context.Context) (*core.CatalogIndexResult, error) {
+	res := &core.CatalogIndexResult{}
+	for {
+		select {
+		case <-ctx.Done():
+			return res, nil
+		default:
+		}
+
+		// Requests claimed before a pop error are still indexed.
+		rr, err := s.queue.Pop(time.Now().Add(-s.config.Debounce), s.config.Batch)
+		if len(rr) == 0 {
+			if err != nil {
+				return res, err
+			}
+			break
+		}
+
+		batch := s.index(rr)
+		if err := s.queue.Record(batch); err != nil {
+			s.logger.Errorf("could not record catalog index metrics: %s", err)
+		}
+		res.Indexed += batch.Indexed
+		res.Failed += batch.Failed
+		res.SLAMissed += batch.SLAMissed
+		res.Latency += batch.Latency
+		if err != nil {
+			return res, err
+		}
+	}
+
+	if n := res.Indexed + res.Failed; n != 0 {
+		s.logger.Printf("catalog index drained %d items, failed:%d sla_missed:%d avg_latency:%s",
+			n, res.Failed, res.SLAMissed, res.Latency/time.Duration(n))
+	}
+	return res, nil
+}
+
+func (s *catalogIndexService) Stats(ctx context.Context) (*core.CatalogIndexStats, error) {
+	if _, err := checkPermission(ctx, s.userStg, core.PermissionCatalogIndexRead); err != nil {
+		return nil, err
+	}
+
+	return s.queue.Stats()
+}
+
+// index re-indexes claimed items and measures its latency since first queued.
+func (s *catalogIndexService) index(rr []core.CatalogIndexRequest) core.CatalogIndexResult {
+	var res core.CatalogIndexResult
+	for _, r := range rr {
+		_, err := s.catalogStg.Index(r.ItemID)
+		now := time.Now()
+		if err != nil {
+			s.logger.Errorf("could not index catalog %s: %s", r.ItemID, err)
+			res.Failed++
+			s.retry(r, now)
+		} else {
+			res.Indexed++
+		}
+		if r.IsLate(now, s.config.SLA) {
+			res.SLAMissed++
+		}
+		res.Latency += r.Latency(now)
+	}
+
+	return res
+}
+
+// retry puts back failed request with backoff until it runs out of attempts.
+func (s *catalogIndexService) retry(r core.CatalogIndexRequest, t time.Time) {
+	r.Attempts++
+	if r.Attempts >= catalogIndexMaxAttempts {
+		s.logger.Errorf("could not index catalog %s after %d attempts, dropped", r.ItemID, r.Attempts)
+		return
+	}
+
+	if err := s.queue.Retry(r, r.RetryAt(t, s.config.Debounce, catalogIndexMaxRetryDelay)); err != nil {
+		s.logger.Errorf("could not retry catalog index %s: %s", r.ItemID, err)
+	}
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+// exchangeRatesCacheExpr duration of exchange rates table kept in memory
+// before reloading it from data store.
+const exchangeRatesCacheExpr = time.Minute * 5
+
+// NewCurrency returns new Currency service.
+func NewCurrency(rs core.ExchangeRateStorage, us core.UserStorage) core.CurrencyService {
+	s := &currencyService{rateStg: rs, userStg: us}
+	s.rates = newTTLCache(exchangeRatesCacheExpr, s.loadRates)
+	return s
+}
+
+type currencyService struct {
+	rateStg core.ExchangeRateStorage
+	userStg core.UserStorage
+	rates   *ttlCache
+}
+
+func (s *currencyService) ExchangeRates(ctx context.Context) ([]core.ExchangeRate, error) {
+	return s.rateStg.Find(core.FindOpts{Sort: "currency"})
+}
+
+func (s *currencyService) UpdateExchangeRates(ctx context.Context, rates []core.ExchangeRate) error {
+	if _, err := checkPermission(ctx, s.userStg, core.PermissionExchangeRatesWrite); err != nil {
+		return err
+	}
+
+	return s.save(rates)
+}
+
+func (s *currencyService) LoadExchangeRates(r io.Reader) error {
+	rates, err := core.ParseExchangeRates(r)
+	if err != nil {
+		return err
+	}
+
+	return s.save(rates)
+}
+
+func (s *currencyService) Rates() (core.ExchangeRates, error) {
+	rates, err := s.rates.get()
+	if err != nil {
+		return nil, err
+	}
+
+	return rates.(core.ExchangeRates), nil
+}
+
+// save creates or updates exchange rates by currency and reloads the rates table.
+func (s *currencyService) save(rates []core.ExchangeRate) error {
+	for _, r := range rates {
+		if err := r.CheckUpdate(); err != nil {
+			return errors.New(core.CurrencyErrRequiredFields, err)
+		}
+	}
+
+	cur, err := s.rateStg.Find(core.FindOpts{})
+	if err != nil {
+		return err
+	}
+	ids := map[string]string{}
+	for _, c := range cur {
+		ids[c.Currency] = c.ID
+	}
+
+	for c, rate := range core.NewExchangeRates(rates) {
+		if c == core.BaseCurrency {
+			continue
+		}
+
+		r := &core.ExchangeRate{ID: ids[c], Currency: c, Rate: rate}
+		if r.ID == "" {
+			err = s.rateStg.Create(r)
+		} else {
+			err = s.rateStg.Update(r)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = s.rates.reload()
+	return err
+}
+
+func (s *currencyService) loadRates() (interface{}, error) {
+	res, err := s.rateStg.Find(core.FindOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	return core.NewExchangeRates(res), nil
+}
@@ -2,20 +2,23 @@ package service
 
 import (
 	"context"
-	"log"
 
 	"github.com/kudarap/dotagiftx/core"
 	"github.com/kudarap/dotagiftx/errors"
+	"github.com/kudarap/dotagiftx/events"
+	"github.com/kudarap/dotagiftx/gokit/log"
 )
 
 // NewDelivery returns new Delivery service.
-func NewDelivery(rs core.DeliveryStorage, ms core.MarketStorage) core.DeliveryService {
-	return &deliveryService{rs, ms}
+func NewDelivery(rs core.DeliveryStorage, ms core.MarketStorage, ev events.Publisher, lg log.Logger) core.DeliveryService {
+	return &deliveryService{rs, ms, ev, lg}
 }
 
 type deliveryService struct {
 	deliveryStg core.DeliveryStorage
 	marketStg   core.MarketStorage
+	events      events.Publisher
+	logger      log.Logger
 }
 
 func (s *deliveryService) Deliveries(opts core.FindOpts) ([]core.Delivery, *core.FindMetadata, error) {
@@ -57,17 +60,11 @@ func (s *deliveryService) DeliveryByMarketID(marketID string) (*core.Delivery, e
 	return s.deliveryStg.GetByMarketID(marketID)
 }
 
-func (s *deliveryService) Set(_ context.Context, del *core.Delivery) error {
+func (s *deliveryService) Set(ctx context.Context, del *core.Delivery) error {
 	if err := del.CheckCreate(); err != nil {
 		return errors.New(core.DeliveryErrRequiredFields, err)
 	}
 
-	defer func() {
-		if _, err := s.marketStg.Index(del.MarketID); err != nil {
-			log.Printf("could not index market %s: %s", del.MarketID, err)
-		}
-	}()
-
 	// Detect if there are still un-opened gift.
 	del = del.IsGiftOpened()
 
@@ -85,8 +82,17 @@ func (s *deliveryService) Set(_ context.Context, del *core.Delivery) error {
 		del.ID = cur.ID
 		del.Retries = cur.Retries + 1
 		del = del.AddAssets(cur.Assets)
-		return s.deliveryStg.Update(del)
+		if err := s.deliveryStg.Update(del); err != nil {
+			return err
+		}
+	} else if err := s.deliveryStg.Create(del); err != nil {
+		return err
+	}
+
+	// Record was already saved and should not fail the request.
+	if err := s.events.Publish(ctx, events.DeliveryVerified{Delivery: *del}); err != nil {
+		s.logger.Errorf("could not publish delivery %s: %s", del.MarketID, err)
 	}
 
-	return s.deliveryStg.Create(del)
+	return nil
 }
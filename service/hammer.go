@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/events"
 )
 
 var ErrHammerNotWeilded = errors.New("user is not weilding a hmmer")
@@ -13,13 +14,20 @@ var ErrHammerNotWeilded = errors.New("user is not weilding a hmmer")
 const markedOfBaal = 10000
 
 // NewHammerService returns a new Ban service.
-func NewHammerService(us core.UserStorage, ms core.MarketStorage) *BanService {
-	return &BanService{us, ms}
+func NewHammerService(
+	us core.UserStorage,
+	ms core.MarketStorage,
+	hs core.MarketHistoryStorage,
+	ev events.Publisher,
+) *BanService {
+	return &BanService{us, ms, hs, ev}
 }
 
 type BanService struct {
-	userStg   core.UserStorage
-	marketStg core.MarketStorage
+	userStg    core.UserStorage
+	marketStg  core.MarketStorage
+	historyStg core.MarketHistoryStorage
+	events     events.Publisher
 }
 
 func (s *BanService) Ban(ctx context.Context, p core.HammerParams) (*core.User, error) {
@@ -48,12 +56,14 @@ func (s *BanService) Lift(ctx context.Context, steamID string, restoreListings b
 		return err
 	}
 
-	if !restoreListings {
-		return nil
+	// Listing restoration
+	if restoreListings {
+		if err = s.restoreListings(au.UserID, u.ID); err != nil {
+			return err
+		}
 	}
 
-	// Listing restoration
-	return s.restoreListings(u.ID)
+	return s.events.Publish(ctx, events.UserLifted{User: *u, ActorID: au.UserID, RestoreListings: restoreListings})
 }
 
 func (s *BanService) hilt(ctx context.Context, p core.HammerParams, us core.UserStatus) (*core.User, error) {
@@ -80,38 +90,81 @@ func (s *BanService) hilt(ctx context.Context, p core.HammerParams, us core.User
 		return nil, err
 	}
 
-	if err := s.cancelListings(u.ID); err != nil {
+	if err := s.cancelListings(au.UserID, u.ID); err != nil {
+		return nil, err
+	}
+
+	var e events.Event = events.UserBanned{User: *u, ActorID: au.UserID}
+	if us == core.UserStatusSuspended {
+		e = events.UserSuspended{User: *u, ActorID: au.UserID}
+	}
+	if err := s.events.Publish(ctx, e); err != nil {
 		return nil, err
 	}
 
 	return u, nil
 }
 
-func (s *BanService) cancelListings(userID string) error {
-	return s.sunderListings(userID, core.MarketStatusLive, core.MarketStatusCancelled)
-}
+func (s *BanService) cancelListings(actorID, userID string) error {
+	ms, err := s.marketStg.Find(core.FindOpts{Filter: core.Market{UserID: userID, Status: core.MarketStatusLive}})
+	if err != nil {
+		return err
+	}
 
-func (s *BanService) restoreListings(userID string) error {
-	return s.sunderListings(userID, core.MarketStatusCancelled, core.MarketStatusLive)
+	return s.sunderListings(actorID, ms, core.MarketStatusLive, core.MarketStatusCancelled, core.Market.CheckStatusTransition)
 }
 
-func (s *BanService) sunderListings(userID string, from, to core.MarketStatus) error {
-	f := core.Market{
-		UserID: userID,
-		Status: from,
-	}
-	ms, err := s.marketStg.Find(core.FindOpts{Filter: f})
+// restoreListings puts back listings cancelled by hammer to live, listings
+// cancelled by its owner stays cancelled. Listings without history were
+// cancelled before it was recorded and are restored like before.
+func (s *BanService) restoreListings(actorID, userID string) error {
+	ms, err := s.marketStg.Find(core.FindOpts{Filter: core.Market{UserID: userID, Status: core.MarketStatusCancelled}})
 	if err != nil {
 		return err
 	}
 
+	var hammered []core.Market
 	for _, mm := range ms {
-		mm.Status = to
-		if err := s.marketStg.BaseUpdate(&mm); err != nil {
+		hh, err := s.historyStg.Find(mm.ID)
+		if err != nil {
 			return err
 		}
+		n := len(hh)
+		if n == 0 || (hh[n-1].Source == core.MarketHistorySourceHammer &&
+			hh[n-1].PrevStatus == core.MarketStatusLive && hh[n-1].Status == core.MarketStatusCancelled) {
+			hammered = append(hammered, mm)
+		}
 	}
-	return nil
+
+	return s.sunderListings(actorID, hammered, core.MarketStatusCancelled, core.MarketStatusLive, core.Market.CheckLiftTransition)
+}
+
+func (s *BanService) sunderListings(
+	actorID string,
+	ms []core.Market,
+	from, to core.MarketStatus,
+	check func(core.Market, core.MarketStatus) error,
+) (err error) {
+	// Validates all listings first so none gets updated on invalid transition.
+	for _, mm := range ms {
+		if err = check(mm, to); err != nil {
+			return err
+		}
+	}
+
+	var hh []core.MarketHistory
+	for _, mm := range ms {
+		mm.Status = to
+		if err = s.marketStg.BaseUpdate(&mm); err != nil {
+			break
+		}
+		hh = append(hh, core.NewMarketHistory(mm, from, actorID, core.MarketHistorySourceHammer))
+	}
+	// Records history of updated listings even when one of them failed.
+	if herr := s.historyStg.Create(hh...); herr != nil && err == nil {
+		err = herr
+	}
+	return err
 }
 
 func (s *BanService) weildingHammer(userID string) error {
@@ -120,7 +173,7 @@ func (s *BanService) weildingHammer(userID string) error {
 		return err
 	}
 
-	if u == nil || !u.Hammer {
+	if u == nil || !u.HasPermission(core.PermissionUsersModerate) {
 		return ErrHammerNotWeilded
 	}
 	return nil
@@ -2,21 +2,23 @@ package service
 
 import (
 	"context"
-	"log"
 
 	"github.com/kudarap/dotagiftx/core"
 	"github.com/kudarap/dotagiftx/errors"
+	"github.com/kudarap/dotagiftx/events"
+	"github.com/kudarap/dotagiftx/gokit/log"
 )
 
 // NewInventory returns new Inventory service.
-func NewInventory(rs core.InventoryStorage, ms core.MarketStorage, cs core.CatalogStorage) core.InventoryService {
-	return &InventoryService{rs, ms, cs}
+func NewInventory(rs core.InventoryStorage, ms core.MarketStorage, ev events.Publisher, lg log.Logger) core.InventoryService {
+	return &InventoryService{rs, ms, ev, lg}
 }
 
 type InventoryService struct {
 	inventoryStg core.InventoryStorage
 	marketStg    core.MarketStorage
-	catalogStg   core.CatalogStorage
+	events       events.Publisher
+	logger       log.Logger
 }
 
 func (s *InventoryService) Inventories(opts core.FindOpts) ([]core.Inventory, *core.FindMetadata, error) {
@@ -58,21 +60,11 @@ func (s *InventoryService) InventoryByMarketID(marketID string) (*core.Inventory
 	return s.inventoryStg.GetByMarketID(marketID)
 }
 
-func (s *InventoryService) Set(_ context.Context, inv *core.Inventory) error {
+func (s *InventoryService) Set(ctx context.Context, inv *core.Inventory) error {
 	if err := inv.CheckCreate(); err != nil {
 		return errors.New(core.InventoryErrRequiredFields, err)
 	}
 
-	defer func() {
-		mkt, err := s.marketStg.Index(inv.MarketID)
-		if err != nil {
-			log.Printf("could not index market %s: %s", inv.MarketID, err)
-		}
-		if _, err = s.catalogStg.Index(mkt.ItemID); err != nil {
-			log.Printf("could not index catalog %s: %s", inv.MarketID, err)
-		}
-	}()
-
 	// Update market Inventory status.
 	if err := s.marketStg.BaseUpdate(&core.Market{
 		ID:              inv.MarketID,
@@ -89,8 +81,17 @@ func (s *InventoryService) Set(_ context.Context, inv *core.Inventory) error {
 	if cur != nil {
 		inv.ID = cur.ID
 		inv.Retries = cur.Retries + 1
-		return s.inventoryStg.Update(inv)
+		if err := s.inventoryStg.Update(inv); err != nil {
+			return err
+		}
+	} else if err := s.inventoryStg.Create(inv); err != nil {
+		return err
+	}
+
+	// Record was already saved and should not fail the request.
+	if err := s.events.Publish(ctx, events.InventoryVerified{Inventory: *inv}); err != nil {
+		s.logger.Errorf("could not publish inventory %s: %s", inv.MarketID, err)
 	}
 
-	return s.inventoryStg.Create(inv)
+	return nil
 }
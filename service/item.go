@@ -14,12 +14,13 @@ import (
 )
 
 // NewItem returns new Item service.
-func NewItem(is core.ItemStorage, fm core.FileManager) core.ItemService {
-	return &itemService{is, fm}
+func NewItem(is core.ItemStorage, us core.UserStorage, fm core.FileManager) core.ItemService {
+	return &itemService{is, us, fm}
 }
 
 type itemService struct {
 	itemStg core.ItemStorage
+	userStg core.UserStorage
 	fileMgr core.FileManager
 }
 
@@ -86,12 +87,11 @@ func (s *itemService) TopHeroes() ([]string, error) {
 }
 
 func (s *itemService) Create(ctx context.Context, itm *core.Item) error {
-	// TODO check moderator/contributors
-	au := core.AuthFromContext(ctx)
-	if au == nil {
-		return core.AuthErrNoAccess
+	u, err := checkPermission(ctx, s.userStg, core.PermissionItemsWrite)
+	if err != nil {
+		return err
 	}
-	itm.Contributors = []string{au.UserID}
+	itm.Contributors = []string{u.ID}
 
 	itm.Name = strings.TrimSpace(itm.Name)
 	itm.Hero = strings.TrimSpace(itm.Hero)
@@ -120,10 +120,8 @@ func (s *itemService) Create(ctx context.Context, itm *core.Item) error {
 }
 
 func (s *itemService) Update(ctx context.Context, itm *core.Item) error {
-	// TODO check moderator/contributors
-	au := core.AuthFromContext(ctx)
-	if au == nil {
-		return core.AuthErrNoAccess
+	if _, err := checkPermission(ctx, s.userStg, core.PermissionItemsWrite); err != nil {
+		return err
 	}
 
 	if itm.ID == "" {
@@ -158,6 +156,9 @@ type yamlFile struct {
 
 func (s *itemService) Import(ctx context.Context, f io.Reader) (core.ItemImportResult, error) {
 	res := core.ItemImportResult{}
+	if _, err := checkPermission(ctx, s.userStg, core.PermissionItemsWrite); err != nil {
+		return res, err
+	}
 
 	b, err := ioutil.ReadAll(f)
 	if err != nil {
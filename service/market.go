@@ -8,17 +8,14 @@ import (
 
 	"github.com/kudarap/dotagiftx/core"
 	"github.com/kudarap/dotagiftx/errors"
+	"github.com/kudarap/dotagiftx/events"
 	"github.com/kudarap/dotagiftx/gokit/log"
 )
 
-type Dispatcher interface {
-	VerifyDelivery(marketID string)
-	VerifyInventory(userID string)
-}
-
 // NewMarket returns new Market service.
 func NewMarket(
 	ss core.MarketStorage,
+	hs core.MarketHistoryStorage,
 	us core.UserStorage,
 	is core.ItemStorage,
 	ts core.TrackStorage,
@@ -27,11 +24,14 @@ func NewMarket(
 	vd core.DeliveryService,
 	vi core.InventoryService,
 	sc core.SteamClient,
-	dp Dispatcher,
+	cr core.CurrencyService,
+	ev events.Publisher,
 	lg log.Logger,
 ) core.MarketService {
 	return &marketService{
-		ss, us,
+		ss,
+		hs,
+		us,
 		is,
 		ts,
 		cs,
@@ -39,13 +39,15 @@ func NewMarket(
 		vd,
 		vi,
 		sc,
-		dp,
+		cr,
+		ev,
 		lg,
 	}
 }
 
 type marketService struct {
 	marketStg    core.MarketStorage
+	historyStg   core.MarketHistoryStorage
 	userStg      core.UserStorage
 	itemStg      core.ItemStorage
 	trackStg     core.TrackStorage
@@ -54,7 +56,8 @@ type marketService struct {
 	deliverySvc  core.DeliveryService
 	inventorySvc core.InventoryService
 	steam        core.SteamClient
-	dispatch     Dispatcher
+	currencySvc  core.CurrencyService
+	events       events.Publisher
 	logger       log.Logger
 }
 
@@ -84,10 +87,15 @@ func (s *marketService) Markets(ctx context.Context, opts core.FindOpts) ([]core
 		return nil, nil, err
 	}
 
-	return res, &core.FindMetadata{
+	md := &core.FindMetadata{
 		ResultCount: len(res),
 		TotalCount:  tc,
-	}, nil
+	}
+	if n := len(res); n != 0 {
+		md.NextCursor = opts.NextCursor(n, res[n-1])
+	}
+
+	return res, md, nil
 }
 
 func (s *marketService) Market(ctx context.Context, id string) (*core.Market, error) {
@@ -121,6 +129,15 @@ func (s *marketService) Create(ctx context.Context, mkt *core.Market) error {
 		return err
 	}
 
+	// Normalize price to base currency for catalog market summary and sorting.
+	rates, err := s.currencySvc.Rates()
+	if err != nil {
+		return err
+	}
+	if err = mkt.NormalizePrice(rates); err != nil {
+		return err
+	}
+
 	// Check Item existence.
 	i, _ := s.itemStg.Get(mkt.ItemID)
 	if i == nil || !i.IsActive() {
@@ -150,28 +167,7 @@ func (s *marketService) Create(ctx context.Context, mkt *core.Market) error {
 		return err
 	}
 
-	//if err := s.UpdateUserRankScore(mkt.UserID); err != nil {
-	//	return err
-	//}
-	bench("market create :: UpdateUserRankScore", func() {
-		if err := s.UpdateUserRankScore(mkt.UserID); err != nil {
-			s.logger.Errorf("could not update user rank %s: %s", mkt.UserID, err)
-		}
-	})
-	bench("market create :: marketStg.Index", func() {
-		if _, err := s.marketStg.Index(mkt.ID); err != nil {
-			s.logger.Errorf("could not index market %s: %s", mkt.ItemID, err)
-		}
-	})
-	bench("market create :: catalogStg.Index", func() {
-		if _, err := s.catalogStg.Index(mkt.ItemID); err != nil {
-			s.logger.Errorf("could not index item %s: %s", mkt.ItemID, err)
-		}
-	})
-
-	if mkt.Type == core.MarketTypeAsk {
-		s.dispatch.VerifyInventory(mkt.UserID)
-	}
+	s.publish(ctx, events.MarketCreated{Market: *mkt})
 
 	return nil
 }
@@ -189,6 +185,16 @@ func (s *marketService) Update(ctx context.Context, mkt *core.Market) error {
 	if err = mkt.CheckUpdate(); err != nil {
 		return err
 	}
+	if mkt.Status != 0 {
+		if err = cur.CheckStatusTransition(mkt.Status); err != nil {
+			return err
+		}
+		// Matched entries are only resolved by confirming or declining its match.
+		if mkt.Status != cur.Status && (mkt.Status == core.MarketStatusReservePending ||
+			cur.Status == core.MarketStatusReservePending) {
+			return core.MarketErrInvalidStatusTransition
+		}
+	}
 
 	// Resolves steam profile URL input as partner steam id.
 	if strings.TrimSpace(mkt.PartnerSteamID) != "" {
@@ -212,37 +218,19 @@ func (s *marketService) Update(ctx context.Context, mkt *core.Market) error {
 	mkt.ItemID = ""
 	mkt.Price = 0
 	mkt.Currency = ""
+	mkt.ListPrice = 0
+	mkt.ListCurrency = ""
 	if err = s.marketStg.Update(mkt); err != nil {
 		return err
 	}
-
-	if mkt.Type == core.MarketTypeAsk {
-		switch mkt.Status {
-		case core.MarketStatusReserved:
-			s.dispatch.VerifyInventory(mkt.ID)
-		case core.MarketStatusSold:
-			s.dispatch.VerifyDelivery(mkt.ID)
+	if mkt.Status != cur.Status {
+		h := core.NewMarketHistory(*mkt, cur.Status, cur.UserID, core.MarketHistorySourceUpdate)
+		if err = s.historyStg.Create(h); err != nil {
+			s.logger.Errorf("could not record market history %s: %s", mkt.ID, err)
 		}
+		s.publish(ctx, events.MarketStatusChanged{Market: *mkt, PrevStatus: cur.Status, ActorID: cur.UserID})
 	}
-
-	//if err = s.UpdateUserRankScore(mkt.UserID); err != nil {
-	//	return err
-	//}
-	bench("market update :: UpdateUserRankScore", func() {
-		if err = s.UpdateUserRankScore(mkt.UserID); err != nil {
-			s.logger.Errorf("could not update user rank %s: %s", mkt.UserID, err)
-		}
-	})
-	bench("market update :: marketStg.Index", func() {
-		if _, err = s.marketStg.Index(mkt.ID); err != nil {
-			s.logger.Errorf("could not index market %s: %s", mkt.ItemID, err)
-		}
-	})
-	bench("market update :: catalogStg.Index", func() {
-		if _, err = s.catalogStg.Index(mkt.ItemID); err != nil {
-			s.logger.Errorf("could not index item %s: %s", mkt.ItemID, err)
-		}
-	})
+	s.publish(ctx, events.MarketUpdated{Market: *mkt})
 
 	return nil
 }
@@ -265,13 +253,27 @@ func (s *marketService) UpdateUserRankScore(userID string) error {
 
 // AutoCompleteBid detects if there's matching reservation on buy order and automatically
 // resolve it by setting complete-bid status.
-func (s *marketService) AutoCompleteBid(_ context.Context, ask core.Market, partnerSteamID string) error {
+func (s *marketService) AutoCompleteBid(ctx context.Context, ask core.Market, partnerSteamID string) error {
+	return bidCompleter{s.marketStg, s.historyStg, s.userStg, s.events, s.logger}.complete(ctx, ask, partnerSteamID)
+}
+
+// bidCompleter completes live buy order of the buyer when a listing of the
+// same item gets reserved for them.
+type bidCompleter struct {
+	marketStg  core.MarketStorage
+	historyStg core.MarketHistoryStorage
+	userStg    core.UserStorage
+	events     events.Publisher
+	logger     log.Logger
+}
+
+func (c bidCompleter) complete(ctx context.Context, ask core.Market, partnerSteamID string) error {
 	if ask.ItemID == "" || ask.UserID == "" || partnerSteamID == "" {
 		return fmt.Errorf("ask market item id, user id, and partner steam id are required")
 	}
 
 	// Use buyer ID to get the matching market.
-	buyer, err := s.userStg.Get(partnerSteamID)
+	buyer, err := c.userStg.Get(partnerSteamID)
 	if err != nil {
 		return nil
 	}
@@ -285,20 +287,88 @@ func (s *marketService) AutoCompleteBid(_ context.Context, ask core.Market, part
 			UserID: buyer.ID,
 		},
 	}
-	bids, _ := s.marketStg.Find(fo)
-	if bids == nil || len(bids) == 0 {
+	bids, _ := c.marketStg.Find(fo)
+	if len(bids) == 0 {
 		return nil
 	}
 
 	// Set complete status and seller steam id on matching bid.
-	seller, err := s.userStg.Get(ask.UserID)
+	seller, err := c.userStg.Get(ask.UserID)
 	if err != nil {
 		return err
 	}
 	b := bids[0]
+	if err = b.CheckStatusTransition(core.MarketStatusBidCompleted); err != nil {
+		return err
+	}
+	prev := b.Status
 	b.Status = core.MarketStatusBidCompleted
 	b.PartnerSteamID = seller.SteamID
-	return s.marketStg.Update(&b)
+	if err = c.marketStg.Update(&b); err != nil {
+		return err
+	}
+
+	// Seller completes the bid on behalf of the buyer.
+	var actorID string
+	if au := core.AuthFromContext(ctx); au != nil {
+		actorID = au.UserID
+	}
+	h := core.NewMarketHistory(b, prev, actorID, core.MarketHistorySourceAutoBid)
+	if err = c.historyStg.Create(h); err != nil {
+		c.logger.Errorf("could not record market history %s: %s", b.ID, err)
+	}
+	for _, e := range []events.Event{
+		events.MarketStatusChanged{Market: b, PrevStatus: prev, ActorID: actorID},
+		events.MarketUpdated{Market: b},
+	} {
+		if err = c.events.Publish(ctx, e); err != nil {
+			c.logger.Errorf("could not publish %s event: %s", e.EventType(), err)
+		}
+	}
+
+	return nil
+}
+
+func (s *marketService) History(ctx context.Context, id string) ([]core.MarketHistory, error) {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return nil, core.AuthErrNoAccess
+	}
+
+	res, err := s.historyStg.Find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Owner is taken from history entries so deleted markets history stays
+	// accessible, entries recorded without owner falls back to the market.
+	var ownerID string
+	for _, h := range res {
+		if h.UserID != "" {
+			ownerID = h.UserID
+			break
+		}
+	}
+	if ownerID == "" {
+		mkt, err := s.marketStg.Get(id)
+		if err != nil {
+			return nil, err
+		}
+		ownerID = mkt.UserID
+	}
+
+	// Market history is only accessible by its owner and staff users.
+	if ownerID != au.UserID {
+		u, err := s.userStg.Get(au.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if !u.HasPermission(core.PermissionMarketsHistory) {
+			return nil, core.MarketErrNotFound
+		}
+	}
+
+	return res, nil
 }
 
 func (s *marketService) checkFlaggedUser(userID string) error {
@@ -388,6 +458,9 @@ func (s *marketService) checkBidType(bid *core.Market) error {
 		return err
 	}
 	for _, m := range res {
+		if err = m.CheckStatusTransition(core.MarketStatusRemoved); err != nil {
+			return err
+		}
 		m.Status = core.MarketStatusRemoved
 		if err = s.marketStg.Update(&m); err != nil {
 			return err
@@ -405,21 +478,22 @@ func (s *marketService) checkBidType(bid *core.Market) error {
 // Update 2021/03/08: It turns out some users are picky on which user they
 // want to get the item from, which is very reasonable, and will disable this restriction for now.
 func (s *marketService) restrictMatchingPriceValue(mkt *core.Market) error {
+	c, err := s.catalogStg.Index(mkt.ItemID)
+	if err != nil {
+		return err
+	}
+	price, ok := mkt.BestCounterPartPrice(*c)
+	if !ok {
+		return nil
+	}
+
 	switch mkt.Type {
 	case core.MarketTypeAsk:
-		bid, err := s.catalogStg.Index(mkt.ItemID)
-		if err != nil {
-			return err
-		}
-		if bid.Quantity != 0 && bid.HighestBid > mkt.Price {
+		if price > mkt.Price {
 			return core.MarketErrInvalidAskPrice
 		}
 	case core.MarketTypeBid:
-		ask, err := s.catalogStg.Index(mkt.ItemID)
-		if err != nil {
-			return err
-		}
-		if ask.Quantity != 0 && ask.LowestAsk < mkt.Price {
+		if price < mkt.Price {
 			return core.MarketErrInvalidBidPrice
 		}
 	}
@@ -457,8 +531,10 @@ func (s *marketService) userMarket(userID, id string) (*core.Market, error) {
 	return cur, nil
 }
 
-func bench(name string, fn func()) {
-	s := time.Now()
-	fn()
-	fmt.Println("BENCH service/market", name, time.Now().Sub(s))
+// publish sends market event and only logs failures since the market
+// changes are already persisted at this point.
+func (s *marketService) publish(ctx context.Context, e events.Event) {
+	if err := s.events.Publish(ctx, e); err != nil {
+		s.logger.Errorf("could not publish %s event: %s", e.EventType(), err)
+	}
 }
@@ -0,0 +1,388 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/events"
+	"github.com/kudarap/dotagiftx/gokit/log"
+)
+
+// NewMarketMatch returns new market matching engine service.
+func NewMarketMatch(
+	xs core.MarketMatchStorage,
+	ms core.MarketStorage,
+	hs core.MarketHistoryStorage,
+	cs core.CatalogStorage,
+	us core.UserStorage,
+	ns core.NotificationService,
+	ev events.Publisher,
+	lg log.Logger,
+) core.MarketMatchService {
+	return &marketMatchService{xs, ms, hs, cs, us, ns, ev, lg}
+}
+
+type marketMatchService struct {
+	matchStg   core.MarketMatchStorage
+	marketStg  core.MarketStorage
+	historyStg core.MarketHistoryStorage
+	catalogStg core.CatalogStorage
+	userStg    core.UserStorage
+	notifySvc  core.NotificationService
+	events     events.Publisher
+	logger     log.Logger
+}
+
+func (s *marketMatchService) Matches(ctx context.Context) ([]core.MarketMatch, error) {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return nil, core.AuthErrNoAccess
+	}
+
+	var res []core.MarketMatch
+	for _, f := range []core.MarketMatch{{SellerID: au.UserID}, {BuyerID: au.UserID}} {
+		mm, err := s.matchStg.Find(core.FindOpts{Filter: f})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, mm...)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].CreatedAt != nil && res[j].CreatedAt != nil && res[i].CreatedAt.After(*res[j].CreatedAt)
+	})
+
+	return res, nil
+}
+
+func (s *marketMatchService) Confirm(ctx context.Context, id string) (*core.MarketMatch, error) {
+	mm, actorID, err := s.partyMatch(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	mm.Confirm(actorID)
+	if !mm.IsConfirmed() {
+		if err = s.matchStg.Update(mm); err != nil {
+			return nil, err
+		}
+		s.notify(ctx, mm.CounterParty(actorID), mm.ItemID, "confirmed, waiting for your confirmation")
+		return mm, nil
+	}
+
+	if err = s.reserve(ctx, mm, actorID); err != nil {
+		return nil, err
+	}
+	mm.Status = core.MarketMatchStatusConfirmed
+	if err = s.matchStg.Update(mm); err != nil {
+		return nil, err
+	}
+	s.notify(ctx, mm.SellerID, mm.ItemID, "confirmed by both sides, your listing is now reserved")
+	s.notify(ctx, mm.BuyerID, mm.ItemID, "confirmed by both sides, your buy order is now completed")
+
+	return mm, nil
+}
+
+func (s *marketMatchService) Decline(ctx context.Context, id string) (*core.MarketMatch, error) {
+	mm, actorID, err := s.partyMatch(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.release(ctx, mm, actorID, core.MarketMatchStatusDeclined); err != nil {
+		return nil, err
+	}
+	s.notify(ctx, mm.CounterParty(actorID), mm.ItemID, "declined, your entry is back to live")
+
+	return mm, nil
+}
+
+func (s *marketMatchService) Match(ctx context.Context, m core.Market) (*core.MarketMatch, error) {
+	// Event payload might be stale at this point.
+	cur, err := s.marketStg.Get(m.ID)
+	if err != nil {
+		return nil, err
+	}
+	if cur.Status != core.MarketStatusLive {
+		return nil, nil
+	}
+	// Listings are only matched once its inventory is verified.
+	if cur.Type == core.MarketTypeAsk && cur.InventoryStatus != core.InventoryStatusVerified {
+		return nil, nil
+	}
+
+	// Use catalog market summary to skip looking up entries that will not
+	// cross the market price.
+	c, err := s.catalogStg.Get(cur.ItemID)
+	if err == core.CatalogErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if price, ok := cur.BestCounterPartPrice(*c); !ok || !cur.Crosses(price) {
+		return nil, nil
+	}
+
+	cp, err := s.bestCounterPart(*cur)
+	if err != nil || cp == nil {
+		return nil, err
+	}
+
+	ask, bid := cur, cp
+	if cur.Type == core.MarketTypeBid {
+		ask, bid = cp, cur
+	}
+	// Entries are only paired when both are still live, entries that were
+	// already set are put back to live on any later failure.
+	mm := core.NewMarketMatch(*ask, *bid, time.Now())
+	if err = s.setStatus(ctx, ask, core.MarketStatusReservePending, ""); err != nil {
+		return nil, ignoreStatusChanged(err)
+	}
+	if err = s.setStatus(ctx, bid, core.MarketStatusReservePending, ""); err != nil {
+		s.rollback(ctx, core.MarketStatusLive, ask)
+		return nil, ignoreStatusChanged(err)
+	}
+	if err = s.matchStg.Create(mm); err != nil {
+		s.rollback(ctx, core.MarketStatusLive, ask, bid)
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("found for %.2f %s, please confirm", mm.Price, ask.Currency)
+	s.notify(ctx, mm.SellerID, mm.ItemID, msg)
+	s.notify(ctx, mm.BuyerID, mm.ItemID, msg)
+
+	return mm, nil
+}
+
+func (s *marketMatchService) Expire(ctx context.Context) error {
+	res, err := s.matchStg.Find(core.FindOpts{
+		Filter: core.MarketMatch{Status: core.MarketMatchStatusPending},
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, mm := range res {
+		if !mm.IsExpired(now) {
+			continue
+		}
+
+		mm := mm
+		if err = s.release(ctx, &mm, "", core.MarketMatchStatusExpired); err != nil {
+			return err
+		}
+		s.notify(ctx, mm.SellerID, mm.ItemID, "expired, your entry is back to live")
+		s.notify(ctx, mm.BuyerID, mm.ItemID, "expired, your entry is back to live")
+	}
+
+	return nil
+}
+
+// bestCounterPart returns live counter-part entry of other users that crosses
+// the market price, best price first and older entry on same price.
+func (s *marketMatchService) bestCounterPart(m core.Market) (*core.Market, error) {
+	f := core.Market{
+		ItemID: m.ItemID,
+		Type:   m.CounterPartType(),
+		Status: core.MarketStatusLive,
+	}
+	if f.Type == core.MarketTypeAsk {
+		f.InventoryStatus = core.InventoryStatusVerified
+	}
+	res, err := s.marketStg.Find(core.FindOpts{Filter: f})
+	if err != nil {
+		return nil, err
+	}
+
+	var best *core.Market
+	for i, c := range res {
+		if c.UserID == m.UserID || !m.Crosses(c.Price) {
+			continue
+		}
+		if best == nil || isBetterCounterPart(c, *best) {
+			best = &res[i]
+		}
+	}
+
+	return best, nil
+}
+
+// reserve sets matched listing to reserved for the buyer and completes the
+// matched buy order, listing is put back to pending when buy order could
+// not be completed.
+func (s *marketMatchService) reserve(ctx context.Context, mm *core.MarketMatch, actorID string) error {
+	ask, err := s.marketStg.Get(mm.AskID)
+	if err != nil {
+		return err
+	}
+	bid, err := s.marketStg.Get(mm.BidID)
+	if err != nil {
+		return err
+	}
+	if ask.Status != core.MarketStatusReservePending || bid.Status != core.MarketStatusReservePending {
+		return core.MarketMatchErrMarketChanged
+	}
+
+	buyer, err := s.userStg.Get(mm.BuyerID)
+	if err != nil {
+		return err
+	}
+	seller, err := s.userStg.Get(mm.SellerID)
+	if err != nil {
+		return err
+	}
+
+	ask.PartnerSteamID = buyer.SteamID
+	if err = s.setStatus(ctx, ask, core.MarketStatusReserved, actorID); err != nil {
+		return matchStatusChanged(err)
+	}
+	bid.PartnerSteamID = seller.SteamID
+	if err = s.setStatus(ctx, bid, core.MarketStatusBidCompleted, actorID); err != nil {
+		s.rollback(ctx, core.MarketStatusReservePending, ask)
+		return matchStatusChanged(err)
+	}
+
+	return nil
+}
+
+// release puts matched entries that are still pending back to live.
+func (s *marketMatchService) release(ctx context.Context, mm *core.MarketMatch, actorID string, status core.MarketMatchStatus) error {
+	for _, id := range []string{mm.AskID, mm.BidID} {
+		m, err := s.marketStg.Get(id)
+		if err != nil {
+			return err
+		}
+		if m.Status != core.MarketStatusReservePending {
+			continue
+		}
+		if err = s.setStatus(ctx, m, core.MarketStatusLive, actorID); err != nil {
+			return err
+		}
+	}
+
+	mm.Status = status
+	return s.matchStg.Update(mm)
+}
+
+// setStatus changes market status only when its stored status is still the
+// same as the given market.
+func (s *marketMatchService) setStatus(ctx context.Context, m *core.Market, to core.MarketStatus, actorID string) error {
+	if err := m.CheckStatusTransition(to); err != nil {
+		return err
+	}
+
+	prev := m.Status
+	in := &core.Market{ID: m.ID, Status: to, PartnerSteamID: m.PartnerSteamID}
+	if err := s.marketStg.UpdateIfStatus(in, prev); err != nil {
+		return err
+	}
+	m.Status = to
+
+	h := core.NewMarketHistory(*m, prev, actorID, core.MarketHistorySourceMatch)
+	if err := s.historyStg.Create(h); err != nil {
+		s.logger.Errorf("could not record market history %s: %s", m.ID, err)
+	}
+	s.publish(ctx, events.MarketStatusChanged{Market: *m, PrevStatus: prev, ActorID: actorID})
+	s.publish(ctx, events.MarketUpdated{Market: *m})
+
+	return nil
+}
+
+// rollback puts back markets that were set by a failed match to the status,
+// reverting is not a user transition so transition rules are not checked.
+func (s *marketMatchService) rollback(ctx context.Context, to core.MarketStatus, markets ...*core.Market) {
+	for _, m := range markets {
+		prev := m.Status
+		if err := s.marketStg.UpdateIfStatus(&core.Market{ID: m.ID, Status: to}, prev); err != nil {
+			s.logger.Errorf("could not rollback market %s to %s: %s", m.ID, to, err)
+			continue
+		}
+		m.Status = to
+		h := core.NewMarketHistory(*m, prev, "", core.MarketHistorySourceMatch)
+		if err := s.historyStg.Create(h); err != nil {
+			s.logger.Errorf("could not record market history %s: %s", m.ID, err)
+		}
+		s.publish(ctx, events.MarketStatusChanged{Market: *m, PrevStatus: prev})
+		s.publish(ctx, events.MarketUpdated{Market: *m})
+	}
+}
+
+// ignoreStatusChanged skips matching entry that was changed by another request.
+func ignoreStatusChanged(err error) error {
+	if err == core.MarketErrStatusChanged {
+		return nil
+	}
+	return err
+}
+
+// matchStatusChanged reports entry that was changed by another request as
+// changed match entry.
+func matchStatusChanged(err error) error {
+	if err == core.MarketErrStatusChanged {
+		return core.MarketMatchErrMarketChanged
+	}
+	return err
+}
+
+// partyMatch returns pending match of the authenticated user.
+func (s *marketMatchService) partyMatch(ctx context.Context, id string) (*core.MarketMatch, string, error) {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return nil, "", core.AuthErrNoAccess
+	}
+	if id == "" {
+		return nil, "", core.MarketMatchErrRequiredID
+	}
+
+	mm, err := s.matchStg.Get(id)
+	if err != nil {
+		return nil, "", err
+	}
+	if !mm.IsParty(au.UserID) {
+		return nil, "", core.MarketMatchErrNotFound
+	}
+	if mm.Status != core.MarketMatchStatusPending {
+		return nil, "", core.MarketMatchErrNotPending
+	}
+
+	return mm, au.UserID, nil
+}
+
+func (s *marketMatchService) notify(ctx context.Context, userID, itemID, status string) {
+	name := itemID
+	if c, err := s.catalogStg.Get(itemID); err == nil {
+		name = c.Name
+	}
+
+	err := s.notifySvc.Notify(ctx, userID, core.Notification{
+		Event:   core.NotificationEventMarketMatch,
+		Subject: fmt.Sprintf("Market match for %s", name),
+		Message: fmt.Sprintf("Market match for %s %s.", name, status),
+	})
+	if err != nil {
+		s.logger.Errorf("could not notify market match to %s: %s", userID, err)
+	}
+}
+
+func (s *marketMatchService) publish(ctx context.Context, e events.Event) {
+	if err := s.events.Publish(ctx, e); err != nil {
+		s.logger.Errorf("could not publish %s event: %s", e.EventType(), err)
+	}
+}
+
+// isBetterCounterPart returns true when entry a has better price than b for
+// the opposite side, lower asks and higher bids, and older entry on same price.
+func isBetterCounterPart(a, b core.Market) bool {
+	if a.Price != b.Price {
+		if a.Type == core.MarketTypeBid {
+			return a.Price > b.Price
+		}
+		return a.Price < b.Price
+	}
+
+	return a.CreatedAt != nil && b.CreatedAt != nil && a.CreatedAt.Before(*b.CreatedAt)
+}
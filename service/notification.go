@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+	"github.com/kudarap/dotagiftx/gokit/log"
+)
+
+// NewNotification returns new Notification service.
+func NewNotification(ns core.NotificationStorage, channels []core.NotificationChannel, lg log.Logger) core.NotificationService {
+	return &notificationService{ns, channels, lg}
+}
+
+type notificationService struct {
+	notificationStg core.NotificationStorage
+	channels        []core.NotificationChannel
+	logger          log.Logger
+}
+
+func (s *notificationService) Setting(ctx context.Context) (*core.NotificationSetting, error) {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return nil, core.AuthErrNoAccess
+	}
+
+	ns, err := s.notificationStg.GetByUserID(au.UserID)
+	if err == core.NotificationErrNotFound {
+		return &core.NotificationSetting{UserID: au.UserID, Events: []string{}}, nil
+	}
+
+	return ns, err
+}
+
+func (s *notificationService) UpdateSetting(ctx context.Context, ns *core.NotificationSetting) error {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return core.AuthErrNoAccess
+	}
+	ns.UserID = au.UserID
+
+	ns.Email = strings.TrimSpace(ns.Email)
+	ns.WebhookURL = strings.TrimSpace(ns.WebhookURL)
+	if err := ns.CheckUpdate(); err != nil {
+		return errors.New(core.NotificationErrRequiredFields, err)
+	}
+
+	cur, err := s.notificationStg.GetByUserID(au.UserID)
+	if err != nil && err != core.NotificationErrNotFound {
+		return err
+	}
+
+	// Email address ownership is confirmed again when it changes, a new code
+	// is sent on every save until it gets confirmed.
+	changed := cur == nil || cur.Email != ns.Email
+	ns.EmailConfirmed = ns.Email != "" && !changed && cur.EmailConfirmed
+	sendCode := ns.Email != "" && !ns.EmailConfirmed
+	ns.EmailCode = ""
+	if sendCode {
+		if ns.EmailCode, err = generateEmailCode(); err != nil {
+			return err
+		}
+	}
+
+	confirmed := ns.EmailConfirmed
+	if cur == nil {
+		err = s.notificationStg.Create(ns)
+	} else {
+		ns.ID = cur.ID
+		err = s.notificationStg.Update(ns)
+	}
+	if err != nil {
+		return err
+	}
+	// Update merges current values to zero fields.
+	ns.EmailConfirmed = confirmed
+
+	if sendCode {
+		s.sendEmailCode(ctx, ns)
+	}
+
+	return nil
+}
+
+func (s *notificationService) ConfirmEmail(ctx context.Context, code string) (*core.NotificationSetting, error) {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return nil, core.AuthErrNoAccess
+	}
+
+	ns, err := s.notificationStg.GetByUserID(au.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if ns.EmailConfirmed {
+		return ns, nil
+	}
+
+	code = strings.TrimSpace(code)
+	if code == "" || ns.EmailCode == "" || subtle.ConstantTimeCompare([]byte(code), []byte(ns.EmailCode)) != 1 {
+		return nil, core.NotificationErrInvalidEmailCode
+	}
+
+	ns.EmailConfirmed = true
+	ns.EmailCode = ""
+	if err = s.notificationStg.Update(ns); err != nil {
+		return nil, err
+	}
+
+	return ns, nil
+}
+
+func (s *notificationService) Notify(ctx context.Context, userID string, n core.Notification) error {
+	ns, err := s.notificationStg.GetByUserID(userID)
+	if err == core.NotificationErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !ns.HasEvent(n.Event) {
+		return nil
+	}
+	if !ns.EmailConfirmed {
+		ns.Email = ""
+	}
+
+	// Channel failures should not prevent other channels from sending.
+	for _, ch := range s.channels {
+		if err = ch.Send(ctx, *ns, n); err != nil {
+			s.logger.Errorf("could not send %s notification to %s via %s: %s", n.Event, userID, ch.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+// sendEmailCode sends confirmation code to email address only, failure is
+// logged and user can request a new code by saving the setting again.
+func (s *notificationService) sendEmailCode(ctx context.Context, ns *core.NotificationSetting) {
+	n := core.Notification{
+		Event:   core.NotificationEventEmailConfirm,
+		Subject: "Confirm your email address",
+		Message: fmt.Sprintf("Use this code to confirm receiving DotagiftX notifications on this address:\n\n%s", ns.EmailCode),
+	}
+	dst := core.NotificationSetting{UserID: ns.UserID, Email: ns.Email}
+	for _, ch := range s.channels {
+		if err := ch.Send(ctx, dst, n); err != nil {
+			s.logger.Errorf("could not send %s notification to %s via %s: %s", n.Event, ns.UserID, ch.Name(), err)
+		}
+	}
+}
+
+func generateEmailCode() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
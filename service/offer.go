@@ -0,0 +1,304 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+	"github.com/kudarap/dotagiftx/events"
+	"github.com/kudarap/dotagiftx/gokit/log"
+)
+
+// NewOffer returns new listing offer service.
+func NewOffer(
+	os core.OfferStorage,
+	ms core.MarketStorage,
+	hs core.MarketHistoryStorage,
+	cs core.CatalogStorage,
+	us core.UserStorage,
+	ns core.NotificationService,
+	ev events.Publisher,
+	lg log.Logger,
+) core.OfferService {
+	return &offerService{os, ms, hs, cs, us, ns, ev, lg}
+}
+
+type offerService struct {
+	offerStg   core.OfferStorage
+	marketStg  core.MarketStorage
+	historyStg core.MarketHistoryStorage
+	catalogStg core.CatalogStorage
+	userStg    core.UserStorage
+	notifySvc  core.NotificationService
+	events     events.Publisher
+	logger     log.Logger
+}
+
+func (s *offerService) Offers(ctx context.Context) ([]core.Offer, error) {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return nil, core.AuthErrNoAccess
+	}
+
+	var res []core.Offer
+	for _, f := range []core.Offer{{SellerID: au.UserID}, {BuyerID: au.UserID}} {
+		oo, err := s.offerStg.Find(core.FindOpts{Filter: f})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, oo...)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].CreatedAt != nil && res[j].CreatedAt != nil && res[i].CreatedAt.After(*res[j].CreatedAt)
+	})
+
+	return res, nil
+}
+
+func (s *offerService) Create(ctx context.Context, o *core.Offer) error {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return core.AuthErrNoAccess
+	}
+	if err := o.CheckCreate(); err != nil {
+		return errors.New(core.OfferErrRequiredFields, err)
+	}
+
+	// Prevents flagged accounts from making offers.
+	u, err := s.userStg.Get(au.UserID)
+	if err != nil {
+		return err
+	}
+	if err = u.CheckStatus(); err != nil {
+		return err
+	}
+
+	ask, err := s.marketStg.Get(o.MarketID)
+	if err != nil {
+		return err
+	}
+	if ask.Type != core.MarketTypeAsk || ask.UserID == au.UserID {
+		return core.MarketErrNotFound
+	}
+	if ask.Status != core.MarketStatusLive {
+		return core.OfferErrMarketNotAvailable
+	}
+
+	pending, err := s.offerStg.Find(core.FindOpts{Filter: core.Offer{
+		MarketID: ask.ID,
+		BuyerID:  au.UserID,
+		Status:   core.OfferStatusPending,
+	}})
+	if err != nil {
+		return err
+	}
+	if len(pending) != 0 {
+		return core.OfferErrDuplicate
+	}
+
+	if err = o.SetDefaults(*ask, au.UserID, time.Now()); err != nil {
+		return err
+	}
+	if err = s.offerStg.Create(o); err != nil {
+		return err
+	}
+
+	s.notify(ctx, o.SellerID, *o, fmt.Sprintf("received an offer of %.2f %s", o.Price, ask.Currency))
+	return nil
+}
+
+func (s *offerService) Accept(ctx context.Context, id string) (*core.Offer, error) {
+	o, actorID, err := s.respondOffer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ask, err := s.marketStg.Get(o.MarketID)
+	if err != nil {
+		return nil, err
+	}
+	if ask.Status != core.MarketStatusLive {
+		return nil, core.OfferErrMarketNotAvailable
+	}
+	if err = s.reserve(ctx, o, ask, actorID); err != nil {
+		return nil, err
+	}
+
+	o.Status = core.OfferStatusAccepted
+	if err = s.offerStg.Update(o); err != nil {
+		return nil, err
+	}
+	msg := fmt.Sprintf("was accepted at %.2f %s, the listing is now reserved", o.Price, ask.Currency)
+	s.notify(ctx, o.CounterParty(actorID), *o, msg)
+
+	// Listing is no longer available for the other buyers.
+	s.rejectOthers(ctx, *o)
+
+	return o, nil
+}
+
+func (s *offerService) Reject(ctx context.Context, id string) (*core.Offer, error) {
+	o, actorID, err := s.respondOffer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	o.Status = core.OfferStatusRejected
+	if err = s.offerStg.Update(o); err != nil {
+		return nil, err
+	}
+	s.notify(ctx, o.CounterParty(actorID), *o, "was rejected")
+
+	return o, nil
+}
+
+func (s *offerService) Counter(ctx context.Context, id string, price float64) (*core.Offer, error) {
+	o, actorID, err := s.respondOffer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = o.Counter(actorID, price, time.Now()); err != nil {
+		return nil, err
+	}
+	if err = s.offerStg.Update(o); err != nil {
+		return nil, err
+	}
+	s.notify(ctx, o.CounterParty(actorID), *o, fmt.Sprintf("was countered with %.2f", o.Price))
+
+	return o, nil
+}
+
+func (s *offerService) Expire(ctx context.Context) error {
+	res, err := s.offerStg.Find(core.FindOpts{
+		Filter: core.Offer{Status: core.OfferStatusPending},
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, o := range res {
+		if !o.IsExpired(now) {
+			continue
+		}
+
+		o := o
+		o.Status = core.OfferStatusExpired
+		if err = s.offerStg.Update(&o); err != nil {
+			return err
+		}
+		s.notify(ctx, o.SellerID, o, "expired")
+		s.notify(ctx, o.BuyerID, o, "expired")
+	}
+
+	return nil
+}
+
+// reserve sets the listing to reserved for the buyer on agreed offer price
+// and completes the buyer's matching buy order if there's any.
+func (s *offerService) reserve(ctx context.Context, o *core.Offer, ask *core.Market, actorID string) error {
+	if err := ask.CheckStatusTransition(core.MarketStatusReserved); err != nil {
+		return err
+	}
+
+	buyer, err := s.userStg.Get(o.BuyerID)
+	if err != nil {
+		return err
+	}
+
+	prev := ask.Status
+	ask.Status = core.MarketStatusReserved
+	ask.Price = o.Price
+	ask.PartnerSteamID = buyer.SteamID
+	// Conditional write makes sure only one of concurrent accepts on the same
+	// listing can reserve it.
+	if err = s.marketStg.UpdateIfStatus(&core.Market{
+		ID:             ask.ID,
+		Status:         ask.Status,
+		Price:          ask.Price,
+		PartnerSteamID: ask.PartnerSteamID,
+	}, prev); err != nil {
+		if err == core.MarketErrStatusChanged {
+			return core.OfferErrMarketNotAvailable
+		}
+		return err
+	}
+
+	h := core.NewMarketHistory(*ask, prev, actorID, core.MarketHistorySourceOffer)
+	if err = s.historyStg.Create(h); err != nil {
+		s.logger.Errorf("could not record market history %s: %s", ask.ID, err)
+	}
+	s.publish(ctx, events.MarketStatusChanged{Market: *ask, PrevStatus: prev, ActorID: actorID})
+	s.publish(ctx, events.MarketUpdated{Market: *ask})
+
+	return bidCompleter{s.marketStg, s.historyStg, s.userStg, s.events, s.logger}.complete(ctx, *ask, buyer.SteamID)
+}
+
+// rejectOthers rejects pending offers of other buyers on the same listing.
+func (s *offerService) rejectOthers(ctx context.Context, accepted core.Offer) {
+	res, err := s.offerStg.Find(core.FindOpts{Filter: core.Offer{
+		MarketID: accepted.MarketID,
+		Status:   core.OfferStatusPending,
+	}})
+	if err != nil {
+		s.logger.Errorf("could not find pending offers on %s: %s", accepted.MarketID, err)
+		return
+	}
+
+	for _, o := range res {
+		o := o
+		o.Status = core.OfferStatusRejected
+		if err = s.offerStg.Update(&o); err != nil {
+			s.logger.Errorf("could not reject offer %s: %s", o.ID, err)
+			continue
+		}
+		s.notify(ctx, o.BuyerID, o, "was rejected, the listing was reserved to another buyer")
+	}
+}
+
+// respondOffer returns pending offer that waits for the authenticated user response.
+func (s *offerService) respondOffer(ctx context.Context, id string) (*core.Offer, string, error) {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return nil, "", core.AuthErrNoAccess
+	}
+	if id == "" {
+		return nil, "", core.OfferErrRequiredID
+	}
+
+	o, err := s.offerStg.Get(id)
+	if err != nil {
+		return nil, "", err
+	}
+	if err = o.CheckRespond(au.UserID, time.Now()); err != nil {
+		return nil, "", err
+	}
+
+	return o, au.UserID, nil
+}
+
+func (s *offerService) notify(ctx context.Context, userID string, o core.Offer, status string) {
+	name := o.ItemID
+	if c, err := s.catalogStg.Get(o.ItemID); err == nil {
+		name = c.Name
+	}
+
+	err := s.notifySvc.Notify(ctx, userID, core.Notification{
+		Event:   core.NotificationEventOffer,
+		Subject: fmt.Sprintf("Offer for %s", name),
+		Message: fmt.Sprintf("Offer for %s %s.", name, status),
+	})
+	if err != nil {
+		s.logger.Errorf("could not notify offer to %s: %s", userID, err)
+	}
+}
+
+func (s *offerService) publish(ctx context.Context, e events.Event) {
+	if err := s.events.Publish(ctx, e); err != nil {
+		s.logger.Errorf("could not publish %s event: %s", e.EventType(), err)
+	}
+}
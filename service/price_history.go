@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/gokit/log"
+)
+
+// NewPriceHistory returns new item price history service.
+func NewPriceHistory(
+	ps core.PriceHistoryStorage,
+	ms core.MarketStorage,
+	cs core.CatalogStorage,
+	is core.ItemStorage,
+	lg log.Logger,
+) core.PriceHistoryService {
+	return &priceHistoryService{ps, ms, cs, is, lg}
+}
+
+type priceHistoryService struct {
+	priceStg   core.PriceHistoryStorage
+	marketStg  core.MarketStorage
+	catalogStg core.CatalogStorage
+	itemStg    core.ItemStorage
+	logger     log.Logger
+}
+
+func (s *priceHistoryService) PriceHistory(ctx context.Context, slug, interval string, limit int) (*core.PriceHistory, error) {
+	if interval == "" {
+		interval = core.PriceIntervalDay
+	}
+	if _, ok := core.PriceIntervals[interval]; !ok {
+		return nil, core.PriceHistoryErrInvalidInterval
+	}
+	if limit <= 0 {
+		limit = core.PriceHistoryDefaultLimit
+	}
+	if limit > core.PriceHistoryMaxLimit {
+		limit = core.PriceHistoryMaxLimit
+	}
+
+	// Items that were never listed have no catalog yet.
+	var itemID string
+	c, err := s.catalogStg.Get(slug)
+	if err == core.CatalogErrNotFound {
+		i, err := s.itemStg.GetBySlug(slug)
+		if err != nil {
+			return nil, err
+		}
+		itemID = i.ID
+	} else if err != nil {
+		return nil, err
+	} else {
+		itemID = c.ID
+	}
+
+	ph := &core.PriceHistory{ItemID: itemID, Interval: interval}
+	for side, out := range map[string]*[]core.PriceCandle{
+		core.PriceSideAsk:  &ph.Asks,
+		core.PriceSideBid:  &ph.Bids,
+		core.PriceSideSale: &ph.Sales,
+	} {
+		res, err := s.priceStg.Find(core.FindOpts{
+			Filter: core.PriceCandle{ItemID: itemID, Interval: interval, Side: side},
+			Sort:   "time",
+			Desc:   true,
+			Limit:  limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Recent candles are fetched first and served in chronological order.
+		sort.Slice(res, func(i, j int) bool {
+			return res[i].Time.Before(*res[j].Time)
+		})
+		if res == nil {
+			res = []core.PriceCandle{}
+		}
+		*out = res
+	}
+
+	return ph, nil
+}
+
+func (s *priceHistoryService) Record(ctx context.Context, p core.PricePoint) error {
+	if p.ItemID == "" || p.Price <= 0 {
+		return nil
+	}
+
+	// Concurrent points on the same candle are added atomically by storage.
+	for interval := range core.PriceIntervals {
+		c := core.NewPriceCandle(p.ItemID, interval, p.Side, p.Time)
+		if err := s.priceStg.AddPrice(&c, p.Price); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *priceHistoryService) Backfill(ctx context.Context) error {
+	res, err := s.marketStg.Find(core.FindOpts{})
+	if err != nil {
+		return err
+	}
+
+	var points []core.PricePoint
+	for _, m := range res {
+		points = append(points, m.PricePoints()...)
+	}
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].Time.Before(points[j].Time)
+	})
+
+	// Rebuilds candles in memory and replaces the stored ones.
+	candles := map[string]*core.PriceCandle{}
+	var keys []string
+	for _, p := range points {
+		if p.ItemID == "" || p.Price <= 0 {
+			continue
+		}
+		for interval := range core.PriceIntervals {
+			c := core.NewPriceCandle(p.ItemID, interval, p.Side, p.Time)
+			if _, ok := candles[c.Key]; !ok {
+				candles[c.Key] = &c
+				keys = append(keys, c.Key)
+			}
+			candles[c.Key].Add(p.Price)
+		}
+	}
+
+	for _, k := range keys {
+		c := candles[k]
+		cur, err := s.candle(k)
+		if err != nil {
+			return err
+		}
+		if cur != nil {
+			c.ID = cur.ID
+		}
+		if err = s.save(c); err != nil {
+			return err
+		}
+	}
+
+	s.logger.Printf("price history backfilled %d candles from %d markets", len(keys), len(res))
+	return nil
+}
+
+// candle returns stored candle by key and nil when not found.
+func (s *priceHistoryService) candle(key string) (*core.PriceCandle, error) {
+	res, err := s.priceStg.Find(core.FindOpts{Filter: core.PriceCandle{Key: key}, Limit: 1})
+	if err != nil || len(res) == 0 {
+		return nil, err
+	}
+
+	return &res[0], nil
+}
+
+func (s *priceHistoryService) save(c *core.PriceCandle) error {
+	if c.ID == "" {
+		return s.priceStg.Create(c)
+	}
+
+	return s.priceStg.Update(c)
+}
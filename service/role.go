@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+// NewRole returns new Role service.
+func NewRole(us core.UserStorage, as core.RoleAuditStorage) core.RoleService {
+	return &roleService{us, as}
+}
+
+type roleService struct {
+	userStg  core.UserStorage
+	auditStg core.RoleAuditStorage
+}
+
+func (s *roleService) Staff(ctx context.Context) ([]core.User, error) {
+	if _, err := checkPermission(ctx, s.userStg, core.PermissionRolesRead); err != nil {
+		return nil, err
+	}
+
+	return s.userStg.FindStaff(core.FindOpts{Sort: "name"})
+}
+
+func (s *roleService) Grant(ctx context.Context, p core.RoleParams) (*core.User, error) {
+	actor, err := checkPermission(ctx, s.userStg, core.PermissionRolesWrite)
+	if err != nil {
+		return nil, err
+	}
+	if err = p.Validate(); err != nil {
+		return nil, err
+	}
+
+	u, err := s.userStg.Get(p.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if u.HasRole(p.Role) {
+		return u, nil
+	}
+
+	if err = s.userStg.AddRole(u.ID, p.Role); err != nil {
+		return nil, err
+	}
+
+	return s.audit(u.ID, actor.ID, core.RoleActionGrant, p)
+}
+
+func (s *roleService) Revoke(ctx context.Context, p core.RoleParams) (*core.User, error) {
+	actor, err := checkPermission(ctx, s.userStg, core.PermissionRolesWrite)
+	if err != nil {
+		return nil, err
+	}
+	if err = p.Validate(); err != nil {
+		return nil, err
+	}
+
+	u, err := s.userStg.Get(p.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !u.HasRole(p.Role) {
+		return nil, core.RoleErrNotFound
+	}
+	// Prevents admins from locking themselves out of role management.
+	if u.ID == actor.ID && p.Role == core.RoleAdmin {
+		return nil, core.RoleErrSelfRevoke
+	}
+
+	if err = s.userStg.RemoveRole(u.ID, p.Role); err != nil {
+		return nil, err
+	}
+
+	return s.audit(u.ID, actor.ID, core.RoleActionRevoke, p)
+}
+
+func (s *roleService) Audits(ctx context.Context, opts core.FindOpts) ([]core.RoleAudit, error) {
+	if _, err := checkPermission(ctx, s.userStg, core.PermissionRolesRead); err != nil {
+		return nil, err
+	}
+
+	if opts.Sort == "" {
+		opts.Sort = "created_at"
+		opts.Desc = true
+	}
+	return s.auditStg.Find(opts)
+}
+
+// audit records who changed the user role and returns the user with roles
+// after the change, including the ones changed concurrently.
+func (s *roleService) audit(userID, actorID, action string, p core.RoleParams) (*core.User, error) {
+	err := s.auditStg.Create(&core.RoleAudit{
+		UserID:  userID,
+		Role:    p.Role,
+		Action:  action,
+		ActorID: actorID,
+		Reason:  strings.TrimSpace(p.Reason),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.userStg.Get(userID)
+}
+
+// checkPermission returns context user when its roles grants the permission.
+func checkPermission(ctx context.Context, us core.UserStorage, permission string) (*core.User, error) {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return nil, core.AuthErrNoAccess
+	}
+	u, err := us.Get(au.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !u.HasPermission(permission) {
+		return nil, core.AuthErrForbidden
+	}
+
+	return u, nil
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+)
+
+// NewCatalogSearch returns catalog storage that resolves keyword searches
+// and its synonyms through the search index.
+func NewCatalogSearch(cs core.CatalogStorage, si core.SearchIndex, syn core.SynonymService) core.CatalogStorage {
+	return &catalogSearch{cs, si, syn}
+}
+
+type catalogSearch struct {
+	core.CatalogStorage
+	index      core.SearchIndex
+	synonymSvc core.SynonymService
+}
+
+func (s *catalogSearch) Find(o core.FindOpts) ([]core.Catalog, error) {
+	if !hasKeyword(o) {
+		return s.CatalogStorage.Find(o)
+	}
+
+	ids, err := s.search(o.Keyword)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	so, byRelevance := searchFindOpts(o, ids)
+	res, err := s.CatalogStorage.Find(so)
+	if err != nil || !byRelevance {
+		return res, err
+	}
+
+	rank := searchRank(ids)
+	sort.SliceStable(res, func(i, j int) bool {
+		return rank[res[i].ID] < rank[res[j].ID]
+	})
+	start, end := searchPage(len(res), o)
+	return res[start:end], nil
+}
+
+func (s *catalogSearch) Count(o core.FindOpts) (int, error) {
+	if !hasKeyword(o) {
+		return s.CatalogStorage.Count(o)
+	}
+
+	ids, err := s.search(o.Keyword)
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+	so, _ := searchFindOpts(o, ids)
+	return s.CatalogStorage.Count(so)
+}
+
+func (s *catalogSearch) Facets(o core.FindOpts) (*core.CatalogFacets, error) {
+	if !hasKeyword(o) {
+		return s.CatalogStorage.Facets(o)
+	}
+
+	ids, err := s.search(o.Keyword)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return core.NewCatalogFacets(nil), nil
+	}
+	so, _ := searchFindOpts(o, ids)
+	return s.CatalogStorage.Facets(so)
+}
+
+func (s *catalogSearch) search(keyword string) ([]string, error) {
+	dict, err := s.synonymSvc.Dictionary()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.index.SearchCatalogs(keyword, dict)
+}
+
+// NewMarketSearch returns market storage that resolves keyword searches
+// and its synonyms through the search index.
+func NewMarketSearch(ms core.MarketStorage, si core.SearchIndex, syn core.SynonymService) core.MarketStorage {
+	return &marketSearch{ms, si, syn}
+}
+
+type marketSearch struct {
+	core.MarketStorage
+	index      core.SearchIndex
+	synonymSvc core.SynonymService
+}
+
+func (s *marketSearch) Find(o core.FindOpts) ([]core.Market, error) {
+	if !hasKeyword(o) {
+		return s.MarketStorage.Find(o)
+	}
+
+	ids, err := s.search(o)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	so, byRelevance := searchFindOpts(o, ids)
+	res, err := s.MarketStorage.Find(so)
+	if err != nil || !byRelevance {
+		return res, err
+	}
+
+	rank := searchRank(ids)
+	sort.SliceStable(res, func(i, j int) bool {
+		return rank[res[i].ID] < rank[res[j].ID]
+	})
+	start, end := searchPage(len(res), o)
+	return res[start:end], nil
+}
+
+func (s *marketSearch) Count(o core.FindOpts) (int, error) {
+	if !hasKeyword(o) {
+		return s.MarketStorage.Count(o)
+	}
+
+	ids, err := s.search(o)
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+	so, _ := searchFindOpts(o, ids)
+	return s.MarketStorage.Count(so)
+}
+
+func (s *marketSearch) search(o core.FindOpts) ([]string, error) {
+	dict, err := s.synonymSvc.Dictionary()
+	if err != nil {
+		return nil, err
+	}
+
+	f := core.NewMarketSearchFilter(o.Filter)
+	if f.UserID == "" {
+		f.UserID = o.UserID
+	}
+	return s.index.SearchMarkets(o.Keyword, dict, f)
+}
+
+// NewMarketIndexSync returns market storage that keeps search index entries
+// in sync on market re-index and removes entries of deleted markets.
+func NewMarketIndexSync(ms core.MarketStorage, w core.SearchIndexWriter) core.MarketStorage {
+	return &marketIndexSync{ms, w}
+}
+
+type marketIndexSync struct {
+	core.MarketStorage
+	index core.SearchIndexWriter
+}
+
+func (s *marketIndexSync) Index(id string) (*core.Market, error) {
+	m, err := s.MarketStorage.Index(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, s.index.IndexMarket(*m)
+}
+
+func (s *marketIndexSync) BulkDeleteByStatus(ms core.MarketStatus, cutOff time.Time, limit int) ([]core.Market, error) {
+	res, err := s.MarketStorage.BulkDeleteByStatus(ms, cutOff, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range res {
+		if err = s.index.DeleteMarket(m.ID); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+func hasKeyword(o core.FindOpts) bool {
+	return strings.TrimSpace(o.Keyword) != ""
+}
+
+// searchFindOpts replaces keyword with search index hits. Results without
+// sorting are ordered by relevance, which needs all hits to be fetched
+// before paginating.
+func searchFindOpts(o core.FindOpts, ids []string) (opts core.FindOpts, byRelevance bool) {
+	o.Keyword = ""
+	o.IDs = ids
+	if o.Sort != "" {
+		return o, false
+	}
+
+	o.Page = 0
+	o.Limit = 0
+	return o, true
+}
+
+func searchRank(ids []string) map[string]int {
+	rank := make(map[string]int, len(ids))
+	for i, id := range ids {
+		rank[id] = i
+	}
+
+	return rank
+}
+
+func searchPage(n int, o core.FindOpts) (start, end int) {
+	if o.Limit == 0 {
+		return 0, n
+	}
+	page := o.Page
+	if page < 1 {
+		page = 1
+	}
+
+	start = (page - 1) * o.Limit
+	if start > n {
+		start = n
+	}
+	end = start + o.Limit
+	if end > n {
+		end = n
+	}
+	return start, end
+}
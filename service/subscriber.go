@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/events"
+	"github.com/kudarap/dotagiftx/gokit/log"
+)
+
+type Dispatcher interface {
+	VerifyDelivery(marketID string)
+	VerifyInventory(userID string)
+}
+
+// NewSubscriber returns side effects handler of domain events.
+func NewSubscriber(
+	ms core.MarketService,
+	ss core.MarketStorage,
+	cis core.CatalogIndexService,
+	ws core.WebhookService,
+	ns core.NotificationService,
+	wls core.WatchlistService,
+	xs core.MarketMatchService,
+	ps core.PriceHistoryService,
+	si core.SearchIndexWriter,
+	dp Dispatcher,
+	lg log.Logger,
+) *Subscriber {
+	return &Subscriber{ms, ss, cis, ws, ns, wls, xs, ps, si, dp, lg}
+}
+
+// Subscriber represents handlers that keeps market ranking, search index
+// and verifications in sync after a domain event.
+type Subscriber struct {
+	marketSvc  core.MarketService
+	marketStg  core.MarketStorage
+	indexSvc   core.CatalogIndexService
+	webhookSvc core.WebhookService
+	notifySvc  core.NotificationService
+	watchSvc   core.WatchlistService
+	matchSvc   core.MarketMatchService
+	priceSvc   core.PriceHistoryService
+	searchIdx  core.SearchIndexWriter
+	dispatch   Dispatcher
+	logger     log.Logger
+}
+
+// SubscribeMarket registers market event handlers.
+func (s *Subscriber) SubscribeMarket(sub events.Subscriber) {
+	sub.Subscribe(events.TypeMarketCreated, s.marketCreated)
+	sub.Subscribe(events.TypeMarketUpdated, s.marketUpdated)
+	sub.Subscribe(events.TypeMarketStatusChanged, s.marketStatusChanged)
+}
+
+// SubscribeVerification registers delivery and inventory verification
+// event handlers.
+func (s *Subscriber) SubscribeVerification(sub events.Subscriber) {
+	sub.Subscribe(events.TypeDeliveryVerified, s.deliveryVerified)
+	sub.Subscribe(events.TypeInventoryVerified, s.inventoryVerified)
+}
+
+// SubscribeWebhook registers handlers that queues webhook deliveries
+// of market owners.
+func (s *Subscriber) SubscribeWebhook(sub events.Subscriber) {
+	sub.Subscribe(events.TypeMarketStatusChanged, s.webhookMarketStatusChanged)
+	sub.Subscribe(events.TypeDeliveryVerified, s.webhookDeliveryVerified)
+}
+
+// SubscribeNotification registers handlers that sends user notifications.
+func (s *Subscriber) SubscribeNotification(sub events.Subscriber) {
+	sub.Subscribe(events.TypeMarketCreated, s.notifyBidAboveAsk)
+}
+
+// SubscribeWatchlist registers handlers that evaluates watchlist price
+// alerts of re-indexed catalogs.
+func (s *Subscriber) SubscribeWatchlist(sub events.Subscriber) {
+	sub.Subscribe(events.TypeCatalogIndexed, s.watchlistCatalogIndexed)
+}
+
+// SubscribeMatching registers handlers that pairs new buy orders and
+// verified listings with crossing counter-part entries.
+func (s *Subscriber) SubscribeMatching(sub events.Subscriber) {
+	sub.Subscribe(events.TypeMarketCreated, s.matchMarketCreated)
+	sub.Subscribe(events.TypeInventoryVerified, s.matchInventoryVerified)
+}
+
+// SubscribePriceHistory registers handlers that records ask, bid and sale
+// prices to item price candles.
+func (s *Subscriber) SubscribePriceHistory(sub events.Subscriber) {
+	sub.Subscribe(events.TypeMarketCreated, s.priceMarketCreated)
+	sub.Subscribe(events.TypeMarketStatusChanged, s.priceMarketStatusChanged)
+}
+
+// SubscribeSearch registers handlers that keeps search index in sync with
+// catalog indexing and market status changes.
+func (s *Subscriber) SubscribeSearch(sub events.Subscriber) {
+	sub.Subscribe(events.TypeCatalogIndexed, s.searchCatalogIndexed)
+	sub.Subscribe(events.TypeMarketStatusChanged, s.searchMarketStatusChanged)
+}
+
+func (s *Subscriber) marketCreated(_ context.Context, e events.Event) error {
+	m := e.(events.MarketCreated).Market
+	if err := s.refreshMarket(m); err != nil {
+		return err
+	}
+
+	if m.Type == core.MarketTypeAsk {
+		s.dispatch.VerifyInventory(m.UserID)
+	}
+	return nil
+}
+
+func (s *Subscriber) marketUpdated(_ context.Context, e events.Event) error {
+	return s.refreshMarket(e.(events.MarketUpdated).Market)
+}
+
+func (s *Subscriber) marketStatusChanged(_ context.Context, e events.Event) error {
+	m := e.(events.MarketStatusChanged).Market
+	if m.Type != core.MarketTypeAsk {
+		return nil
+	}
+
+	switch m.Status {
+	case core.MarketStatusReserved:
+		s.dispatch.VerifyInventory(m.UserID)
+	case core.MarketStatusSold:
+		s.dispatch.VerifyDelivery(m.ID)
+	}
+	return nil
+}
+
+func (s *Subscriber) deliveryVerified(_ context.Context, e events.Event) error {
+	del := e.(events.DeliveryVerified).Delivery
+	if _, err := s.marketStg.Index(del.MarketID); err != nil {
+		return fmt.Errorf("could not index market %s: %s", del.MarketID, err)
+	}
+	return nil
+}
+
+func (s *Subscriber) inventoryVerified(_ context.Context, e events.Event) error {
+	inv := e.(events.InventoryVerified).Inventory
+	mkt, err := s.marketStg.Index(inv.MarketID)
+	if err != nil {
+		return fmt.Errorf("could not index market %s: %s", inv.MarketID, err)
+	}
+	if err = s.indexSvc.Queue(mkt.ItemID); err != nil {
+		return fmt.Errorf("could not queue catalog index %s: %s", mkt.ItemID, err)
+	}
+	return nil
+}
+
+// refreshMarket updates owner rank score and search index of the market
+// and queues its catalog for re-indexing.
+func (s *Subscriber) refreshMarket(m core.Market) error {
+	if err := s.marketSvc.UpdateUserRankScore(m.UserID); err != nil {
+		s.logger.Errorf("could not update user rank %s: %s", m.UserID, err)
+	}
+	if _, err := s.marketStg.Index(m.ID); err != nil {
+		return fmt.Errorf("could not index market %s: %s", m.ID, err)
+	}
+	if err := s.indexSvc.Queue(m.ItemID); err != nil {
+		return fmt.Errorf("could not queue catalog index %s: %s", m.ItemID, err)
+	}
+	return nil
+}
+
+func (s *Subscriber) webhookMarketStatusChanged(_ context.Context, e events.Event) error {
+	m := e.(events.MarketStatusChanged).Market
+	we := marketWebhookEvent(m)
+	if we == "" {
+		return nil
+	}
+
+	return s.webhookSvc.Queue(m.UserID, we, m)
+}
+
+func (s *Subscriber) webhookDeliveryVerified(_ context.Context, e events.Event) error {
+	del := e.(events.DeliveryVerified).Delivery
+	if del.Status != core.DeliveryStatusNameVerified && del.Status != core.DeliveryStatusSenderVerified {
+		return nil
+	}
+
+	m, err := s.marketStg.Get(del.MarketID)
+	if err != nil {
+		return err
+	}
+
+	return s.webhookSvc.Queue(m.UserID, core.WebhookEventMarketDeliveryVerified, m)
+}
+
+func (s *Subscriber) watchlistCatalogIndexed(ctx context.Context, e events.Event) error {
+	return s.watchSvc.Evaluate(ctx, e.(events.CatalogIndexed).Catalog)
+}
+
+func (s *Subscriber) matchMarketCreated(ctx context.Context, e events.Event) error {
+	_, err := s.matchSvc.Match(ctx, e.(events.MarketCreated).Market)
+	return err
+}
+
+func (s *Subscriber) matchInventoryVerified(ctx context.Context, e events.Event) error {
+	inv := e.(events.InventoryVerified).Inventory
+	if inv.Status != core.InventoryStatusVerified {
+		return nil
+	}
+
+	_, err := s.matchSvc.Match(ctx, core.Market{ID: inv.MarketID})
+	return err
+}
+
+func (s *Subscriber) priceMarketCreated(ctx context.Context, e events.Event) error {
+	m := e.(events.MarketCreated).Market
+	for _, p := range m.PricePoints() {
+		if p.Side == core.PriceSideSale {
+			continue
+		}
+		if err := s.priceSvc.Record(ctx, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Subscriber) priceMarketStatusChanged(ctx context.Context, e events.Event) error {
+	ev := e.(events.MarketStatusChanged)
+	if !ev.Market.IsSaleTransition(ev.PrevStatus) {
+		return nil
+	}
+
+	t := time.Now()
+	if ev.Market.UpdatedAt != nil {
+		t = *ev.Market.UpdatedAt
+	}
+	return s.priceSvc.Record(ctx, core.PricePoint{
+		ItemID: ev.Market.ItemID,
+		Side:   core.PriceSideSale,
+		Price:  ev.Market.Price,
+		Time:   t,
+	})
+}
+
+func (s *Subscriber) searchCatalogIndexed(_ context.Context, e events.Event) error {
+	return s.searchIdx.IndexCatalog(e.(events.CatalogIndexed).Catalog)
+}
+
+// searchMarketStatusChanged updates market status on the search index, market
+// storage keeps search index in sync on re-index.
+func (s *Subscriber) searchMarketStatusChanged(_ context.Context, e events.Event) error {
+	m := e.(events.MarketStatusChanged).Market
+	if _, err := s.marketStg.Index(m.ID); err != nil && err != core.MarketErrNotFound {
+		return fmt.Errorf("could not index market %s: %s", m.ID, err)
+	}
+	return nil
+}
+
+// notifyBidAboveAsk notifies sellers of live listings that new buy order
+// is priced higher or equal to their asking price.
+func (s *Subscriber) notifyBidAboveAsk(ctx context.Context, e events.Event) error {
+	bid := e.(events.MarketCreated).Market
+	if bid.Type != core.MarketTypeBid {
+		return nil
+	}
+
+	asks, err := s.marketStg.Find(core.FindOpts{
+		Filter: core.Market{
+			ItemID: bid.ItemID,
+			Type:   core.MarketTypeAsk,
+			Status: core.MarketStatusLive,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	notified := map[string]struct{}{bid.UserID: {}}
+	for _, ask := range asks {
+		if _, ok := notified[ask.UserID]; ok || ask.Price > bid.Price {
+			continue
+		}
+		notified[ask.UserID] = struct{}{}
+
+		name := ask.ItemID
+		if ask.Item != nil {
+			name = ask.Item.Name
+		}
+		err = s.notifySvc.Notify(ctx, ask.UserID, core.Notification{
+			Event:   core.NotificationEventBidAboveAsk,
+			Subject: fmt.Sprintf("New buy order for %s", name),
+			Message: fmt.Sprintf("Someone wants to buy %s for %.2f %s, your listing asks for %.2f %s.",
+				name, bid.Price, bid.Currency, ask.Price, ask.Currency),
+		})
+		if err != nil {
+			s.logger.Errorf("could not notify seller %s: %s", ask.UserID, err)
+		}
+	}
+
+	return nil
+}
+
+// marketWebhookEvent returns webhook event of market status change.
+func marketWebhookEvent(m core.Market) string {
+	switch m.Status {
+	case core.MarketStatusReserved:
+		return core.WebhookEventMarketReserved
+	case core.MarketStatusSold:
+		return core.WebhookEventMarketSold
+	case core.MarketStatusExpired:
+		return core.WebhookEventMarketExpired
+	case core.MarketStatusBidCompleted:
+		return core.WebhookEventBidCompleted
+	}
+
+	return ""
+}
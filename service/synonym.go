@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+// synonymCacheExpr duration of synonym dictionary kept in memory before
+// reloading it from data store.
+const synonymCacheExpr = time.Minute * 5
+
+// NewSynonym returns new search Synonym service.
+func NewSynonym(ss core.SynonymStorage, us core.UserStorage) core.SynonymService {
+	s := &synonymService{synonymStg: ss, userStg: us}
+	s.dict = newTTLCache(synonymCacheExpr, s.loadDictionary)
+	return s
+}
+
+type synonymService struct {
+	synonymStg core.SynonymStorage
+	userStg    core.UserStorage
+	dict       *ttlCache
+}
+
+func (s *synonymService) Synonyms(ctx context.Context) ([]core.Synonym, error) {
+	return s.synonymStg.Find(core.FindOpts{Sort: "term"})
+}
+
+func (s *synonymService) Create(ctx context.Context, syn *core.Synonym) error {
+	if err := s.checkCurator(ctx); err != nil {
+		return err
+	}
+	if err := syn.CheckCreate(); err != nil {
+		if err == core.SynonymErrInvalidTerm {
+			return err
+		}
+		return errors.New(core.SynonymErrRequiredFields, err)
+	}
+	if err := s.checkDuplicate(*syn); err != nil {
+		return err
+	}
+
+	if err := s.synonymStg.Create(syn); err != nil {
+		return err
+	}
+
+	s.dict.invalidate()
+	return nil
+}
+
+func (s *synonymService) Update(ctx context.Context, syn *core.Synonym) error {
+	if err := s.checkCurator(ctx); err != nil {
+		return err
+	}
+	if syn.ID == "" {
+		return core.SynonymErrRequiredID
+	}
+	if _, err := s.synonymStg.Get(syn.ID); err != nil {
+		return err
+	}
+	if err := syn.CheckCreate(); err != nil {
+		if err == core.SynonymErrInvalidTerm {
+			return err
+		}
+		return errors.New(core.SynonymErrRequiredFields, err)
+	}
+	if err := s.checkDuplicate(*syn); err != nil {
+		return err
+	}
+
+	if err := s.synonymStg.Update(syn); err != nil {
+		return err
+	}
+
+	s.dict.invalidate()
+	return nil
+}
+
+func (s *synonymService) Delete(ctx context.Context, id string) error {
+	if err := s.checkCurator(ctx); err != nil {
+		return err
+	}
+	if id == "" {
+		return core.SynonymErrRequiredID
+	}
+	if _, err := s.synonymStg.Get(id); err != nil {
+		return err
+	}
+
+	if err := s.synonymStg.Delete(id); err != nil {
+		return err
+	}
+
+	s.dict.invalidate()
+	return nil
+}
+
+func (s *synonymService) Dictionary() (core.SynonymDictionary, error) {
+	dict, err := s.dict.get()
+	if err != nil {
+		return nil, err
+	}
+
+	return dict.(core.SynonymDictionary), nil
+}
+
+func (s *synonymService) loadDictionary() (interface{}, error) {
+	res, err := s.synonymStg.Find(core.FindOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	return core.NewSynonymDictionary(res), nil
+}
+
+func (s *synonymService) checkCurator(ctx context.Context) error {
+	_, err := checkPermission(ctx, s.userStg, core.PermissionSynonymsWrite)
+	return err
+}
+
+// checkDuplicate prevents other synonym records using the same term.
+func (s *synonymService) checkDuplicate(syn core.Synonym) error {
+	res, err := s.synonymStg.Find(core.FindOpts{Filter: core.Synonym{Term: syn.Term}})
+	if err != nil {
+		return err
+	}
+	for _, r := range res {
+		if r.ID != syn.ID {
+			return core.SynonymErrDuplicate
+		}
+	}
+
+	return nil
+}
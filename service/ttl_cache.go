@@ -0,0 +1,56 @@
+package service
+
+import (
+	"sync"
+	"time"
+)
+
+// ttlCache keeps a value loaded from data store in memory until it expires
+// or gets invalidated.
+type ttlCache struct {
+	ttl  time.Duration
+	load func() (interface{}, error)
+
+	mu        sync.RWMutex
+	val       interface{}
+	expiresAt time.Time
+}
+
+func newTTLCache(ttl time.Duration, load func() (interface{}, error)) *ttlCache {
+	return &ttlCache{ttl: ttl, load: load}
+}
+
+// get returns cached value or loads it when expired.
+func (c *ttlCache) get() (interface{}, error) {
+	c.mu.RLock()
+	val, exp := c.val, c.expiresAt
+	c.mu.RUnlock()
+	if time.Now().Before(exp) {
+		return val, nil
+	}
+
+	return c.reload()
+}
+
+// reload loads value and keeps it until ttl.
+func (c *ttlCache) reload() (interface{}, error) {
+	val, err := c.load()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.val = val
+	c.expiresAt = time.Now().Add(c.ttl)
+	c.mu.Unlock()
+
+	return val, nil
+}
+
+// invalidate loads the value again on next get.
+func (c *ttlCache) invalidate() {
+	c.mu.Lock()
+	c.val = nil
+	c.expiresAt = time.Time{}
+	c.mu.Unlock()
+}
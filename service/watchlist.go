@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+	"github.com/kudarap/dotagiftx/gokit/log"
+)
+
+// watchlistAlertLimit number of recent alerts shown per user.
+const watchlistAlertLimit = 50
+
+// NewWatchlist returns new Watchlist service.
+func NewWatchlist(
+	ws core.WatchlistStorage,
+	as core.WatchlistAlertStorage,
+	is core.ItemStorage,
+	cs core.CatalogStorage,
+	ns core.NotificationService,
+	lg log.Logger,
+) core.WatchlistService {
+	return &watchlistService{ws, as, is, cs, ns, lg}
+}
+
+type watchlistService struct {
+	watchlistStg core.WatchlistStorage
+	alertStg     core.WatchlistAlertStorage
+	itemStg      core.ItemStorage
+	catalogStg   core.CatalogStorage
+	notifySvc    core.NotificationService
+	logger       log.Logger
+}
+
+func (s *watchlistService) Watchlist(ctx context.Context) ([]core.Watchlist, error) {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return nil, core.AuthErrNoAccess
+	}
+
+	res, err := s.userWatchlist(au.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Items that were never listed have no catalog yet.
+	for i, w := range res {
+		c, err := s.catalogStg.Get(w.ItemID)
+		if err == core.CatalogErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		res[i].Item = c
+	}
+
+	return res, nil
+}
+
+func (s *watchlistService) Create(ctx context.Context, w *core.Watchlist) error {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return core.AuthErrNoAccess
+	}
+	w.UserID = au.UserID
+
+	if err := w.CheckCreate(); err != nil {
+		return errors.New(core.WatchlistErrRequiredFields, err)
+	}
+
+	item, err := s.itemStg.Get(w.ItemID)
+	if err != nil {
+		return err
+	}
+	w.ItemID = item.ID
+
+	cur, err := s.userWatchlist(au.UserID)
+	if err != nil {
+		return err
+	}
+	if len(cur) >= core.MaxWatchlistPerUser {
+		return core.WatchlistErrLimitReached
+	}
+	for _, c := range cur {
+		if c.ItemID == w.ItemID {
+			return core.WatchlistErrDuplicateItem
+		}
+	}
+
+	w.AskTriggered = nil
+	w.BidTriggered = nil
+	return s.watchlistStg.Create(w)
+}
+
+func (s *watchlistService) Update(ctx context.Context, w *core.Watchlist) error {
+	if _, err := s.checkOwnership(ctx, w.ID); err != nil {
+		return err
+	}
+
+	if err := w.CheckUpdate(); err != nil {
+		return errors.New(core.WatchlistErrRequiredFields, err)
+	}
+
+	// Only thresholds are updated, zero threshold clears it and alerts are
+	// re-armed on new thresholds.
+	w.UserID = ""
+	w.ItemID = ""
+	return s.watchlistStg.UpdateThresholds(w)
+}
+
+func (s *watchlistService) Delete(ctx context.Context, id string) error {
+	if _, err := s.checkOwnership(ctx, id); err != nil {
+		return err
+	}
+
+	return s.watchlistStg.Delete(id)
+}
+
+func (s *watchlistService) Alerts(ctx context.Context) ([]core.WatchlistAlert, error) {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return nil, core.AuthErrNoAccess
+	}
+
+	return s.alertStg.Find(au.UserID, watchlistAlertLimit)
+}
+
+func (s *watchlistService) Evaluate(ctx context.Context, c core.Catalog) error {
+	res, err := s.watchlistStg.Find(core.FindOpts{Filter: core.Watchlist{ItemID: c.ID}})
+	if err != nil {
+		return err
+	}
+
+	for _, w := range res {
+		alerts, changed := w.Evaluate(c)
+		if !changed {
+			continue
+		}
+
+		// Saves alert state first to avoid recording duplicate alerts on
+		// concurrent re-index of the same catalog.
+		if err = s.watchlistStg.Update(&core.Watchlist{
+			ID:           w.ID,
+			AskTriggered: w.AskTriggered,
+			BidTriggered: w.BidTriggered,
+		}); err != nil {
+			return err
+		}
+
+		for _, a := range alerts {
+			a := a
+			if err = s.alertStg.Create(&a); err != nil {
+				return err
+			}
+			s.notify(ctx, c, a)
+		}
+	}
+
+	return nil
+}
+
+func (s *watchlistService) notify(ctx context.Context, c core.Catalog, a core.WatchlistAlert) {
+	var msg string
+	switch a.Type {
+	case core.WatchlistAlertAskBelow:
+		msg = fmt.Sprintf("Lowest ask of %s dropped to %.2f, below your %.2f alert.", c.Name, a.Price, a.Threshold)
+	case core.WatchlistAlertBidAbove:
+		msg = fmt.Sprintf("Highest bid of %s rose to %.2f, above your %.2f alert.", c.Name, a.Price, a.Threshold)
+	}
+
+	err := s.notifySvc.Notify(ctx, a.UserID, core.Notification{
+		Event:   core.NotificationEventPriceAlert,
+		Subject: fmt.Sprintf("Price alert for %s", c.Name),
+		Message: msg,
+	})
+	if err != nil {
+		s.logger.Errorf("could not notify watchlist alert %s: %s", a.ID, err)
+	}
+}
+
+func (s *watchlistService) userWatchlist(userID string) ([]core.Watchlist, error) {
+	return s.watchlistStg.Find(core.FindOpts{Filter: core.Watchlist{UserID: userID}})
+}
+
+func (s *watchlistService) checkOwnership(ctx context.Context, id string) (*core.Watchlist, error) {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return nil, core.AuthErrNoAccess
+	}
+
+	w, err := s.watchlistStg.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if w.UserID != au.UserID {
+		return nil, core.WatchlistErrNotFound
+	}
+
+	return w, nil
+}
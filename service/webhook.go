@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/kudarap/dotagiftx/core"
+	"github.com/kudarap/dotagiftx/errors"
+)
+
+// webhookDeliveryLogLimit number of recent deliveries shown per webhook.
+const webhookDeliveryLogLimit = 50
+
+// NewWebhook returns new Webhook service.
+func NewWebhook(ws core.WebhookStorage, ds core.WebhookDeliveryStorage) core.WebhookService {
+	return &webhookService{ws, ds}
+}
+
+type webhookService struct {
+	webhookStg  core.WebhookStorage
+	deliveryStg core.WebhookDeliveryStorage
+}
+
+func (s *webhookService) Webhooks(ctx context.Context) ([]core.Webhook, error) {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return nil, core.AuthErrNoAccess
+	}
+
+	return s.userWebhooks(au.UserID)
+}
+
+func (s *webhookService) Webhook(ctx context.Context, id string) (*core.Webhook, error) {
+	return s.checkOwnership(ctx, id)
+}
+
+func (s *webhookService) Create(ctx context.Context, wh *core.Webhook) error {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return core.AuthErrNoAccess
+	}
+	wh.UserID = au.UserID
+
+	wh.URL = strings.TrimSpace(wh.URL)
+	if err := wh.CheckCreate(); err != nil {
+		return errors.New(core.WebhookErrRequiredFields, err)
+	}
+
+	cur, err := s.userWebhooks(au.UserID)
+	if err != nil {
+		return err
+	}
+	if len(cur) >= core.MaxWebhooksPerUser {
+		return core.WebhookErrLimitReached
+	}
+
+	if wh.Secret, err = generateWebhookSecret(); err != nil {
+		return err
+	}
+
+	return s.webhookStg.Create(wh)
+}
+
+func (s *webhookService) Update(ctx context.Context, wh *core.Webhook) error {
+	if _, err := s.checkOwnership(ctx, wh.ID); err != nil {
+		return err
+	}
+
+	wh.URL = strings.TrimSpace(wh.URL)
+	if err := wh.CheckUpdate(); err != nil {
+		return errors.New(core.WebhookErrRequiredFields, err)
+	}
+
+	// Do not allow update on these fields.
+	wh.UserID = ""
+	wh.Secret = ""
+	return s.webhookStg.Update(wh)
+}
+
+func (s *webhookService) Delete(ctx context.Context, id string) error {
+	if _, err := s.checkOwnership(ctx, id); err != nil {
+		return err
+	}
+
+	return s.webhookStg.Delete(id)
+}
+
+func (s *webhookService) Deliveries(ctx context.Context, id string) ([]core.WebhookDelivery, error) {
+	wh, err := s.checkOwnership(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deliveryStg.Find(wh.ID, webhookDeliveryLogLimit)
+}
+
+func (s *webhookService) Queue(userID, event string, data interface{}) error {
+	hooks, err := s.userWebhooks(userID)
+	if err != nil {
+		return err
+	}
+
+	for _, wh := range hooks {
+		if wh.IsDisabled() || !wh.HasEvent(event) {
+			continue
+		}
+
+		// Delivery is created without attempt schedule so it will not be
+		// picked up until its payload is rendered with the delivery id.
+		d := &core.WebhookDelivery{
+			WebhookID: wh.ID,
+			Event:     event,
+			Status:    core.WebhookDeliveryStatusPending,
+		}
+		if err = s.deliveryStg.Create(d); err != nil {
+			return err
+		}
+
+		t := time.Now()
+		b, err := json.Marshal(core.WebhookPayload{
+			ID:        d.ID,
+			Event:     event,
+			CreatedAt: t,
+			Data:      data,
+		})
+		if err != nil {
+			return err
+		}
+		d.Payload = string(b)
+		d.NextAttemptAt = &t
+		if err = s.deliveryStg.Update(d); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *webhookService) userWebhooks(userID string) ([]core.Webhook, error) {
+	return s.webhookStg.Find(core.FindOpts{Filter: core.Webhook{UserID: userID}})
+}
+
+func (s *webhookService) checkOwnership(ctx context.Context, id string) (*core.Webhook, error) {
+	au := core.AuthFromContext(ctx)
+	if au == nil {
+		return nil, core.AuthErrNoAccess
+	}
+
+	wh, err := s.webhookStg.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if wh.UserID != au.UserID {
+		return nil, core.WebhookErrNotFound
+	}
+
+	return wh, nil
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}